@@ -29,3 +29,45 @@ type FieldFilter struct {
 
 const Is = "Is"
 const Empty = "Empty"
+
+// CopySince is the default start of the annotations copied by Copy.
+var CopySince = time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Copy inserts the annotations in src that end since since into dst, for
+// example to migrate from Elastic to Redis. The annotations are read and
+// inserted a day at a time, by their start, up to today, so src is never
+// read at once. An annotation replaces the one with the same id in dst, so a
+// copy that failed can be resumed from the day it failed on, which the error
+// names, or run again. progress, if not nil, is called after every day on
+// which annotations were copied with the day and the number of annotations
+// copied so far. Copy returns the
+// number of annotations copied.
+func Copy(dst, src Backend, since time.Time, progress func(day time.Time, n int)) (int, error) {
+	since = since.UTC().Truncate(24 * time.Hour)
+	today := time.Now().UTC()
+	n := 0
+	for day := since; !day.After(today); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		// The annotations overlapping the day are returned, so copy those
+		// starting on it, and those starting before since on the first.
+		annotations, err := src.GetAnnotations(&day, &next)
+		if err != nil {
+			return n, fmt.Errorf("%s: %v", day.Format("2006-01-02"), err)
+		}
+		copied := n
+		for i := range annotations {
+			a := &annotations[i]
+			if !a.StartDate.Before(next) || (a.StartDate.Before(day) && !day.Equal(since)) {
+				continue
+			}
+			if err := dst.InsertAnnotation(a); err != nil {
+				return n, fmt.Errorf("%s: annotation %s: %v", day.Format("2006-01-02"), a.Id, err)
+			}
+			n++
+		}
+		if progress != nil && n > copied {
+			progress(day, n)
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,278 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"bosun.org/annotate"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+Annotations : hash of Id - json of annotation.
+
+AnnotationsByEnd : zset of end time to id. Annotations in a time range are
+those ending after its start, filtered to those starting before its end.
+
+AnnotationValues:<Field> : zset of the values of a filterable field to the
+number of annotations with that value.
+
+Only commands supported by ledis are used, so this works with bosun's
+embedded data store as well as with redis.
+
+*/
+
+const (
+	redisAnnotations      = "Annotations"
+	redisAnnotationsByEnd = "AnnotationsByEnd"
+	redisAnnotationValues = "AnnotationValues:"
+)
+
+// RedisConnector gets a connection to redis or ledis. It is satisfied by
+// *redis.Pool and bosun's database.DataAccess.
+type RedisConnector interface {
+	Get() redis.Conn
+}
+
+// Redis is a Backend storing annotations in redis or ledis.
+type Redis struct {
+	RedisConnector
+	prefix      string
+	maxResults  int
+	initialized bool
+}
+
+// NewRedis returns a Redis backend using c. All keys are prefixed with
+// prefix, so several backends can share a database.
+func NewRedis(c RedisConnector, prefix string) *Redis {
+	return &Redis{c, prefix, 200, false}
+}
+
+func (r *Redis) InitBackend() error {
+	conn := r.Get()
+	defer conn.Close()
+	if _, err := conn.Do("PING"); err != nil {
+		return err
+	}
+	r.initialized = true
+	return nil
+}
+
+func (r *Redis) InsertAnnotation(a *annotate.Annotation) error {
+	if !r.initialized {
+		return unInitErr
+	}
+	conn := r.Get()
+	defer conn.Close()
+	old, found, err := r.getAnnotation(conn, a.Id)
+	if err != nil {
+		return err
+	}
+	dat, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Do("HSET", r.prefix+redisAnnotations, a.Id, dat); err != nil {
+		return err
+	}
+	if _, err := conn.Do("ZADD", r.prefix+redisAnnotationsByEnd, a.EndDate.Unix(), a.Id); err != nil {
+		return err
+	}
+	for _, field := range redisFields {
+		v := fieldValue(a, field)
+		if found {
+			ov := fieldValue(old, field)
+			if ov == v {
+				continue
+			}
+			if err := r.decrValue(conn, field, ov); err != nil {
+				return err
+			}
+		}
+		if v == "" {
+			continue
+		}
+		if _, err := conn.Do("ZINCRBY", r.prefix+redisAnnotationValues+field, 1, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Redis) GetAnnotation(id string) (*annotate.Annotation, bool, error) {
+	if !r.initialized {
+		return nil, false, unInitErr
+	}
+	if id == "" {
+		return &annotate.Annotation{}, false, fmt.Errorf("must provide id")
+	}
+	conn := r.Get()
+	defer conn.Close()
+	return r.getAnnotation(conn, id)
+}
+
+func (r *Redis) getAnnotation(conn redis.Conn, id string) (*annotate.Annotation, bool, error) {
+	a := annotate.Annotation{}
+	dat, err := redis.Bytes(conn.Do("HGET", r.prefix+redisAnnotations, id))
+	if err == redis.ErrNil {
+		return &a, false, nil
+	}
+	if err != nil {
+		return &a, false, err
+	}
+	if err := json.Unmarshal(dat, &a); err != nil {
+		return &a, true, err
+	}
+	return &a, true, nil
+}
+
+func (r *Redis) GetAnnotations(start, end *time.Time, fieldFilters ...FieldFilter) (annotate.Annotations, error) {
+	if !r.initialized {
+		return nil, unInitErr
+	}
+	annotations := annotate.Annotations{}
+	for _, filter := range fieldFilters {
+		switch filter.Field {
+		case annotate.Source, annotate.Host, annotate.CreationUser, annotate.Owner, annotate.Category:
+		default:
+			return annotations, fmt.Errorf("%v is not a field that can be filtered on", filter.Field)
+		}
+		switch filter.Verb {
+		case Is, "", Empty:
+		default:
+			return annotations, fmt.Errorf("%v is not a valid query verb", filter.Verb)
+		}
+	}
+	conn := r.Get()
+	defer conn.Close()
+	min := interface{}("-inf")
+	if start != nil && end != nil {
+		min = start.Unix()
+	}
+	ids, err := redis.Strings(conn.Do("ZRANGEBYSCORE", r.prefix+redisAnnotationsByEnd, min, "+inf"))
+	if err != nil {
+		return annotations, err
+	}
+	if len(ids) == 0 {
+		return annotations, nil
+	}
+	args := make([]interface{}, len(ids)+1)
+	args[0] = r.prefix + redisAnnotations
+	for i := range ids {
+		args[i+1] = ids[i]
+	}
+	jsons, err := redis.ByteSlices(conn.Do("HMGET", args...))
+	if err != nil {
+		return annotations, err
+	}
+Loop:
+	for i, dat := range jsons {
+		if dat == nil {
+			continue
+		}
+		var a annotate.Annotation
+		if err := json.Unmarshal(dat, &a); err != nil {
+			return annotations, fmt.Errorf("annotation %v: %v", ids[i], err)
+		}
+		if start != nil && end != nil && a.StartDate.After(*end) {
+			continue
+		}
+		for _, filter := range fieldFilters {
+			v := fieldValue(&a, filter.Field)
+			match := v == filter.Value
+			if filter.Verb == Empty {
+				match = v == ""
+			}
+			if match == filter.Not {
+				continue Loop
+			}
+		}
+		annotations = append(annotations, a)
+	}
+	sort.Sort(annotate.AnnotationsByStartID(annotations))
+	return annotations, nil
+}
+
+func (r *Redis) DeleteAnnotation(id string) error {
+	if !r.initialized {
+		return unInitErr
+	}
+	conn := r.Get()
+	defer conn.Close()
+	a, found, err := r.getAnnotation(conn, id)
+	if err != nil || !found {
+		return err
+	}
+	if _, err := conn.Do("ZREM", r.prefix+redisAnnotationsByEnd, id); err != nil {
+		return err
+	}
+	if _, err := conn.Do("HDEL", r.prefix+redisAnnotations, id); err != nil {
+		return err
+	}
+	for _, field := range redisFields {
+		if err := r.decrValue(conn, field, fieldValue(a, field)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFieldValues returns the values of field, most used first.
+func (r *Redis) GetFieldValues(field string) ([]string, error) {
+	if !r.initialized {
+		return nil, unInitErr
+	}
+	terms := []string{}
+	switch field {
+	case annotate.Source, annotate.Host, annotate.CreationUser, annotate.Owner, annotate.Category:
+		//continue
+	default:
+		return terms, fmt.Errorf("invalid field %v", field)
+	}
+	conn := r.Get()
+	defer conn.Close()
+	values, err := redis.Strings(conn.Do("ZREVRANGEBYSCORE", r.prefix+redisAnnotationValues+field, "+inf", 1, "LIMIT", 0, r.maxResults))
+	if err != nil {
+		return terms, err
+	}
+	return append(terms, values...), nil
+}
+
+// decrValue decrements the count of annotations with value v for field and
+// removes the value once no annotation has it.
+func (r *Redis) decrValue(conn redis.Conn, field, v string) error {
+	if v == "" {
+		return nil
+	}
+	key := r.prefix + redisAnnotationValues + field
+	n, err := redis.Float64(conn.Do("ZINCRBY", key, -1, v))
+	if err != nil {
+		return err
+	}
+	if n <= 0 {
+		_, err = conn.Do("ZREM", key, v)
+	}
+	return err
+}
+
+// redisFields are the fields whose values are counted for GetFieldValues.
+var redisFields = []string{annotate.Source, annotate.Host, annotate.CreationUser, annotate.Owner, annotate.Category}
+
+func fieldValue(a *annotate.Annotation, field string) string {
+	switch field {
+	case annotate.Source:
+		return a.Source
+	case annotate.Host:
+		return a.Host
+	case annotate.CreationUser:
+		return a.CreationUser
+	case annotate.Owner:
+		return a.Owner
+	case annotate.Category:
+		return a.Category
+	}
+	return ""
+}
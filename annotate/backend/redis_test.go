@@ -0,0 +1,177 @@
+package backend
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"bosun.org/annotate"
+	"github.com/alicebob/miniredis"
+	"github.com/garyburd/redigo/redis"
+)
+
+func TestRedis(t *testing.T) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	pool := &redis.Pool{Dial: func() (redis.Conn, error) { return redis.Dial("tcp", s.Addr()) }}
+	b := NewRedis(pool, "test:")
+	if err := b.InsertAnnotation(&annotate.Annotation{}); err != unInitErr {
+		t.Fatalf("expected uninitialized error, got %v", err)
+	}
+	if err := b.InitBackend(); err != nil {
+		t.Fatal(err)
+	}
+
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return base.Add(time.Duration(h) * time.Hour) }
+	for _, a := range []annotate.Annotation{
+		annotate.NewAnnotation("a", hour(0), hour(2), "alice", "sre", "ui", "ny-web01", "outage", "", "first"),
+		annotate.NewAnnotation("b", hour(3), hour(3), "", "sre", "scollector", "ny-web02", "deploy", "", "second"),
+		annotate.NewAnnotation("c", hour(5), hour(6), "bob", "dba", "ui", "", "deploy", "", "third"),
+	} {
+		a := a
+		if err := b.InsertAnnotation(&a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ids := func(as annotate.Annotations) []string {
+		s := []string{}
+		for _, a := range as {
+			s = append(s, a.Id)
+		}
+		return s
+	}
+	for _, test := range []struct {
+		start, end int
+		filters    []FieldFilter
+		expected   []string
+	}{
+		{-1, 10, nil, []string{"a", "b", "c"}},
+		{1, 4, nil, []string{"a", "b"}},
+		{3, 3, nil, []string{"b"}},
+		{7, 8, nil, []string{}},
+		{-1, 10, []FieldFilter{{Field: annotate.Category, Value: "deploy"}}, []string{"b", "c"}},
+		{-1, 10, []FieldFilter{{Field: annotate.Category, Verb: Is, Not: true, Value: "deploy"}}, []string{"a"}},
+		{-1, 10, []FieldFilter{{Field: annotate.Host, Verb: Empty}}, []string{"c"}},
+		{-1, 10, []FieldFilter{{Field: annotate.CreationUser, Verb: Empty, Not: true}, {Field: annotate.Source, Value: "ui"}}, []string{"a", "c"}},
+	} {
+		start, end := hour(test.start), hour(test.end)
+		as, err := b.GetAnnotations(&start, &end, test.filters...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ids(as); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%d-%d %v: expected %v, got %v", test.start, test.end, test.filters, test.expected, got)
+		}
+	}
+	if _, err := b.GetAnnotations(nil, nil, FieldFilter{Field: annotate.Message, Value: "first"}); err == nil {
+		t.Error("expected error filtering on Message")
+	}
+
+	values, err := b.GetFieldValues(annotate.Category)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"deploy", "outage"}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected values %v, got %v", expected, values)
+	}
+	if _, err := b.GetFieldValues(annotate.Message); err == nil {
+		t.Error("expected error for Message values")
+	}
+
+	// Updating and deleting annotations updates the field values.
+	a, found, err := b.GetAnnotation("a")
+	if err != nil || !found || a.Message != "first" {
+		t.Fatalf("expected annotation a, got %v %v %v", a, found, err)
+	}
+	a.Category = "deploy"
+	if err := b.InsertAnnotation(a); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.DeleteAnnotation("c"); err != nil {
+		t.Fatal(err)
+	}
+	if _, found, err := b.GetAnnotation("c"); err != nil || found {
+		t.Errorf("expected c to be deleted, got %v %v", found, err)
+	}
+	for field, expected := range map[string][]string{
+		annotate.Category:     {"deploy"},
+		annotate.Owner:        {"sre"},
+		annotate.CreationUser: {"alice"},
+	} {
+		values, err := b.GetFieldValues(field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(values, expected) {
+			t.Errorf("%s: expected values %v, got %v", field, expected, values)
+		}
+	}
+	as, err := b.GetAnnotations(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ids(as); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("expected a and b, got %v", got)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	pool := &redis.Pool{Dial: func() (redis.Conn, error) { return redis.Dial("tcp", s.Addr()) }}
+	src, dst := NewRedis(pool, "src:"), NewRedis(pool, "dst:")
+	for _, b := range []*Redis{src, dst} {
+		if err := b.InitBackend(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	day := func(d int) time.Time { return today.AddDate(0, 0, d) }
+	for _, a := range []annotate.Annotation{
+		annotate.NewAnnotation("old", day(-10), day(-9), "", "", "", "", "test", "", "ended before since"),
+		annotate.NewAnnotation("a", day(-6), day(-1), "", "", "", "", "test", "", "started before since"),
+		annotate.NewAnnotation("b", day(-3).Add(time.Hour), day(-2), "", "", "", "", "test", "", "spans days"),
+		annotate.NewAnnotation("c", day(-1), day(-1), "", "", "", "", "test", "", "yesterday"),
+		annotate.NewAnnotation("d", day(0).Add(time.Hour), day(0).Add(time.Hour), "", "", "", "", "test", "", "today"),
+	} {
+		a := a
+		if err := src.InsertAnnotation(&a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var days []time.Time
+	n, err := Copy(dst, src, day(-5).Add(time.Hour), func(day time.Time, n int) {
+		days = append(days, day)
+	})
+	if err != nil || n != 4 {
+		t.Fatalf("expected 4 annotations copied, got %v %v", n, err)
+	}
+	if expected := []time.Time{day(-5), day(-3), day(-1), day(0)}; !reflect.DeepEqual(days, expected) {
+		t.Errorf("expected progress on %v, got %v", expected, days)
+	}
+	if _, found, err := dst.GetAnnotation("b"); err != nil || !found {
+		t.Errorf("expected b to be copied, got %v %v", found, err)
+	}
+	if _, found, err := dst.GetAnnotation("old"); err != nil || found {
+		t.Errorf("expected old not to be copied, got %v %v", found, err)
+	}
+	// Copying again, like resuming a copy, replaces the annotations.
+	if n, err := Copy(dst, src, day(-1), nil); err != nil || n != 3 {
+		t.Fatalf("expected a, c and d copied again, got %v %v", n, err)
+	}
+	all, err := dst.GetAnnotations(nil, nil)
+	if err != nil || len(all) != 4 {
+		t.Errorf("expected 4 annotations, got %v %v", all, err)
+	}
+	if values, err := dst.GetFieldValues(annotate.Category); err != nil || !reflect.DeepEqual(values, []string{"test"}) {
+		t.Errorf("expected category test, got %v %v", values, err)
+	}
+}
@@ -0,0 +1,145 @@
+// Command server is a standalone annotate server. It serves the annotate
+// API and UI and stores annotations in Elastic or Redis.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"bosun.org/annotate/backend"
+	"bosun.org/annotate/web"
+	"github.com/BurntSushi/toml"
+	"github.com/garyburd/redigo/redis"
+	"github.com/gorilla/mux"
+	elastic6 "github.com/olivere/elastic"
+	elastic7 "github.com/olivere/elastic/v7"
+	elastic2 "gopkg.in/olivere/elastic.v3"
+	elastic5 "gopkg.in/olivere/elastic.v5"
+)
+
+type Conf struct {
+	ListenAddress   string
+	ElasticClusters []ElasticCluster
+	Redis           RedisConf
+	LocalAssets     bool // Serve the UI from annotate/web/static instead of the binary
+}
+
+type ElasticCluster struct {
+	Servers []string
+	Index   string
+	Version string // v2, v5, v6 or v7 (default)
+}
+
+// RedisConf stores annotations in redis or ledis instead of Elastic. The
+// ElasticClusters are then only used as the source for -migrate.
+type RedisConf struct {
+	Host     string
+	DB       int
+	Password string
+	Prefix   string // Prefix of all keys, empty by default
+}
+
+var (
+	flagConf    = flag.String("c", "config.toml", "config file location")
+	flagMigrate = flag.Bool("migrate", false, "copy all annotations from the ElasticClusters to Redis and exit")
+	flagSince   = flag.String("since", backend.CopySince.Format("2006-01-02"), "with -migrate, copy the annotations ending since this date, to resume a migration that failed")
+)
+
+func main() {
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	flag.Parse()
+	var c Conf
+	if _, err := toml.DecodeFile(*flagConf, &c); err != nil {
+		log.Fatal(err)
+	}
+	var elastics []backend.Backend
+	for _, cluster := range c.ElasticClusters {
+		index := cluster.Index
+		if index == "" {
+			index = "annotate"
+		}
+		var b backend.Backend
+		switch cluster.Version {
+		case "v2":
+			b = backend.NewElastic2(cluster.Servers, false, index, []elastic2.ClientOptionFunc{})
+		case "v5":
+			b = backend.NewElastic5(cluster.Servers, false, index, []elastic5.ClientOptionFunc{})
+		case "v6":
+			b = backend.NewElastic6(cluster.Servers, false, index, []elastic6.ClientOptionFunc{})
+		case "v7", "":
+			b = backend.NewElastic7(cluster.Servers, false, index, []elastic7.ClientOptionFunc{})
+		default:
+			log.Fatalf("invalid Elastic version %q for %v", cluster.Version, cluster.Servers)
+		}
+		elastics = append(elastics, b)
+	}
+	var backends []backend.Backend
+	var store backend.Backend
+	if c.Redis.Host != "" {
+		pool := &redis.Pool{
+			MaxIdle: 10,
+			Dial: func() (redis.Conn, error) {
+				conn, err := redis.Dial("tcp", c.Redis.Host, redis.DialDatabase(c.Redis.DB))
+				if err != nil {
+					return nil, err
+				}
+				if c.Redis.Password != "" {
+					if _, err := conn.Do("AUTH", c.Redis.Password); err != nil {
+						conn.Close()
+						return nil, err
+					}
+				}
+				return conn, nil
+			},
+		}
+		store = backend.NewRedis(pool, c.Redis.Prefix)
+		backends = []backend.Backend{store}
+	} else {
+		backends = elastics
+	}
+	if *flagMigrate && store == nil {
+		log.Fatal("-migrate requires Redis to be configured")
+	}
+	// The Elastic clusters are only used with Redis as the store to migrate
+	// from, and are not required to be reachable otherwise.
+	initialize := backends
+	if *flagMigrate {
+		initialize = append(initialize, elastics...)
+	}
+	for _, b := range initialize {
+		if err := b.InitBackend(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *flagMigrate {
+		since, err := time.Parse("2006-01-02", *flagSince)
+		if err != nil {
+			log.Fatalf("bad -since: %v", err)
+		}
+		for i, b := range elastics {
+			n, err := backend.Copy(store, b, since, func(day time.Time, n int) {
+				log.Printf("%v: copied %d annotations up to %s", c.ElasticClusters[i].Servers, n, day.Format("2006-01-02"))
+			})
+			if err != nil {
+				log.Fatalf("%v: copied %d annotations: %v", c.ElasticClusters[i].Servers, n, err)
+			}
+			log.Printf("%v: copied %d annotations", c.ElasticClusters[i].Servers, n)
+		}
+		os.Exit(0)
+	}
+	if len(backends) == 0 {
+		log.Fatal("no ElasticClusters or Redis configured")
+	}
+	router := mux.NewRouter()
+	if err := web.AddRoutes(router, "", backends, true, c.LocalAssets); err != nil {
+		log.Fatal(err)
+	}
+	if c.ListenAddress == "" {
+		c.ListenAddress = ":8070"
+	}
+	log.Println("listening on", c.ListenAddress)
+	log.Fatal(http.ListenAndServe(c.ListenAddress, router))
+}
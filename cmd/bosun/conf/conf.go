@@ -8,7 +8,9 @@ import (
 	"net/mail"
 	"net/url"
 	"os/exec"
+	"path"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +23,7 @@ import (
 	"bosun.org/opentsdb"
 	"bosun.org/slog"
 	"github.com/influxdata/influxdb/client/v2"
+	"github.com/robfig/cron/v3"
 )
 
 // SystemConfProvider providers all the information about the system configuration.
@@ -55,6 +58,7 @@ type SystemConfProvider interface {
 	GetAlertCheckDistribution() string
 	GetUnknownThreshold() int
 	GetMinGroupSize() int
+	GetNotificationRateLimit() *RateLimit
 
 	GetShortURLKey() string
 	GetInternetProxy() string
@@ -66,13 +70,20 @@ type SystemConfProvider interface {
 
 	SetTSDBHost(tsdbHost string)
 	GetTSDBHost() string
+	GetTSDBCoalesceQueries() bool
 
+	GetAnnotateBackend() string
 	GetAnnotateElasticHosts() expr.ElasticConfig
 	GetAnnotateIndex() string
 
 	GetAuthConf() *AuthConf
 
 	GetMaxRenderedTemplateAge() int
+	GetMaxAlertRuns() int
+
+	GetCardinalityConf() CardinalityConf
+
+	GetClusterConf() ClusterConf
 
 	GetExampleExpression() string
 
@@ -85,6 +96,7 @@ type SystemConfProvider interface {
 	GetCloudWatchContext() cloudwatch.Context
 	GetPromContext() expr.PromClients
 	AnnotateEnabled() bool
+	AutoAnnotateEnabled() bool
 
 	MakeLink(string, *url.Values) string
 	EnabledBackends() EnabledBackends
@@ -125,6 +137,11 @@ type RuleConfProvider interface {
 
 	GetLookup(string) *Lookup
 
+	GetReports() map[string]*Report
+
+	GetProblemRules() map[string]*ProblemRule
+	GetProblemRule(string) *ProblemRule
+
 	AlertSquelched(*Alert) func(opentsdb.TagSet) bool
 	Squelched(*Alert, opentsdb.TagSet) bool
 	Expand(string, map[string]string, bool) string
@@ -286,6 +303,16 @@ type Notification struct {
 	UnknownMinGroupSize *int // nil means use global defaults. 0 means no-grouping at all.
 	UnknownThreshold    *int // nil means use global defaults. 0 means no limit
 
+	// RateLimit limits the alert notifications sent by this notification,
+	// nil means no limit. The notifications over the limit are sent in a
+	// summary rendered with RateLimitedTemplateKeys.
+	RateLimit               *RateLimit `json:",omitempty"`
+	RateLimitedTemplateKeys NotificationTemplateKeys
+
+	// AutoClose closes the incidents this notification was sent for, unless
+	// their alert has its own policy.
+	AutoClose *AutoClose `json:",omitempty"`
+
 	NextName        string `json:"-"`
 	RawEmail        string `json:"-"`
 	RawPost, RawGet string `json:"-"`
@@ -293,6 +320,89 @@ type Notification struct {
 	Locator `json:"-"`
 }
 
+// AutoClose is a policy to close incidents automatically once they have
+// returned to normal.
+type AutoClose struct {
+	// After is how long the incident must have been normal.
+	After time.Duration
+	// Unacknowledged only closes incidents that were never acknowledged.
+	Unacknowledged bool
+	// Warning only closes incidents that were never worse than warning.
+	Warning bool
+}
+
+// Matches returns if the incident st, which has been normal for d, should
+// be closed by the policy.
+func (p *AutoClose) Matches(st *models.IncidentState, d time.Duration) bool {
+	if d < p.After {
+		return false
+	}
+	if p.Warning && st.WorstStatus > models.StWarning {
+		return false
+	}
+	if p.Unacknowledged {
+		for _, a := range st.Actions {
+			if a.Type == models.ActionAcknowledge {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// String describes the policy, like "normal for 30m0s and never acknowledged".
+func (p *AutoClose) String() string {
+	conds := []string{fmt.Sprintf("normal for %v", p.After)}
+	if p.Unacknowledged {
+		conds = append(conds, "never acknowledged")
+	}
+	if p.Warning {
+		conds = append(conds, "only warning")
+	}
+	return strings.Join(conds, " and ")
+}
+
+// A RateLimit allows Count notifications every Per. It is enforced by token
+// buckets holding up to Count tokens, which refill at Count tokens per Per.
+type RateLimit struct {
+	Count int
+	Per   time.Duration
+}
+
+// ParseRateLimit parses a rate limit of the form count/duration, like 10/1h.
+func ParseRateLimit(s string) (*RateLimit, error) {
+	sp := strings.Split(s, "/")
+	if len(sp) != 2 {
+		return nil, fmt.Errorf("bad rate limit %q, expected count/duration like 10/1h", s)
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(sp[0]))
+	if err != nil || count <= 0 {
+		return nil, fmt.Errorf("bad rate limit %q: count must be a positive integer", s)
+	}
+	d, err := opentsdb.ParseDuration(strings.TrimSpace(sp[1]))
+	if err != nil {
+		return nil, fmt.Errorf("bad rate limit %q: %v", s, err)
+	}
+	if d <= 0 {
+		return nil, fmt.Errorf("bad rate limit %q: duration must be positive", s)
+	}
+	return &RateLimit{Count: count, Per: time.Duration(d)}, nil
+}
+
+// UnmarshalText is the method called by TOML when decoding a value
+func (r *RateLimit) UnmarshalText(text []byte) error {
+	p, err := ParseRateLimit(string(text))
+	if err != nil {
+		return err
+	}
+	*r = *p
+	return nil
+}
+
+func (r *RateLimit) String() string {
+	return fmt.Sprintf("%d/%v", r.Count, r.Per)
+}
+
 // Vars holds a map of variable names to the variable's value
 type Vars map[string]string
 
@@ -419,6 +529,23 @@ type Macro struct {
 	Locator `json:"-"`
 }
 
+// Func is a user defined expression function. A call of it is replaced by
+// Body, in which $name is replaced by the argument of the parameter name.
+type Func struct {
+	Text    string
+	Name    string
+	Args    []FuncArg
+	Return  models.FuncType
+	Body    string
+	Locator `json:"-"`
+}
+
+// FuncArg is a parameter of a Func.
+type FuncArg struct {
+	Name string
+	Type models.FuncType
+}
+
 // Alert stores all information about alerts. All other major
 // sections of rule configuration are referenced by alerts including
 // Templates, Macros, and Notifications. Alerts hold the expressions
@@ -439,6 +566,9 @@ type Alert struct {
 	WarnNotification *Notifications
 	Unknown          time.Duration
 	MaxLogFrequency  time.Duration
+	PendingDuration  time.Duration
+	RecoverAfter     time.Duration
+	AutoClose        *AutoClose `json:",omitempty"`
 	IgnoreUnknown    bool
 	UnknownsNormal   bool
 	UnjoinedOK       bool `json:",omitempty"`
@@ -453,6 +583,81 @@ type Alert struct {
 	AlertTemplateKeys map[string]*template.Template `json:"-"`
 }
 
+// Report is a template that is rendered and sent to notifications on a
+// schedule, independent of alerts and incidents. Reports are rendered like
+// alert templates, so they have the same template functions.
+type Report struct {
+	Text string
+	Vars
+	*Template     `json:"-"`
+	Name          string
+	Schedule      cron.Schedule            `json:"-"`
+	Notifications map[string]*Notification `json:"-"`
+	UnjoinedOK    bool                     `json:",omitempty"`
+
+	RawSchedule  string
+	TemplateName string `json:"-"`
+
+	Locator `json:"-"`
+	// TemplateKeys are the custom template keys used by the notifications.
+	TemplateKeys map[string]*template.Template `json:"-"`
+}
+
+// Alert returns an alert with the name, variables and template of r, so it
+// can be rendered like an alert.
+func (r *Report) Alert() *Alert {
+	return &Alert{
+		Text:              r.Text,
+		Vars:              r.Vars,
+		Template:          r.Template,
+		Name:              r.Name,
+		UnjoinedOK:        r.UnjoinedOK,
+		CritNotification:  &Notifications{Notifications: r.Notifications},
+		WarnNotification:  new(Notifications),
+		TemplateName:      r.TemplateName,
+		Locator:           r.Locator,
+		AlertTemplateKeys: r.TemplateKeys,
+	}
+}
+
+// ProblemRule correlates concurrent incidents, possibly of different alerts,
+// into a problem, so an outage that opens many incidents is handled once.
+// An incident is added to an open problem of the rule if it starts within
+// Window of the last incident of the problem and it shares a value of one
+// of the Tags with an incident of the problem, or, with Depends, the alert
+// of one depends on the alert of the other.
+type ProblemRule struct {
+	Text string
+	Vars
+	Name    string
+	Tags    []string
+	Window  time.Duration
+	Depends bool
+	// Alerts are the patterns of the names of the alerts whose incidents are
+	// correlated, like disk.*. All alerts if empty.
+	Alerts []string
+	// Notification, if not nil, is sent once per problem instead of the
+	// notifications of its incidents, and again when the problem worsens.
+	Notification *Notification `json:"-"`
+
+	NotificationName string `json:"-"`
+	Locator          `json:"-"`
+}
+
+// MatchAlert returns if the incidents of the alert name are correlated by
+// the rule.
+func (r *ProblemRule) MatchAlert(name string) bool {
+	if len(r.Alerts) == 0 {
+		return true
+	}
+	for _, pattern := range r.Alerts {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // A Locator stores the information about the location of the rule in the underlying
 // rule store
 type Locator interface{}
@@ -461,7 +666,7 @@ type Locator interface{}
 type BulkEditRequest []EditRequest
 
 // EditRequest is a proposed edit to the config file for sections. The Name is the name of section,
-// Type can be "alert", "template", "notification", "lookup", or "macro". The Text should be the full
+// Type can be "alert", "template", "notification", "lookup", "macro", "report", or "problem". The Text should be the full
 // text of the definition, including the declaration and brackets (i.e. "alert foo { .. }"). If Delete
 // is true then the section will be deleted. In order to rename something, specify the old name in the
 // Name field but have the Text definition contain the new name.
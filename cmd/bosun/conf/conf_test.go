@@ -3,6 +3,7 @@ package conf
 import (
 	"regexp"
 	"testing"
+	"time"
 
 	"bosun.org/opentsdb"
 )
@@ -66,3 +67,29 @@ func TestSquelch(t *testing.T) {
 		}
 	}
 }
+
+func TestParseRateLimit(t *testing.T) {
+	tests := []struct {
+		s     string
+		limit *RateLimit
+	}{
+		{"10/1h", &RateLimit{Count: 10, Per: time.Hour}},
+		{" 5 / 30m", &RateLimit{Count: 5, Per: 30 * time.Minute}},
+		{"10", nil},
+		{"0/1h", nil},
+		{"x/1h", nil},
+		{"10/x", nil},
+	}
+	for _, test := range tests {
+		limit, err := ParseRateLimit(test.s)
+		if test.limit == nil {
+			if err == nil {
+				t.Errorf("%q: expected error, got %v", test.s, limit)
+			}
+			continue
+		}
+		if err != nil || *limit != *test.limit {
+			t.Errorf("%q: expected %v, got %v, %v", test.s, test.limit, limit, err)
+		}
+	}
+}
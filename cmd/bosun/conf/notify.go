@@ -21,8 +21,8 @@ import (
 )
 
 const (
-	sendLogSuccessFmt = "%s; name: %s; transport: %s; dst: %s; body: %s"
-	sendLogErrorFmt   = "%s; name: %s; transport: %s; dst: %s; body: %s; error: %s"
+	sendLogSuccessFmt = "%s; dst: %s; body: %s"
+	sendLogErrorFmt   = "%s; dst: %s; body: %s; error: %s"
 	httpSendErrorFmt  = "bad response for '%s' %s notification using template key '%s' for alert keys %v method %s: %d"
 )
 
@@ -51,23 +51,20 @@ type PreparedNotifications struct {
 
 func (p *PreparedNotifications) Send(c SystemConfProvider) (errs []error) {
 	if p.Email != nil {
+		log := slog.With("notification", p.Name, "method", "email", "alertkey", p.Email.AK)
 		if err := p.Email.Send(c); err != nil {
-			slog.Errorf(
+			log.Errorf(
 				sendLogErrorFmt,
 				fmt.Sprintf("subject: %s", p.Email.Subject),
-				p.Name,
-				"email",
 				strings.Join(p.Email.To, ","),
 				p.Email.Body,
 				err.Error(),
 			)
 			errs = append(errs, err)
 		} else if p.Print {
-			slog.Infof(
+			log.Infof(
 				sendLogSuccessFmt,
 				fmt.Sprintf("subject: %s", p.Email.Subject),
-				p.Name,
-				"email",
 				strings.Join(p.Email.To, ","),
 				p.Email.Body,
 			)
@@ -80,23 +77,20 @@ func (p *PreparedNotifications) Send(c SystemConfProvider) (errs []error) {
 		} else {
 			logPrefix = "type: alert"
 		}
+		log := slog.With("notification", h.Details.NotifyName, "method", "http_"+h.Method, "alertkey", h.Details.Ak)
 		if _, err := h.Send(); err != nil {
-			slog.Errorf(
+			log.Errorf(
 				sendLogErrorFmt,
 				logPrefix,
-				h.Details.NotifyName,
-				"http_"+h.Method,
 				h.URL,
 				h.Body,
 				err.Error(),
 			)
 			errs = append(errs, err)
 		} else if p.Print {
-			slog.Infof(
+			log.Infof(
 				sendLogSuccessFmt,
 				logPrefix,
-				h.Details.NotifyName,
-				"http_"+h.Method,
 				h.URL,
 				h.Body,
 			)
@@ -166,6 +160,7 @@ const (
 	alert = iota + 1
 	unknown
 	multiunknown
+	ratelimited
 )
 
 type NotificationDetails struct {
@@ -230,6 +225,16 @@ func (p *PreparedHttp) Send() (int, error) {
 				p.Method,
 				resp.StatusCode,
 			)
+		case ratelimited:
+			return resp.StatusCode, fmt.Errorf(
+				httpSendErrorFmt,
+				p.Details.NotifyName,
+				"rate-limited",
+				p.Details.TemplateKey,
+				strings.Join(p.Details.Ak, ","),
+				p.Method,
+				resp.StatusCode,
+			)
 		default:
 			return resp.StatusCode, fmt.Errorf(
 				httpSendErrorFmt,
@@ -310,11 +315,11 @@ func (p *PreparedEmail) Send(c SystemConfProvider) error {
 	e.Headers.Add("X-Bosun-Server", util.GetHostManager().GetHostName())
 	if err := sendEmail(e, c.GetSMTPHost(), c.GetSMTPUsername(), c.GetSMTPPassword()); err != nil {
 		collect.Add("email.sent_failed", nil, 1)
-		slog.Errorf("failed to send alert %v to %v %v\n", p.AK, e.To, err)
+		slog.With("alertkey", p.AK).Errorf("failed to send alert to %v %v\n", e.To, err)
 		return err
 	}
 	collect.Add("email.sent", nil, 1)
-	slog.Infof("relayed email %v to %v sucessfully. Subject: %d bytes. Body: %d bytes.", p.AK, e.To, len(e.Subject), len(e.HTML))
+	slog.With("alertkey", p.AK).Infof("relayed email to %v sucessfully. Subject: %d bytes. Body: %d bytes.", e.To, len(e.Subject), len(e.HTML))
 	return nil
 }
 
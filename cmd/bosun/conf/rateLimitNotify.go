@@ -0,0 +1,94 @@
+package conf
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"time"
+
+	"bosun.org/cmd/bosun/conf/template"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+var rateLimitedDefaults defaultTemplates
+
+type rateLimitedContext struct {
+	Time     time.Time
+	Name     string
+	States   []*models.IncidentState
+	makeLink func(string, *url.Values) string
+}
+
+func (r *rateLimitedContext) IncidentLink(i int64) string {
+	return r.makeLink("/incident", &url.Values{
+		"id": []string{fmt.Sprint(i)},
+	})
+}
+
+func init() {
+	subject := `{{.Name}}: and {{.States | len}} more incidents`
+	body := `
+	<p>Time: {{.Time}}
+	<p>The rate limit of notification {{.Name}} was reached. Notifications of the
+	following incidents were not sent.
+	<ul>
+	{{ range .States }}
+		<li><a href="{{ $.IncidentLink .Id }}">#{{ .Id }}</a> {{ .Subject }}</li>
+	{{ end }}
+	</ul>
+	`
+	rateLimitedDefaults.subject = template.Must(template.New("subject").Parse(subject))
+	rateLimitedDefaults.body = template.Must(template.New("body").Parse(body))
+}
+
+// PrepareRateLimited prepares the summary of the incidents whose alert
+// notifications were not sent because of rate limits.
+func (n *Notification) PrepareRateLimited(t *Template, c SystemConfProvider, states []*models.IncidentState) *PreparedNotifications {
+	ctx := &rateLimitedContext{
+		Time:     time.Now().UTC(),
+		Name:     n.Name,
+		States:   states,
+		makeLink: c.MakeLink,
+	}
+	pn := &PreparedNotifications{Name: n.Name, Print: n.Print}
+	buf := &bytes.Buffer{}
+	render := func(key string, defaultTmpl *template.Template) (string, error) {
+		tpl := defaultTmpl
+		if key != "" {
+			tpl = t.Get(key)
+		} else {
+			key = "default"
+		}
+		buf.Reset()
+		err := tpl.Execute(buf, ctx)
+		if err != nil {
+			e := fmt.Sprintf("executing rate limited template '%s': %s", key, err)
+			pn.Errors = append(pn.Errors, e)
+			slog.Errorf(e)
+			return "", err
+		}
+		return buf.String(), nil
+	}
+
+	tks := n.RateLimitedTemplateKeys
+
+	ak := []string{}
+	for _, st := range states {
+		ak = append(ak, string(st.AlertKey))
+	}
+
+	details := &NotificationDetails{
+		NotifyName:  n.Name,
+		TemplateKey: tks.BodyTemplate,
+		Ak:          ak,
+		NotifyType:  ratelimited,
+	}
+
+	n.prepareFromTemplateKeys(pn, tks, render, rateLimitedDefaults, details)
+	return pn
+}
+
+func (n *Notification) NotifyRateLimited(t *Template, c SystemConfProvider, states []*models.IncidentState) {
+	go n.PrepareRateLimited(t, c, states).Send(c)
+}
@@ -0,0 +1,332 @@
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule/parse"
+	"bosun.org/cmd/bosun/expr"
+	eparse "bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/models"
+)
+
+// funcTypes are the types of the parameters and return values of funcs, by
+// the names used for them in error messages of expressions.
+var funcTypes = map[string]models.FuncType{
+	"string": models.TypeString,
+	"scalar": models.TypeScalar,
+	"number": models.TypeNumberSet,
+	"series": models.TypeSeriesSet,
+}
+
+// funcNameRE matches the names of funcs and of their parameters, which may
+// only contain letters like the names of expression functions.
+var funcNameRE = regexp.MustCompile(`^[a-zA-Z]+$`)
+
+// funcCallRE matches the calls of functions in an expression.
+var funcCallRE = regexp.MustCompile(`([a-zA-Z]+)\s*\(`)
+
+func (c *Conf) loadFunc(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Funcs[name]; ok {
+		c.errorf("duplicate func name: %s", name)
+	}
+	if !funcNameRE.MatchString(name) {
+		c.errorf("func names may only contain letters: %s", name)
+	}
+	funcs := c.GetFuncs(c.backends)
+	// isFunc reports whether n is the name of an expression function or of
+	// a func, including the funcs defined after this one.
+	isFunc := func(n string) bool {
+		if _, ok := funcs[n]; ok {
+			return true
+		}
+		if _, ok := expr.Builtins()[n]; ok {
+			return true
+		}
+		for _, ds := range c.deferredSections["func"] {
+			if ds.SectionNode.Name.Text == n {
+				return true
+			}
+		}
+		return false
+	}
+	_, ok := funcs[name]
+	if _, builtin := expr.Builtins()[name]; ok || builtin {
+		c.errorf("func %s is already an expression function", name)
+	}
+	f := conf.Func{
+		Name: name,
+	}
+	f.Text = s.RawText
+	f.Locator = newSectionLocator(s)
+	pairs := make(map[string]*parse.PairNode)
+	saw := make(map[string]bool)
+	for _, n := range s.Nodes.Nodes {
+		c.at(n)
+		p, ok := n.(*parse.PairNode)
+		if !ok {
+			c.errorf("unexpected node")
+		}
+		c.seen(p.Key.Text, saw)
+		switch p.Key.Text {
+		case "args", "return", "expr":
+			pairs[p.Key.Text] = p
+		default:
+			c.errorf("unknown key %s", p.Key.Text)
+		}
+	}
+	params := make(map[string]bool)
+	hasString := false
+	if p := pairs["args"]; p != nil {
+		c.at(p)
+		for _, a := range strings.Split(c.Expand(p.Val.Text, nil, false), ",") {
+			fields := strings.Fields(a)
+			if len(fields) != 2 {
+				c.errorf("bad parameter %q: expected a name and a type", strings.TrimSpace(a))
+			}
+			arg := conf.FuncArg{Name: fields[0]}
+			if !funcNameRE.MatchString(arg.Name) {
+				c.errorf("parameter names may only contain letters: %s", arg.Name)
+			}
+			if params[arg.Name] {
+				c.errorf("duplicate parameter %s", arg.Name)
+			}
+			// Parameters are expanded to calls named like them, so they
+			// can not be named like a function.
+			if isFunc(arg.Name) {
+				c.errorf("parameter %s is named like a function", arg.Name)
+			}
+			if _, ok := c.Vars["$"+arg.Name]; ok {
+				c.errorf("parameter %s shadows the variable $%s", arg.Name, arg.Name)
+			}
+			t, ok := funcTypes[fields[1]]
+			if !ok {
+				c.errorf("unknown type %s of parameter %s", fields[1], arg.Name)
+			}
+			arg.Type = t
+			hasString = hasString || t == models.TypeString
+			params[arg.Name] = true
+			f.Args = append(f.Args, arg)
+		}
+	}
+	p := pairs["return"]
+	if p == nil {
+		c.at(s)
+		c.errorf("func requires a return type")
+	}
+	c.at(p)
+	v := strings.TrimSpace(c.Expand(p.Val.Text, nil, false))
+	t, ok := funcTypes[v]
+	if !ok {
+		c.errorf("unknown return type %s", v)
+	}
+	f.Return = t
+	p = pairs["expr"]
+	if p == nil {
+		c.at(s)
+		c.errorf("func requires an expr")
+	}
+	c.at(p)
+	f.Body = c.Expand(p.Val.Text, nil, true)
+	for _, v := range exRE.FindAllString(f.Body, -1) {
+		if !params[strings.Trim(v[1:], "{}")] {
+			c.errorf("unknown variable %s", v)
+		}
+	}
+	// Funcs are loaded in order, so a func can not call itself or a func
+	// calling it.
+	later := make(map[string]bool)
+	for _, ds := range c.deferredSections["func"] {
+		if _, ok := c.Funcs[ds.SectionNode.Name.Text]; !ok {
+			later[ds.SectionNode.Name.Text] = true
+		}
+	}
+	for _, m := range funcCallRE.FindAllStringSubmatch(f.Body, -1) {
+		if later[m[1]] {
+			c.errorf("func %s calls %s, which is not defined before it", name, m[1])
+		}
+	}
+	// The expr can only be checked now if no parameter is a string, since
+	// strings are replaced by the text of the arguments of the call.
+	if !hasString {
+		if _, err := parseFunc(&f, funcs, nil); err != nil {
+			c.error(err)
+		}
+	}
+	c.Funcs[name] = &f
+}
+
+// expandFunc returns the body of f with its string parameters replaced by
+// their argument in strs, and its other parameters by a call of a function
+// named like the parameter, like errors() for $errors.
+func expandFunc(f *conf.Func, strs map[string]string) string {
+	return exRE.ReplaceAllStringFunc(f.Body, func(s string) string {
+		name := strings.Trim(s[1:], "{}")
+		if v, ok := strs[name]; ok {
+			return v
+		}
+		return name + "()"
+	})
+}
+
+// parseFunc parses the body of f called with the arguments args, checking
+// that it returns the type of f. If args is nil, the body is checked without
+// tags of the arguments.
+func parseFunc(f *conf.Func, funcs map[string]eparse.Func, args []eparse.Node) (*expr.Expr, error) {
+	strs := make(map[string]string)
+	params := make(map[string]eparse.Func)
+	for i, a := range f.Args {
+		var arg eparse.Node
+		if args != nil {
+			arg = args[i]
+		}
+		if a.Type == models.TypeString {
+			s, ok := arg.(*eparse.StringNode)
+			if !ok {
+				return nil, fmt.Errorf("%s: argument %s must be a string literal", f.Name, a.Name)
+			}
+			strs[a.Name] = s.Text
+			continue
+		}
+		p := eparse.Func{Return: a.Type}
+		if a.Type != models.TypeScalar {
+			p.Tags = func([]eparse.Node) (eparse.Tags, error) {
+				if arg == nil {
+					return nil, nil
+				}
+				return arg.Tags()
+			}
+		}
+		params[a.Name] = p
+	}
+	e, err := expr.New(expandFunc(f, strs), params, funcs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", f.Name, err)
+	}
+	if r := e.Root.Return(); r != f.Return {
+		return nil, fmt.Errorf("%s: expr returns %v, not %v", f.Name, r, f.Return)
+	}
+	return e, nil
+}
+
+// userFunc returns the expression function of f. Its calls are checked and
+// evaluated by parsing the body of f with the arguments, so the functions of
+// the body are looked up in funcs when f is called.
+func userFunc(f *conf.Func, funcs map[string]eparse.Func) eparse.Func {
+	args := make([]models.FuncType, len(f.Args))
+	for i, a := range f.Args {
+		args[i] = a.Type
+	}
+	uf := eparse.Func{
+		Args:   args,
+		Return: f.Return,
+		Check: func(t *eparse.Tree, n *eparse.FuncNode) error {
+			if _, err := parseFunc(f, funcs, n.Args); err != nil {
+				return err
+			}
+			// Evaluate this call knowing its arguments, so the expansion
+			// in the computations shows them.
+			call := *n.F
+			call.F = callFunc(f, funcs, n.Args)
+			n.F = &call
+			return nil
+		},
+	}
+	// Only functions returning sets have tags.
+	if f.Return == models.TypeNumberSet || f.Return == models.TypeSeriesSet {
+		uf.Tags = func(args []eparse.Node) (eparse.Tags, error) {
+			e, err := parseFunc(f, funcs, args)
+			if err != nil {
+				return nil, err
+			}
+			return e.Root.Tags()
+		}
+	}
+	uf.F = callFunc(f, funcs, nil)
+	return uf
+}
+
+// callFunc returns the evaluation of a call of f with the arguments nodes.
+// Without nodes, the expansion of the call in the computations shows the
+// non-string parameters like errors() for $errors.
+func callFunc(f *conf.Func, funcs map[string]eparse.Func, nodes []eparse.Node) func(*expr.State, ...interface{}) (*expr.Results, error) {
+	return func(s *expr.State, args ...interface{}) (*expr.Results, error) {
+		strs := make(map[string]string)
+		params := make(map[string]eparse.Func)
+		for i, a := range f.Args {
+			var res *expr.Results
+			switch v := args[i].(type) {
+			case string:
+				strs[a.Name] = v
+				continue
+			case float64:
+				res = &expr.Results{
+					Results: expr.ResultSlice{{Value: expr.Scalar(v)}},
+				}
+			case *expr.Results:
+				// The results are copied, so the computations of the
+				// body are not added to those of the argument.
+				res = &expr.Results{
+					IgnoreUnjoined:      v.IgnoreUnjoined,
+					IgnoreOtherUnjoined: v.IgnoreOtherUnjoined,
+					NaNValue:            v.NaNValue,
+				}
+				for _, r := range v.Results {
+					cp := *r
+					cp.Computations = append(models.Computations(nil), r.Computations...)
+					res.Results = append(res.Results, &cp)
+				}
+			default:
+				return nil, fmt.Errorf("%s: unexpected argument %v", f.Name, v)
+			}
+			p := eparse.Func{
+				Return: a.Type,
+				F: func(*expr.State) (*expr.Results, error) {
+					return res, nil
+				},
+			}
+			if a.Type != models.TypeScalar {
+				p.Tags = func([]eparse.Node) (eparse.Tags, error) {
+					return nil, nil
+				}
+			}
+			params[a.Name] = p
+		}
+		text := expandFunc(f, strs)
+		e, err := expr.New(text, params, funcs)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", f.Name, err)
+		}
+		results, _, err := e.ExecuteState(s)
+		if err != nil {
+			return nil, err
+		}
+		// Show the expansion of the call in the computations, with the
+		// expressions of the arguments in place of the parameters.
+		shown := text
+		if len(nodes) == len(f.Args) {
+			exprs := make(map[string]string)
+			for i, a := range f.Args {
+				if str, ok := strs[a.Name]; ok {
+					exprs[a.Name] = str
+					continue
+				}
+				exprs[a.Name] = nodes[i].String()
+				if _, ok := nodes[i].(*eparse.BinaryNode); ok {
+					exprs[a.Name] = "(" + exprs[a.Name] + ")"
+				}
+			}
+			shown = expandFunc(f, exprs)
+		}
+		for _, r := range results.Results {
+			switch v := r.Value.(type) {
+			case expr.Number, expr.Scalar:
+				s.AddComputation(r, shown, v)
+			}
+		}
+		return results, nil
+	}
+}
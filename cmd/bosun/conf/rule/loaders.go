@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/mail"
 	"net/url"
+	"path"
 	"regexp"
 	"strconv"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	eparse "bosun.org/cmd/bosun/expr/parse"
 	"bosun.org/models"
 	"bosun.org/opentsdb"
+	"github.com/robfig/cron/v3"
 )
 
 func (c *Conf) loadTemplate(s *parse.SectionNode) {
@@ -217,6 +219,31 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 				c.errorf("max log frequency must be at least 1s")
 			}
 			a.MaxLogFrequency = d
+		case "for", "pendingDuration":
+			od, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			d := time.Duration(od)
+			if d < time.Second {
+				c.errorf("pending duration must be at least 1s")
+			}
+			a.PendingDuration = d
+		case "recoverAfter":
+			od, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			d := time.Duration(od)
+			if d < time.Second {
+				c.errorf("recover after duration must be at least 1s")
+			}
+			a.RecoverAfter = d
+		case "autoClose", "autoCloseIf":
+			if a.AutoClose == nil {
+				a.AutoClose = &conf.AutoClose{}
+			}
+			c.loadAutoClose(a.AutoClose, p.key, v)
 		case "unjoinedOk":
 			a.UnjoinedOK = true
 		case "ignoreUnknown":
@@ -320,6 +347,7 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			checkTplKeys(&not.NotificationTemplateKeys, "alert", true)
 			checkTplKeys(&not.UnknownTemplateKeys, "unknown", false)
 			checkTplKeys(&not.UnknownMultiTemplateKeys, "unknownMulti", false)
+			checkTplKeys(&not.RateLimitedTemplateKeys, "rateLimited", false)
 			for at, ntk := range not.ActionTemplateKeys {
 				key := at.String()
 				if at == models.ActionNone {
@@ -336,6 +364,29 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 	c.Alerts[name] = &a
 }
 
+// loadAutoClose sets the autoClose duration or the autoCloseIf conditions
+// of the auto close policy p of an alert or notification.
+func (c *Conf) loadAutoClose(p *conf.AutoClose, key, v string) {
+	if key == "autoClose" {
+		od, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			c.error(err)
+		}
+		p.After = time.Duration(od)
+		return
+	}
+	for _, cond := range strings.Split(v, ",") {
+		switch strings.TrimSpace(cond) {
+		case "unacknowledged":
+			p.Unacknowledged = true
+		case "warning":
+			p.Warning = true
+		default:
+			c.errorf("unknown autoCloseIf condition %s, expected unacknowledged or warning", cond)
+		}
+	}
+}
+
 func (c *Conf) loadNotification(s *parse.SectionNode) {
 	name := s.Name.Text
 	if _, ok := c.Notifications[name]; ok {
@@ -427,12 +478,24 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 				c.error(err)
 			}
 			n.UnknownThreshold = &i
+		case "autoClose", "autoCloseIf":
+			if n.AutoClose == nil {
+				n.AutoClose = &conf.AutoClose{}
+			}
+			c.loadAutoClose(n.AutoClose, k, v)
+		case "rateLimit":
+			r, err := conf.ParseRateLimit(v)
+			if err != nil {
+				c.error(err)
+			}
+			n.RateLimit = r
 		default:
 			// all special template keys are handled in one loop
 			// the following formats are possible:
 			// action(templateKey)(ActionType})?   //action
 			// unknown(TemplateKey)                //unknown
 			// unknownMulti(TemplateKey)           //unknown
+			// rateLimited(TemplateKey)            //rate limit summary
 			var keys *conf.NotificationTemplateKeys
 			keyType := k
 			if strings.HasPrefix(k, "action") {
@@ -453,6 +516,9 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 					n.ActionTemplateKeys[at] = &conf.NotificationTemplateKeys{}
 				}
 				keys = n.ActionTemplateKeys[at]
+			} else if strings.HasPrefix(k, "rateLimited") {
+				keys = &n.RateLimitedTemplateKeys
+				keyType = strings.TrimPrefix(k, "rateLimited")
 			} else if strings.HasPrefix(k, "unknownMulti") {
 				keys = &n.UnknownMultiTemplateKeys
 				keyType = strings.TrimPrefix(k, "unknownMulti")
@@ -484,3 +550,133 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 		c.errorf("timeout specified without next")
 	}
 }
+
+func (c *Conf) loadReport(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Reports[name]; ok {
+		c.errorf("duplicate report name: %s", name)
+	}
+	r := conf.Report{
+		Vars:          make(map[string]string),
+		Name:          name,
+		Notifications: make(map[string]*conf.Notification),
+		TemplateKeys:  map[string]*template.Template{},
+	}
+	r.Text = s.RawText
+	r.Locator = newSectionLocator(s)
+	pairs := c.getPairs(s, r.Vars, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "schedule":
+			sched, err := cron.ParseStandard(v)
+			if err != nil {
+				c.errorf("bad schedule %q: %v", v, err)
+			}
+			r.RawSchedule = v
+			r.Schedule = sched
+		case "template":
+			r.TemplateName = v
+			t, ok := c.Templates[r.TemplateName]
+			if !ok {
+				c.errorf("template not found %s", r.TemplateName)
+			}
+			r.Template = t
+		case "notification":
+			n, err := c.parseNotifications(v)
+			if err != nil {
+				c.error(err)
+			}
+			for k, v := range n {
+				r.Notifications[k] = v
+			}
+		case "unjoinedOk":
+			r.UnjoinedOK = true
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if r.Schedule == nil {
+		c.errorf("report requires a schedule")
+	}
+	if r.Template == nil {
+		c.errorf("report requires a template")
+	}
+	if r.Body == nil || r.Subject == nil {
+		c.errorf("report templates must have body and subject specified")
+	}
+	if len(r.Notifications) == 0 {
+		c.errorf("report requires a notification")
+	}
+	for _, n := range r.Notifications {
+		for _, key := range []string{n.BodyTemplate, n.EmailSubjectTemplate, n.GetTemplate, n.PostTemplate} {
+			if key == "" || key == "body" || key == "subject" {
+				continue
+			}
+			tmpl := r.CustomTemplates[key]
+			if tmpl == nil {
+				c.errorf("notification %s uses template key %s, but template %s does not include it", n.Name, key, r.Template.Name)
+			}
+			r.TemplateKeys[key] = tmpl
+		}
+	}
+	c.Reports[name] = &r
+}
+
+func (c *Conf) loadProblemRule(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.ProblemRules[name]; ok {
+		c.errorf("duplicate problem name: %s", name)
+	}
+	r := conf.ProblemRule{
+		Vars: make(map[string]string),
+		Name: name,
+	}
+	r.Text = s.RawText
+	r.Locator = newSectionLocator(s)
+	pairs := c.getPairs(s, r.Vars, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "tags":
+			for _, k := range strings.Split(v, ",") {
+				if k = strings.TrimSpace(k); k != "" {
+					r.Tags = append(r.Tags, k)
+				}
+			}
+		case "window":
+			od, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			r.Window = time.Duration(od)
+		case "depends":
+			r.Depends = true
+		case "alerts":
+			for _, a := range strings.Split(v, ",") {
+				a = strings.TrimSpace(a)
+				if _, err := path.Match(a, ""); err != nil {
+					c.errorf("bad alert pattern %s: %v", a, err)
+				}
+				r.Alerts = append(r.Alerts, a)
+			}
+		case "notification":
+			n, ok := c.Notifications[v]
+			if !ok {
+				c.errorf("unknown notification %s", v)
+			}
+			r.NotificationName = v
+			r.Notification = n
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if r.Window == 0 {
+		c.errorf("problem requires a window")
+	}
+	c.ProblemRules[name] = &r
+}
@@ -89,8 +89,23 @@ func (c *Conf) BulkEdit(edits conf.BulkEditRequest) error {
 			if m != nil {
 				l = m.Locator.(Location)
 			}
+		case "report":
+			r := newConf.GetReport(edit.Name)
+			if r != nil {
+				l = r.Locator.(Location)
+			}
+		case "problem":
+			r := newConf.GetProblemRule(edit.Name)
+			if r != nil {
+				l = r.Locator.(Location)
+			}
+		case "func":
+			f := newConf.GetFunc(edit.Name)
+			if f != nil {
+				l = f.Locator.(Location)
+			}
 		default:
-			return fmt.Errorf("%v is an unsuported type for bulk edit. must be alert, template, notification, lookup or macro", edit.Type)
+			return fmt.Errorf("%v is an unsuported type for bulk edit. must be alert, template, notification, lookup, macro, func, report or problem", edit.Type)
 		}
 		var rawConf string
 		if edit.Delete {
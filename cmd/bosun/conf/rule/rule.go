@@ -33,6 +33,9 @@ type Conf struct {
 	RawText       string
 	Macros        map[string]*conf.Macro
 	Lookups       map[string]*conf.Lookup
+	Funcs         map[string]*conf.Func
+	Reports       map[string]*conf.Report
+	ProblemRules  map[string]*conf.ProblemRule
 	Squelch       conf.Squelches `json:"-"`
 	NoSleep       bool
 
@@ -148,6 +151,9 @@ func NewConf(name string, backends conf.EnabledBackends, sysVars map[string]stri
 		customTemplates:  map[string]*template.Template{},
 		Lookups:          make(map[string]*conf.Lookup),
 		Macros:           make(map[string]*conf.Macro),
+		Funcs:            make(map[string]*conf.Func),
+		Reports:          make(map[string]*conf.Report),
+		ProblemRules:     make(map[string]*conf.ProblemRule),
 		writeLock:        make(chan bool, 1),
 		deferredSections: make(map[string][]deferredSection),
 		backends:         backends,
@@ -182,7 +188,10 @@ func NewConf(name string, backends conf.EnabledBackends, sysVars map[string]stri
 	loadSections("macro")
 	loadSections("notification")
 	loadSections("lookup")
+	loadSections("func")
 	loadSections("alert")
+	loadSections("report")
+	loadSections("problem")
 
 	c.genHash()
 	return
@@ -220,6 +229,12 @@ func (c *Conf) loadSection(s *parse.SectionNode) {
 		ds.LoadFunc = c.loadMacro
 	case "lookup":
 		ds.LoadFunc = c.loadLookup
+	case "func":
+		ds.LoadFunc = c.loadFunc
+	case "report":
+		ds.LoadFunc = c.loadReport
+	case "problem":
+		ds.LoadFunc = c.loadProblemRule
 	default:
 		c.errorf("unknown section type: %s", s.SectionType.Text)
 	}
@@ -686,6 +701,9 @@ func (c *Conf) GetFuncs(backends conf.EnabledBackends) map[string]eparse.Func {
 	if backends.CloudWatch {
 		merge(expr.CloudWatch)
 	}
+	for _, f := range c.Funcs {
+		funcs[f.Name] = userFunc(f, funcs)
+	}
 	return funcs
 }
 
@@ -787,6 +805,26 @@ func (c *Conf) GetLookup(s string) *conf.Lookup {
 	return c.Lookups[s]
 }
 
+func (c *Conf) GetFunc(s string) *conf.Func {
+	return c.Funcs[s]
+}
+
+func (c *Conf) GetReport(s string) *conf.Report {
+	return c.Reports[s]
+}
+
+func (c *Conf) GetReports() map[string]*conf.Report {
+	return c.Reports
+}
+
+func (c *Conf) GetProblemRule(s string) *conf.ProblemRule {
+	return c.ProblemRules[s]
+}
+
+func (c *Conf) GetProblemRules() map[string]*conf.ProblemRule {
+	return c.ProblemRules
+}
+
 func (c *Conf) GetSquelches() conf.Squelches {
 	return c.Squelch
 }
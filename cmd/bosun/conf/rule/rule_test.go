@@ -5,8 +5,13 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/expr"
+	"bosun.org/models"
+
+	"github.com/MiniProfiler/go/miniprofiler"
 )
 
 func TestPrint(t *testing.T) {
@@ -28,6 +33,12 @@ func TestPrint(t *testing.T) {
 	if w := c.Alerts["m"].Crit.Text; w != `avg(q("avg:a", "", "")) > 1` {
 		t.Errorf("bad crit: %v", w)
 	}
+	if a := c.Alerts["m"]; a.PendingDuration != 5*time.Minute || a.RecoverAfter != 10*time.Minute {
+		t.Errorf("bad pending duration or recover after: %v, %v", a.PendingDuration, a.RecoverAfter)
+	}
+	if a := c.Alerts["m"]; a.AutoClose == nil || *a.AutoClose != (conf.AutoClose{After: 30 * time.Minute, Unacknowledged: true, Warning: true}) {
+		t.Errorf("bad auto close: %v", a.AutoClose)
+	}
 	if w := c.Alerts["braceTest"].Crit.Text; w != `avg(q("avg:o{t=m}", "", "")) > 1` {
 		t.Errorf("bad crit: %v", w)
 	}
@@ -38,6 +49,31 @@ func TestPrint(t *testing.T) {
 		t.Errorf("bad lookup: %v", w)
 	}
 	checkMacroVarAlert(t, c.Alerts["macroVarAlert"])
+	if f := c.Funcs["cpuAbove"]; f == nil || len(f.Args) != 2 || f.Args[1].Type != models.TypeScalar || f.Return != models.TypeNumberSet {
+		t.Errorf("bad func: %v", f)
+	}
+	if tags, err := c.Alerts["funcAlert"].Warn.Root.Tags(); err != nil || tags.String() != "host" {
+		t.Errorf("bad func tags: %v, %v", tags, err)
+	}
+	r := c.Reports["daily"]
+	if r == nil || r.Template != c.Templates["generic"] || r.Notifications["default"] == nil || r.Vars["team"] != "sre" {
+		t.Fatalf("bad report: %v", r)
+	}
+	// Saturday, so the next report is on Monday.
+	now := time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	if next := r.Schedule.Next(now); !next.Equal(time.Date(2020, 1, 6, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad next report time: %v", next)
+	}
+	if l := c.Notifications["default"].RateLimit; l == nil || *l != (conf.RateLimit{Count: 10, Per: time.Hour}) {
+		t.Errorf("bad rate limit: %v", l)
+	}
+	p := c.ProblemRules["network"]
+	if p == nil || p.Window != 5*time.Minute || !p.Depends || len(p.Tags) != 1 || p.Notification != c.Notifications["default"] {
+		t.Fatalf("bad problem: %v", p)
+	}
+	if !p.MatchAlert("os.high_cpu") || !p.MatchAlert("m") || p.MatchAlert("nc") {
+		t.Errorf("bad problem alerts: %v", p.Alerts)
+	}
 }
 
 func checkMacroVarAlert(t *testing.T, a *conf.Alert) {
@@ -72,6 +108,11 @@ func TestInvalid(t *testing.T) {
 		"depends-no-overlap": `conf: depends-no-overlap:1:0: at <alert broken {\n	dep...>: Depends and crit/warn must share at least one tag.`,
 		"log-no-notification": `conf: log-no-notification:1:0: at <alert a {\n	crit = 1...>: log specified but no notification`,
 		"crit-notification-no-template": `conf: crit-notification-no-template:5:0: at <alert a {\n	crit = 1...>: notifications specified but no template`,
+		"report-no-notification":        `conf: report-no-notification:6:0: at <report r {\n	schedul...>: report requires a notification`,
+		"problem-no-window":             `conf: problem-no-window:1:0: at <problem p {\n	tags =...>: problem requires a window`,
+		"func-recursion":                `conf: func-recursion:4:1: at <expr = twice($x) * 2>: func twice calls twice, which is not defined before it`,
+		"func-param-builtin":            `conf: func-param-builtin:2:1: at <args = avg number>: parameter avg is named like a function`,
+		"func-param-func":               `conf: func-param-func:2:1: at <args = half number>: parameter half is named like a function`,
 	}
 	for fname, reason := range names {
 		path := filepath.Join("invalid", fname)
@@ -89,3 +130,41 @@ func TestInvalid(t *testing.T) {
 		}
 	}
 }
+
+func TestFunc(t *testing.T) {
+	c, err := NewConf("", conf.EnabledBackends{}, nil, `
+		func double {
+			args = x number
+			return = number
+			expr = $x * 2
+		}
+		func quadruple {
+			args = x number
+			return = number
+			expr = double(double($x))
+		}
+		alert a {
+			warn = quadruple(1.5)
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _, err := c.Alerts["a"].Warn.Execute(&expr.Backends{}, &expr.BosunProviders{}, new(miniprofiler.Profile), time.Now(), 0, false, t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 || r.Results[0].Value != expr.Number(6) {
+		t.Fatalf("bad results: %v", r.Results)
+	}
+	found := false
+	for _, comp := range r.Results[0].Computations {
+		found = found || comp.Text == "double(double(1.5))" && comp.Value == expr.Number(6)
+	}
+	if !found {
+		t.Errorf("expected computation of the expansion, got %v", r.Results[0].Computations)
+	}
+	if _, err := expr.New(`double("a")`, c.GetFuncs(c.backends)); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -47,6 +47,7 @@ type SystemConf struct {
 	MinGroupSize  int
 
 	UnknownThreshold       int
+	NotificationRateLimit  *RateLimit // Rate limit of all alert notifications: 100/1h
 	CheckFrequency         Duration // Time between alert checks: 5m
 	DefaultRunEvery        int      // Default number of check intervals to run each alert: 1
 	AlertCheckDistribution string   // Method to distribute alet checks. No distribution if equals ""
@@ -68,9 +69,14 @@ type SystemConf struct {
 	CloudWatchConf   CloudWatchConf
 	AnnotateConf     AnnotateConf
 
+	CardinalityConf CardinalityConf
+
+	ClusterConf ClusterConf
+
 	AuthConf *AuthConf
 
 	MaxRenderedTemplateAge int // in days
+	MaxAlertRuns           int // per alert
 
 	EnableSave      bool
 	EnableReload    bool
@@ -103,7 +109,7 @@ func (sc *SystemConf) EnabledBackends() EnabledBackends {
 	b.Influx = sc.InfluxConf.URL != ""
 	b.Prom = sc.PromConf["default"].URL != ""
 	b.Elastic = len(sc.ElasticConf["default"].Hosts) != 0
-	b.Annotate = len(sc.AnnotateConf.Hosts) != 0
+	b.Annotate = sc.AnnotateEnabled()
 	b.AzureMonitor = len(sc.AzureMonitorConf) != 0
 	b.CloudWatch = sc.CloudWatchConf.Enabled
 	return b
@@ -116,6 +122,9 @@ type OpenTSDBConf struct {
 	ResponseLimit int64
 	Host          string           // OpenTSDB relay and query destination: ny-devtsdb04:4242
 	Version       opentsdb.Version // If set to 2.2 , enable passthrough of wildcards and filters, and add support for groupby
+	// CoalesceQueries merges the queries of the alerts of a check cycle
+	// into fewer, wider queries. It requires version 2.2.
+	CoalesceQueries bool
 }
 
 // GraphiteConf contains a string representing the host of a graphite server and
@@ -125,13 +134,16 @@ type GraphiteConf struct {
 	Headers map[string]string
 }
 
-// AnnotateConf contains the elastic configuration to enable Annotations support
+// AnnotateConf contains the configuration to enable Annotations support
 type AnnotateConf struct {
-	Hosts         []string // CSV of Elastic Hosts, currently the only backend in annotate
+	Backend       string   // "elastic" (default) or "db" to store annotations in the DBConf database
+	Hosts         []string // CSV of Elastic Hosts
 	Version       string
 	SimpleClient  bool            // If true ES will connect over NewSimpleClient
 	ClientOptions ESClientOptions // ES client options
 	Index         string          // name of index / table
+
+	DisableAutomatic bool // If true bosun won't annotate incidents, actions, config saves and silences
 }
 
 // ESClientOptions: elastic search client options
@@ -157,6 +169,12 @@ type ESClientOptions struct {
 // ElasticConf contains configuration for an elastic host that Bosun can query
 type ElasticConf AnnotateConf
 
+// Annotation backends that can be set in AnnotateConf
+const (
+	AnnotateBackendElastic = "elastic"
+	AnnotateBackendDB      = "db"
+)
+
 // AzureConf contains configuration for an Azure metrics
 type AzureMonitorConf struct {
 	SubscriptionId string
@@ -253,6 +271,42 @@ type SMTPConf struct {
 	Password  string `json:"-"`
 }
 
+// CardinalityConf limits the number of series that bosun will index from
+// /api/put and /api/index. A limit of zero disables it.
+type CardinalityConf struct {
+	MaxSeriesPerMetric int    // Maximum number of tag sets of a single metric
+	MaxTagValues       int    // Maximum number of values of a tag key for a single metric
+	Action             string // "drop" (the default) discards data points of new series over a limit, "reject" fails the request
+}
+
+// Valid returns an error if the Action of the CardinalityConf is unknown
+func (c CardinalityConf) Valid() error {
+	switch c.Action {
+	case "", "drop", "reject":
+		return nil
+	}
+	return fmt.Errorf(`invalid Action %q, must be "drop" or "reject"`, c.Action)
+}
+
+// ClusterConf shards alert checks across bosun processes sharing a Redis
+// database. Alerts are assigned to processes by consistent hashing of their
+// names and are reassigned when processes join or leave.
+type ClusterConf struct {
+	Enabled           bool
+	Name              string   // Unique name of this process, defaults to the hostname and HTTP listen port
+	HeartbeatInterval Duration // How often this process announces it is alive: 10s
+	Timeout           Duration // Time without a heartbeat after which a process is considered gone: 3 heartbeat intervals
+}
+
+// Valid returns an error if the timeout of the ClusterConf does not allow
+// for missed heartbeats
+func (c ClusterConf) Valid() error {
+	if c.Timeout.Duration != 0 && c.Timeout.Duration < c.HeartbeatInterval.Duration {
+		return fmt.Errorf("Timeout %v is shorter than HeartbeatInterval %v", c.Timeout, c.HeartbeatInterval)
+	}
+	return nil
+}
+
 //AuthConf is configuration for bosun's authentication
 type AuthConf struct {
 	AuthDisabled bool
@@ -386,6 +440,15 @@ func loadSystemConfig(conf string, isFileName bool) (*SystemConf, error) {
 		if value.SimpleClient && value.ClientOptions.Enabled {
 			return sc, fmt.Errorf("Can't use both ES SimpleClient and ES ClientOptions please remove or disable one in ElasticConf.%s: %#v", hostPrefix, sc.ElasticConf)
 		}
+		if value.Backend != "" || value.DisableAutomatic {
+			return sc, fmt.Errorf("Backend and DisableAutomatic are only valid in AnnotateConf, not in ElasticConf.%s", hostPrefix)
+		}
+	}
+
+	switch sc.AnnotateConf.Backend {
+	case "", AnnotateBackendElastic, AnnotateBackendDB:
+	default:
+		return sc, fmt.Errorf("invalid value %v for AnnotateConf.Backend", sc.AnnotateConf.Backend)
 	}
 
 	if sc.AnnotateConf.SimpleClient && sc.AnnotateConf.ClientOptions.Enabled {
@@ -406,6 +469,17 @@ func loadSystemConfig(conf string, isFileName bool) (*SystemConf, error) {
 		}
 	}
 
+	if err := sc.CardinalityConf.Valid(); err != nil {
+		return sc, fmt.Errorf("error in CardinalityConf: %v", err)
+	}
+
+	if sc.ClusterConf.Enabled && len(sc.GetRedisHost()) == 0 {
+		return sc, fmt.Errorf("ClusterConf requires Redis: the processes of a cluster can't share ledis")
+	}
+	if err := sc.ClusterConf.Valid(); err != nil {
+		return sc, fmt.Errorf("error in ClusterConf: %v", err)
+	}
+
 	sc.md = decodeMeta
 	// clear default http listen if not explicitly specified
 	if !decodeMeta.IsDefined("HTTPListen") && decodeMeta.IsDefined("HTTPSListen") {
@@ -555,6 +629,12 @@ func (sc *SystemConf) GetUnknownThreshold() int {
 	return sc.UnknownThreshold
 }
 
+// GetNotificationRateLimit returns the rate limit of all alert notifications,
+// or nil if they are not limited
+func (sc *SystemConf) GetNotificationRateLimit() *RateLimit {
+	return sc.NotificationRateLimit
+}
+
 // GetMinGroupSize returns the minimum number of alerts needed to group the alerts
 // on Bosun's dashboard
 func (sc *SystemConf) GetMinGroupSize() int {
@@ -579,6 +659,34 @@ func (sc *SystemConf) GetMaxRenderedTemplateAge() int {
 	return sc.MaxRenderedTemplateAge
 }
 
+// GetMaxAlertRuns returns the number of runs of each alert to keep, which
+// defaults to 1440, five days of runs at the default check frequency
+func (sc *SystemConf) GetMaxAlertRuns() int {
+	if sc.MaxAlertRuns <= 0 {
+		return 1440
+	}
+	return sc.MaxAlertRuns
+}
+
+// GetCardinalityConf returns the limits on the number of series bosun will index
+// from incoming data points
+func (sc *SystemConf) GetCardinalityConf() CardinalityConf {
+	return sc.CardinalityConf
+}
+
+// GetClusterConf returns the configuration for sharding alert checks across
+// processes, with the default intervals set
+func (sc *SystemConf) GetClusterConf() ClusterConf {
+	c := sc.ClusterConf
+	if c.HeartbeatInterval.Duration == 0 {
+		c.HeartbeatInterval.Duration = 10 * time.Second
+	}
+	if c.Timeout.Duration == 0 {
+		c.Timeout.Duration = 3 * c.HeartbeatInterval.Duration
+	}
+	return c
+}
+
 // SaveEnabled returns if saving via the UI and config editing API endpoints should be enabled
 func (sc *SystemConf) SaveEnabled() bool {
 	return sc.EnableSave
@@ -617,16 +725,32 @@ func (sc *SystemConf) GetTSDBHost() string {
 }
 
 // GetAnnotateElasticHosts returns the Elastic hosts that should be used for annotations.
-// Annotations are not enabled if this has no hosts
+// With the elastic backend annotations are not enabled if this has no hosts.
+// With the db backend these hosts are the source for migrating annotations
 func (sc *SystemConf) GetAnnotateElasticHosts() expr.ElasticConfig {
 	return parseESAnnoteConfig(sc)
 }
 
+// GetAnnotateBackend returns where annotations are stored: AnnotateBackendElastic
+// or AnnotateBackendDB
+func (sc *SystemConf) GetAnnotateBackend() string {
+	if sc.AnnotateConf.Backend == "" {
+		return AnnotateBackendElastic
+	}
+	return sc.AnnotateConf.Backend
+}
+
 // GetAnnotateIndex returns the name of the Elastic index that should be used for annotations
 func (sc *SystemConf) GetAnnotateIndex() string {
 	return sc.AnnotateConf.Index
 }
 
+// GetTSDBCoalesceQueries returns whether the OpenTSDB queries of the alerts
+// of a check cycle are coalesced.
+func (sc *SystemConf) GetTSDBCoalesceQueries() bool {
+	return sc.OpenTSDBConf.CoalesceQueries
+}
+
 // GetTSDBContext returns an OpenTSDB context limited to
 // c.ResponseLimit. A nil context is returned if TSDBHost is not set.
 func (sc *SystemConf) GetTSDBContext() opentsdb.Context {
@@ -787,7 +911,13 @@ func azureLogResponse() autorest.RespondDecorator {
 
 // AnnotateEnabled returns if annotations have been enabled or not
 func (sc *SystemConf) AnnotateEnabled() bool {
-	return len(sc.AnnotateConf.Hosts) != 0
+	return sc.GetAnnotateBackend() == AnnotateBackendDB || len(sc.AnnotateConf.Hosts) != 0
+}
+
+// AutoAnnotateEnabled returns if bosun should create annotations for incidents,
+// actions, config saves and silences
+func (sc *SystemConf) AutoAnnotateEnabled() bool {
+	return sc.AnnotateEnabled() && !sc.AnnotateConf.DisableAutomatic
 }
 
 // MakeLink creates a HTML Link based on Bosun's configured Hostname
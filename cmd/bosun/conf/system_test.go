@@ -22,6 +22,7 @@ func TestSystemToml(t *testing.T) {
 	assert.Equal(t, sc.Ping, true)
 	assert.Equal(t, sc.MinGroupSize, 5)
 	assert.Equal(t, sc.UnknownThreshold, 5)
+	assert.Equal(t, sc.NotificationRateLimit, &RateLimit{Count: 100, Per: time.Hour})
 	assert.Equal(t, sc.SearchSince, Duration{Duration: time.Hour * 72})
 	assert.Equal(t, sc.PingDuration, Duration{Duration: time.Hour * 24}, "PingDuration does not match (should be set by default)")
 	assert.Equal(t, sc.HTTPListen, ":8080", "HTTPListen does not match")
@@ -69,5 +70,10 @@ func TestSystemToml(t *testing.T) {
 		ExpansionLimit: 500,
 		Concurrency:    2,
 	}, "CloudwatchConf does not match")
+	assert.Equal(t, sc.CardinalityConf, CardinalityConf{
+		MaxSeriesPerMetric: 100000,
+		MaxTagValues:       20000,
+		Action:             "drop",
+	}, "CardinalityConf does not match")
 
 }
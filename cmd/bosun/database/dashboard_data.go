@@ -0,0 +1,92 @@
+package database
+
+import (
+	"encoding/json"
+	"sort"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+dashboards : hash of dashboard name - json of models.Dashboard
+
+*/
+
+const dashboardsHash = "dashboards"
+
+type DashboardDataAccess interface {
+	// GetDashboard returns the dashboard name, or nil if it does not exist.
+	GetDashboard(name string) (*models.Dashboard, error)
+	// GetDashboards returns all dashboards sorted by name.
+	GetDashboards() ([]*models.Dashboard, error)
+	SetDashboard(d *models.Dashboard) error
+	DeleteDashboard(name string) error
+}
+
+func (d *dataAccess) Dashboards() DashboardDataAccess {
+	return d
+}
+
+func (d *dataAccess) GetDashboard(name string) (*models.Dashboard, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := redis.Bytes(conn.Do("HGET", dashboardsHash, name))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, nil
+		}
+		return nil, slog.Wrap(err)
+	}
+	dash := &models.Dashboard{}
+	if err := json.Unmarshal(b, dash); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return dash, nil
+}
+
+func (d *dataAccess) GetDashboards() ([]*models.Dashboard, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	m, err := redis.StringMap(conn.Do("HGETALL", dashboardsHash))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	dashboards := make([]*models.Dashboard, 0, len(m))
+	for name, dat := range m {
+		dash := &models.Dashboard{}
+		if err := json.Unmarshal([]byte(dat), dash); err != nil {
+			slog.Errorf("Incorrect dashboard data for %s: %v", name, err)
+			continue
+		}
+		dashboards = append(dashboards, dash)
+	}
+	sort.Slice(dashboards, func(i, j int) bool {
+		return dashboards[i].Name < dashboards[j].Name
+	})
+	return dashboards, nil
+}
+
+func (d *dataAccess) SetDashboard(dash *models.Dashboard) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	dat, err := json.Marshal(dash)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("HSET", dashboardsHash, dash.Name, dat)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) DeleteDashboard(name string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HDEL", dashboardsHash, name)
+	return slog.Wrap(err)
+}
@@ -35,6 +35,11 @@ type DataAccess interface {
 	State() StateDataAccess
 	Silence() SilenceDataAccess
 	Notifications() NotificationDataAccess
+	Workers() WorkerDataAccess
+	Problems() ProblemDataAccess
+	Runs() RunDataAccess
+	Dashboards() DashboardDataAccess
+	RateLimits() RateLimitDataAccess
 	Migrate() error
 }
 
@@ -72,6 +77,11 @@ type SearchDataAccess interface {
 type dataAccess struct {
 	pool    *redis.Pool
 	isRedis bool
+	// bucketsLock serializes the token takes of ledis, which has no
+	// transactions.
+	bucketsLock sync.Mutex
+	// problemsLock serializes the changes of problems of ledis.
+	problemsLock sync.Mutex
 }
 
 // Create a new data access object pointed at the specified address. isRedis parameter used to distinguish true redis from ledis in-proc.
@@ -143,7 +153,6 @@ func newPool(servers []string, password, masterName string, database int, isRedi
 	var lastMu sync.Mutex
 	var lastMaster string
 	var sntnl *sentinel.Sentinel
-	var serverAddr string
 	if masterName != "" {
 		// It is the Sentinel
 		sntnl = &sentinel.Sentinel{
@@ -184,6 +193,7 @@ func newPool(servers []string, password, masterName string, database int, isRedi
 		Wait:        wait,
 		IdleTimeout: 240 * time.Second,
 		Dial: func() (redis.Conn, error) {
+			var serverAddr string
 			if masterName != "" {
 				var err error
 				serverAddr, err = sntnl.MasterAddr()
@@ -0,0 +1,269 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+problemById:{id} - json encoded models.Problem
+openProblems - Set of the ids of the open problems
+maxProblemId - the last assigned problem id
+
+*/
+
+const (
+	openProblemsKey = "openProblems"
+	maxProblemIdKey = "maxProblemId"
+)
+
+func problemKey(id int64) string {
+	return fmt.Sprintf("problemById:%d", id)
+}
+
+type ProblemDataAccess interface {
+	// UpdateProblem saves p, and assigns it an id if it has none.
+	UpdateProblem(p *models.Problem) error
+	// GetProblem returns the problem id, or nil if it does not exist.
+	GetProblem(id int64) (*models.Problem, error)
+	GetOpenProblems() ([]*models.Problem, error)
+
+	// ChangeOpenProblems calls change with the open problems and saves the
+	// problems it returns, assigning ids to the new ones. The problems are
+	// read and written atomically, so change is called again if another
+	// process changed them in between.
+	ChangeOpenProblems(change func(open []*models.Problem) ([]*models.Problem, error)) error
+	// ChangeProblem calls change with the problem id, if it exists, and
+	// saves the problem if change returns true. Like ChangeOpenProblems,
+	// change may be called more than once.
+	ChangeProblem(id int64, change func(p *models.Problem) (bool, error)) error
+}
+
+func (d *dataAccess) Problems() ProblemDataAccess {
+	return d
+}
+
+func (d *dataAccess) UpdateProblem(p *models.Problem) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	problems := []*models.Problem{p}
+	if err := assignProblemIds(conn, problems, nil); err != nil {
+		return err
+	}
+	return d.transact(conn, func() error {
+		return setProblems(conn, problems)
+	})
+}
+
+// assignProblemIds assigns ids to the problems that have none. If reserved
+// is not nil, the ids taken are added to it, and the ids in it are used
+// first, so a change that is tried again reuses the ids it took.
+func assignProblemIds(conn redis.Conn, problems []*models.Problem, reserved *[]int64) error {
+	var ids []int64
+	if reserved != nil {
+		ids = *reserved
+	}
+	n := 0
+	for _, p := range problems {
+		if p.Id != 0 {
+			continue
+		}
+		if n == len(ids) {
+			id, err := redis.Int64(conn.Do("INCR", maxProblemIdKey))
+			if err != nil {
+				return slog.Wrap(err)
+			}
+			ids = append(ids, id)
+		}
+		p.Id = ids[n]
+		n++
+	}
+	if reserved != nil {
+		*reserved = ids
+	}
+	return nil
+}
+
+func setProblems(conn redis.Conn, problems []*models.Problem) error {
+	for _, p := range problems {
+		data, err := json.Marshal(p)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err := conn.Do("SET", problemKey(p.Id), data); err != nil {
+			return slog.Wrap(err)
+		}
+		op := "SREM"
+		if p.Open {
+			op = "SADD"
+		}
+		if _, err := conn.Do(op, openProblemsKey, p.Id); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// changeProblemsTries is the number of times a change of problems is tried
+// when the problems are changed by another process during the change. One
+// of the concurrent changes succeeds at every try, and every new incident of
+// the workers may be correlated at the same time.
+const changeProblemsTries = 50
+
+func (d *dataAccess) ChangeOpenProblems(change func(open []*models.Problem) ([]*models.Problem, error)) error {
+	return d.changeProblems(func(conn redis.Conn) ([]*models.Problem, error) {
+		if err := d.watch(conn, openProblemsKey); err != nil {
+			return nil, err
+		}
+		ids, err := int64s(conn.Do("SMEMBERS", openProblemsKey))
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		return d.watchProblems(conn, ids)
+	}, change)
+}
+
+func (d *dataAccess) ChangeProblem(id int64, change func(p *models.Problem) (bool, error)) error {
+	return d.changeProblems(func(conn redis.Conn) ([]*models.Problem, error) {
+		return d.watchProblems(conn, []int64{id})
+	}, func(problems []*models.Problem) ([]*models.Problem, error) {
+		if len(problems) == 0 {
+			return nil, nil
+		}
+		if ok, err := change(problems[0]); err != nil || !ok {
+			return nil, err
+		}
+		return problems, nil
+	})
+}
+
+// changeProblems saves the problems change returns for the problems read
+// returns. With redis, read watches the keys it reads, and the problems are
+// saved in a transaction that fails if another process changed the keys
+// after they were watched, in which case the change is tried again with the
+// ids assigned to new problems by the failed tries.
+func (d *dataAccess) changeProblems(read func(conn redis.Conn) ([]*models.Problem, error), change func([]*models.Problem) ([]*models.Problem, error)) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if !d.isRedis {
+		// Ledis has no transactions, but is only used by a single process.
+		d.problemsLock.Lock()
+		defer d.problemsLock.Unlock()
+	}
+	var ids []int64
+	for i := 0; i < changeProblemsTries; i++ {
+		problems, err := read(conn)
+		if err == nil {
+			problems, err = change(problems)
+		}
+		if err == nil {
+			err = assignProblemIds(conn, problems, &ids)
+		}
+		if err != nil || len(problems) == 0 {
+			d.unwatch(conn)
+			return err
+		}
+		if !d.isRedis {
+			return setProblems(conn, problems)
+		}
+		if _, err := conn.Do("MULTI"); err != nil {
+			return slog.Wrap(err)
+		}
+		if err := setProblems(conn, problems); err != nil {
+			conn.Do("DISCARD")
+			return err
+		}
+		res, err := conn.Do("EXEC")
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if res != nil {
+			return nil
+		}
+	}
+	return slog.Wrap(errors.New("problems changed during every change"))
+}
+
+// watchProblems watches and returns the problems ids that exist.
+func (d *dataAccess) watchProblems(conn redis.Conn, ids []int64) ([]*models.Problem, error) {
+	problems := make([]*models.Problem, 0, len(ids))
+	for _, id := range ids {
+		if err := d.watch(conn, problemKey(id)); err != nil {
+			return nil, err
+		}
+		p, err := d.getProblem(conn, id)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			problems = append(problems, p)
+		}
+	}
+	return problems, nil
+}
+
+// watch watches key with redis. Ledis has no transactions.
+func (d *dataAccess) watch(conn redis.Conn, key string) error {
+	if !d.isRedis {
+		return nil
+	}
+	_, err := conn.Do("WATCH", key)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) unwatch(conn redis.Conn) {
+	if d.isRedis {
+		conn.Do("UNWATCH")
+	}
+}
+
+func (d *dataAccess) GetProblem(id int64) (*models.Problem, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	return d.getProblem(conn, id)
+}
+
+func (d *dataAccess) getProblem(conn redis.Conn, id int64) (*models.Problem, error) {
+	b, err := redis.Bytes(conn.Do("GET", problemKey(id)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, nil
+		}
+		return nil, slog.Wrap(err)
+	}
+	p := &models.Problem{}
+	if err = json.Unmarshal(b, p); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return p, nil
+}
+
+func (d *dataAccess) GetOpenProblems() ([]*models.Problem, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := int64s(conn.Do("SMEMBERS", openProblemsKey))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	problems := make([]*models.Problem, 0, len(ids))
+	for _, id := range ids {
+		p, err := d.getProblem(conn, id)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			problems = append(problems, p)
+		}
+	}
+	return problems, nil
+}
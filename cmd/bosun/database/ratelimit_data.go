@@ -0,0 +1,236 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+notificationBuckets - hash of rate limit names to json encoded models.TokenBucket
+
+rateLimited:{notification} - set of ids of the incidents whose alert notifications by notification were not sent because of rate limits
+
+rateLimitedNotifications - set of the notifications with rate limited incidents
+
+*/
+
+const (
+	notificationBucketsKey      = "notificationBuckets"
+	rateLimitedNotificationsKey = "rateLimitedNotifications"
+)
+
+func rateLimitedKey(notification string) string {
+	return fmt.Sprintf("rateLimited:%s", notification)
+}
+
+type RateLimitDataAccess interface {
+	// GetBuckets returns the token buckets of the rate limits names. The
+	// bucket of a rate limit that was never used is empty.
+	GetBuckets(names ...string) (map[string]*models.TokenBucket, error)
+	SetBuckets(buckets map[string]*models.TokenBucket) error
+	// TakeToken refills the token buckets names with refill and takes a
+	// token from each of them, unless one of them holds less than a token.
+	// It returns whether the tokens were taken. The buckets are read and
+	// written atomically.
+	TakeToken(names []string, refill func(name string, b *models.TokenBucket)) (bool, error)
+
+	// AddRateLimited records that the alert notification of the incident id
+	// by notification was not sent.
+	AddRateLimited(notification string, id int64) error
+	// GetRateLimited returns the ids of the rate limited incidents by
+	// notification.
+	GetRateLimited() (map[string][]int64, error)
+	// ClearRateLimited removes ids from the rate limited incidents of
+	// notification.
+	ClearRateLimited(notification string, ids []int64) error
+}
+
+func (d *dataAccess) RateLimits() RateLimitDataAccess {
+	return d
+}
+
+func (d *dataAccess) GetBuckets(names ...string) (map[string]*models.TokenBucket, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	return getBuckets(conn, names)
+}
+
+func getBuckets(conn redis.Conn, names []string) (map[string]*models.TokenBucket, error) {
+	buckets := make(map[string]*models.TokenBucket, len(names))
+	if len(names) == 0 {
+		return buckets, nil
+	}
+	args := []interface{}{notificationBucketsKey}
+	for _, name := range names {
+		args = append(args, name)
+	}
+	rows, err := redis.Strings(conn.Do("HMGET", args...))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	for i, name := range names {
+		b := &models.TokenBucket{}
+		if rows[i] != "" {
+			if err := json.Unmarshal([]byte(rows[i]), b); err != nil {
+				return nil, slog.Wrap(err)
+			}
+		}
+		buckets[name] = b
+	}
+	return buckets, nil
+}
+
+func (d *dataAccess) SetBuckets(buckets map[string]*models.TokenBucket) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	return setBuckets(conn, buckets)
+}
+
+func setBuckets(conn redis.Conn, buckets map[string]*models.TokenBucket) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+	args := []interface{}{notificationBucketsKey}
+	for name, b := range buckets {
+		data, err := json.Marshal(b)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		args = append(args, name, data)
+	}
+	_, err := conn.Do("HMSET", args...)
+	return slog.Wrap(err)
+}
+
+// takeTokenTries is the number of times a token take is tried when the
+// buckets are changed by another process during the take.
+const takeTokenTries = 10
+
+func (d *dataAccess) TakeToken(names []string, refill func(name string, b *models.TokenBucket)) (bool, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	if !d.isRedis {
+		// Ledis has no transactions, but is only used by a single process.
+		d.bucketsLock.Lock()
+		defer d.bucketsLock.Unlock()
+		buckets, ok, err := takeToken(conn, names, refill)
+		if err != nil || !ok {
+			return false, err
+		}
+		return true, setBuckets(conn, buckets)
+	}
+	for i := 0; i < takeTokenTries; i++ {
+		// The transaction fails if another process changes the buckets
+		// after they are watched.
+		if _, err := conn.Do("WATCH", notificationBucketsKey); err != nil {
+			return false, slog.Wrap(err)
+		}
+		buckets, ok, err := takeToken(conn, names, refill)
+		if err != nil || !ok {
+			conn.Do("UNWATCH")
+			return false, err
+		}
+		if _, err := conn.Do("MULTI"); err != nil {
+			return false, slog.Wrap(err)
+		}
+		if err := setBuckets(conn, buckets); err != nil {
+			conn.Do("DISCARD")
+			return false, err
+		}
+		res, err := conn.Do("EXEC")
+		if err != nil {
+			return false, slog.Wrap(err)
+		}
+		if res != nil {
+			return true, nil
+		}
+	}
+	return false, slog.Wrap(errors.New("token buckets changed during every take"))
+}
+
+// takeToken returns the buckets names refilled by refill with a token taken
+// from each, and false if one of them holds less than a token.
+func takeToken(conn redis.Conn, names []string, refill func(name string, b *models.TokenBucket)) (map[string]*models.TokenBucket, bool, error) {
+	buckets, err := getBuckets(conn, names)
+	if err != nil {
+		return nil, false, err
+	}
+	for name, b := range buckets {
+		refill(name, b)
+		if b.Tokens < 1 {
+			return nil, false, nil
+		}
+	}
+	for _, b := range buckets {
+		b.Tokens--
+	}
+	return buckets, true, nil
+}
+
+func (d *dataAccess) AddRateLimited(notification string, id int64) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	return d.transact(conn, func() error {
+		if _, err := conn.Do("SADD", rateLimitedKey(notification), id); err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err := conn.Do("SADD", rateLimitedNotificationsKey, notification); err != nil {
+			return slog.Wrap(err)
+		}
+		return nil
+	})
+}
+
+func (d *dataAccess) GetRateLimited() (map[string][]int64, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	nots, err := redis.Strings(conn.Do("SMEMBERS", rateLimitedNotificationsKey))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	limited := make(map[string][]int64, len(nots))
+	for _, n := range nots {
+		ids, err := redis.Ints(conn.Do("SMEMBERS", rateLimitedKey(n)))
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		for _, id := range ids {
+			limited[n] = append(limited[n], int64(id))
+		}
+	}
+	return limited, nil
+}
+
+func (d *dataAccess) ClearRateLimited(notification string, ids []int64) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if len(ids) > 0 {
+		args := []interface{}{rateLimitedKey(notification)}
+		for _, id := range ids {
+			args = append(args, id)
+		}
+		if _, err := conn.Do("SREM", args...); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	n, err := redis.Int(conn.Do("SCARD", rateLimitedKey(notification)))
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if n == 0 {
+		_, err = conn.Do("SREM", rateLimitedNotificationsKey, notification)
+	}
+	return slog.Wrap(err)
+}
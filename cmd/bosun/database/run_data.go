@@ -0,0 +1,106 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+alertRuns:{name} - list of json encoded models.AlertRun of the checks of the alert, most recent first
+alertsWithRuns - set of the names of the alerts with runs
+
+*/
+
+const alertsWithRuns = "alertsWithRuns"
+
+func alertRunsKey(name string) string {
+	return fmt.Sprintf("alertRuns:%s", name)
+}
+
+type RunDataAccess interface {
+	// AddAlertRun adds run to the runs of the alert name, keeping at most
+	// max runs.
+	AddAlertRun(name string, run *models.AlertRun, max int) error
+	// GetAlertRuns returns the last n runs of the alert name, most recent
+	// first. All runs are returned if n is 0.
+	GetAlertRuns(name string, n int) ([]*models.AlertRun, error)
+	// GetAlertsWithRuns returns the names of the alerts with runs.
+	GetAlertsWithRuns() ([]string, error)
+	// DeleteAlertRuns deletes the runs of the alert name.
+	DeleteAlertRuns(name string) error
+}
+
+func (d *dataAccess) Runs() RunDataAccess {
+	return d
+}
+
+func (d *dataAccess) AddAlertRun(name string, run *models.AlertRun, max int) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	data, err := json.Marshal(run)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	return d.transact(conn, func() error {
+		if _, err := conn.Do("LPUSH", alertRunsKey(name), data); err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err := conn.Do("LTRIM", alertRunsKey(name), 0, max-1); err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err := conn.Do("SADD", alertsWithRuns, name); err != nil {
+			return slog.Wrap(err)
+		}
+		return nil
+	})
+}
+
+func (d *dataAccess) GetAlertRuns(name string, n int) ([]*models.AlertRun, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	rows, err := redis.Strings(conn.Do("LRANGE", alertRunsKey(name), 0, n-1))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	runs := make([]*models.AlertRun, len(rows))
+	for i, row := range rows {
+		runs[i] = &models.AlertRun{}
+		if err := json.Unmarshal([]byte(row), runs[i]); err != nil {
+			return nil, slog.Wrap(err)
+		}
+	}
+	return runs, nil
+}
+
+func (d *dataAccess) GetAlertsWithRuns() ([]string, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	names, err := redis.Strings(conn.Do("SMEMBERS", alertsWithRuns))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return names, nil
+}
+
+func (d *dataAccess) DeleteAlertRuns(name string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	return d.transact(conn, func() error {
+		if _, err := conn.Do(d.LCLEAR(), alertRunsKey(name)); err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err := conn.Do("SREM", alertsWithRuns, name); err != nil {
+			return slog.Wrap(err)
+		}
+		return nil
+	})
+}
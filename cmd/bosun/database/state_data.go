@@ -26,10 +26,13 @@ openIncidents - Hash of open incident Ids. Alert Key -> incident id
 incidents:{ak} - List of incidents for alert key
 
 allIncidents - List of all incidents ever. Value is "incidentId:timestamp:ak"
+
+pendingAlerts - Hash of json encoded PendingAlerts. Alert Key -> pending alert
 */
 
 const (
 	statesOpenIncidentsKey = "openIncidents"
+	statesPendingKey       = "pendingAlerts"
 )
 
 func statesLastTouchedKey(alert string) string {
@@ -78,6 +81,12 @@ type StateDataAccess interface {
 	Forget(ak models.AlertKey) error
 	SetUnevaluated(ak models.AlertKey, uneval bool) error
 	GetUnknownAndUnevalAlertKeys(alert string) ([]models.AlertKey, []models.AlertKey, error)
+
+	// GetPendingAlert returns the pending alert of the alert key, or nil if it is not pending.
+	GetPendingAlert(ak models.AlertKey) (*models.PendingAlert, error)
+	GetAllPendingAlerts() ([]*models.PendingAlert, error)
+	SetPendingAlert(p *models.PendingAlert) error
+	ClearPendingAlert(ak models.AlertKey) error
 }
 
 func (d *dataAccess) SetRenderedTemplates(incidentId int64, rt *models.RenderedTemplates) error {
@@ -487,6 +496,9 @@ func (d *dataAccess) Forget(ak models.AlertKey) error {
 		if _, err := conn.Do("HDEL", statesOpenIncidentsKey, ak); err != nil {
 			return slog.Wrap(err)
 		}
+		if _, err := conn.Do("HDEL", statesPendingKey, ak); err != nil {
+			return slog.Wrap(err)
+		}
 		if _, err = conn.Do("HDEL", statesOpenIncidentsKey, ak); err != nil {
 			return slog.Wrap(err)
 		}
@@ -530,6 +542,67 @@ func (d *dataAccess) GetUnknownAndUnevalAlertKeys(alert string) ([]models.AlertK
 	return unknown, unevals, nil
 }
 
+func (d *dataAccess) GetPendingAlert(ak models.AlertKey) (*models.PendingAlert, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := redis.Bytes(conn.Do("HGET", statesPendingKey, ak))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, nil
+		}
+		return nil, slog.Wrap(err)
+	}
+	p := &models.PendingAlert{}
+	if err = json.Unmarshal(b, p); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return p, nil
+}
+
+func (d *dataAccess) GetAllPendingAlerts() ([]*models.PendingAlert, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	vals, err := redis.Values(conn.Do("HVALS", statesPendingKey))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	pending := make([]*models.PendingAlert, 0, len(vals))
+	for _, v := range vals {
+		b, err := redis.Bytes(v, nil)
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		p := &models.PendingAlert{}
+		if err = json.Unmarshal(b, p); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		pending = append(pending, p)
+	}
+	return pending, nil
+}
+
+func (d *dataAccess) SetPendingAlert(p *models.PendingAlert) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("HSET", statesPendingKey, p.AlertKey, data)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) ClearPendingAlert(ak models.AlertKey) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HDEL", statesPendingKey, ak)
+	return slog.Wrap(err)
+}
+
 func int64s(reply interface{}, err error) ([]int64, error) {
 	if err != nil {
 		return nil, slog.Wrap(err)
@@ -0,0 +1,44 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestDashboards(t *testing.T) {
+	dd := testData.Dashboards()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	dash, err := dd.GetDashboard("web")
+	check(t, err)
+	if dash != nil {
+		t.Fatalf("Expected no dashboard. Got %v.", dash)
+	}
+	check(t, dd.SetDashboard(&models.Dashboard{
+		Name:      "web",
+		Variables: []*models.DashboardVariable{{Name: "host", TagKey: "host"}},
+		Panels:    []*models.DashboardPanel{{Type: models.PanelExpr, Expr: `q("avg:os.cpu{host=$host}", "1h", "")`}},
+		Modified:  now,
+	}))
+	check(t, dd.SetDashboard(&models.Dashboard{Name: "db", Modified: now}))
+
+	dash, err = dd.GetDashboard("web")
+	check(t, err)
+	if dash == nil || len(dash.Panels) != 1 || dash.Variables[0].TagKey != "host" || !dash.Modified.Equal(now) {
+		t.Fatalf("Expected dashboard web. Got %+v.", dash)
+	}
+	dashboards, err := dd.GetDashboards()
+	check(t, err)
+	if len(dashboards) != 2 || dashboards[0].Name != "db" || dashboards[1].Name != "web" {
+		t.Fatalf("Expected dashboards db and web. Got %v.", dashboards)
+	}
+
+	check(t, dd.DeleteDashboard("db"))
+	dashboards, err = dd.GetDashboards()
+	check(t, err)
+	if len(dashboards) != 1 || dashboards[0].Name != "web" {
+		t.Fatalf("Expected only dashboard web. Got %v.", dashboards)
+	}
+}
@@ -0,0 +1,44 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestPendingAlerts(t *testing.T) {
+	sd := testData.State()
+	now := time.Now().UTC().Truncate(time.Second)
+	ak1 := models.AlertKey("pending.a{host=a}")
+	ak2 := models.AlertKey("pending.a{host=b}")
+
+	p, err := sd.GetPendingAlert(ak1)
+	check(t, err)
+	if p != nil {
+		t.Fatalf("Expected no pending alert. Got %v.", p)
+	}
+
+	check(t, sd.SetPendingAlert(&models.PendingAlert{AlertKey: ak1, Alert: ak1.Name(), Status: models.StWarning, Since: now}))
+	check(t, sd.SetPendingAlert(&models.PendingAlert{AlertKey: ak2, Alert: ak2.Name(), Status: models.StWarning, Since: now}))
+	check(t, sd.SetPendingAlert(&models.PendingAlert{AlertKey: ak1, Alert: ak1.Name(), Status: models.StCritical, Since: now, LastCheck: now.Add(time.Minute)}))
+
+	p, err = sd.GetPendingAlert(ak1)
+	check(t, err)
+	if p == nil || p.Status != models.StCritical || !p.Since.Equal(now) || !p.LastCheck.Equal(now.Add(time.Minute)) {
+		t.Fatalf("Expected last pending state of %s. Got %+v.", ak1, p)
+	}
+	all, err := sd.GetAllPendingAlerts()
+	check(t, err)
+	if len(all) != 2 {
+		t.Fatalf("Expected 2 pending alerts. Got %d.", len(all))
+	}
+
+	check(t, sd.ClearPendingAlert(ak1))
+	check(t, sd.Forget(ak2))
+	all, err = sd.GetAllPendingAlerts()
+	check(t, err)
+	if len(all) != 0 {
+		t.Fatalf("Expected no pending alerts. Got %v.", all)
+	}
+}
@@ -0,0 +1,104 @@
+package dbtest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestProblems(t *testing.T) {
+	pd := testData.Problems()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	p := &models.Problem{Rule: "network", Start: now, Last: now, Open: true, IncidentIds: []int64{1, 2}}
+	check(t, pd.UpdateProblem(p))
+	if p.Id == 0 {
+		t.Fatal("Expected problem id to be assigned.")
+	}
+	closed := &models.Problem{Rule: "network", Start: now}
+	check(t, pd.UpdateProblem(closed))
+
+	got, err := pd.GetProblem(p.Id)
+	check(t, err)
+	if got == nil || got.Rule != "network" || len(got.IncidentIds) != 2 || !got.Start.Equal(now) {
+		t.Fatalf("Expected problem %d. Got %+v.", p.Id, got)
+	}
+	open, err := pd.GetOpenProblems()
+	check(t, err)
+	if len(open) != 1 || open[0].Id != p.Id {
+		t.Fatalf("Expected only problem %d to be open. Got %v.", p.Id, open)
+	}
+
+	p.Open = false
+	check(t, pd.UpdateProblem(p))
+	open, err = pd.GetOpenProblems()
+	check(t, err)
+	if len(open) != 0 {
+		t.Fatalf("Expected no open problems. Got %v.", open)
+	}
+	got, err = pd.GetProblem(1000)
+	check(t, err)
+	if got != nil {
+		t.Fatalf("Expected no problem. Got %v.", got)
+	}
+}
+
+func TestChangeProblems(t *testing.T) {
+	pd := testData.Problems()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	// Workers correlating incidents at the same time add them all to a
+	// single problem.
+	var wg sync.WaitGroup
+	for i := 1; i <= 20; i++ {
+		wg.Add(1)
+		go func(id int64) {
+			defer wg.Done()
+			err := pd.ChangeOpenProblems(func(open []*models.Problem) ([]*models.Problem, error) {
+				for _, p := range open {
+					if p.Rule == "concurrent" {
+						p.IncidentIds = append(p.IncidentIds, id)
+						return []*models.Problem{p}, nil
+					}
+				}
+				return []*models.Problem{{Rule: "concurrent", Start: now, Open: true, IncidentIds: []int64{id}}}, nil
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}(int64(i))
+	}
+	wg.Wait()
+	var problem *models.Problem
+	open, err := pd.GetOpenProblems()
+	check(t, err)
+	for _, p := range open {
+		if p.Rule != "concurrent" {
+			continue
+		}
+		if problem != nil {
+			t.Fatalf("Expected a single problem. Got %d and %d.", problem.Id, p.Id)
+		}
+		problem = p
+	}
+	if problem == nil || len(problem.IncidentIds) != 20 {
+		t.Fatalf("Expected a problem with 20 incidents. Got %+v.", problem)
+	}
+
+	// A problem that does not exist is not changed.
+	check(t, pd.ChangeProblem(1000, func(p *models.Problem) (bool, error) {
+		t.Fatal("Expected no change of a problem that does not exist.")
+		return false, nil
+	}))
+	check(t, pd.ChangeProblem(problem.Id, func(p *models.Problem) (bool, error) {
+		p.Open = false
+		return true, nil
+	}))
+	got, err := pd.GetProblem(problem.Id)
+	check(t, err)
+	if got == nil || got.Open || len(got.IncidentIds) != 20 {
+		t.Fatalf("Expected closed problem %d. Got %+v.", problem.Id, got)
+	}
+}
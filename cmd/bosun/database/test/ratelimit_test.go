@@ -0,0 +1,87 @@
+package dbtest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestRateLimits(t *testing.T) {
+	rd := testData.RateLimits()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	buckets, err := rd.GetBuckets("rlGlobal", "rlNot")
+	check(t, err)
+	if len(buckets) != 2 || !buckets["rlGlobal"].Time.IsZero() || buckets["rlNot"].Tokens != 0 {
+		t.Fatalf("Expected empty buckets. Got %v.", buckets)
+	}
+	check(t, rd.SetBuckets(map[string]*models.TokenBucket{"rlNot": {Tokens: 1.5, Time: now}}))
+	buckets, err = rd.GetBuckets("rlNot")
+	check(t, err)
+	if b := buckets["rlNot"]; b.Tokens != 1.5 || !b.Time.Equal(now) {
+		t.Fatalf("Expected the bucket to round trip. Got %+v.", b)
+	}
+
+	check(t, rd.AddRateLimited("rlNot", 3))
+	check(t, rd.AddRateLimited("rlNot", 4))
+	check(t, rd.AddRateLimited("rlNot", 4))
+	check(t, rd.AddRateLimited("rlOther", 5))
+	limited, err := rd.GetRateLimited()
+	check(t, err)
+	if len(limited["rlNot"]) != 2 || len(limited["rlOther"]) != 1 {
+		t.Fatalf("Expected 2 and 1 rate limited incidents. Got %v.", limited)
+	}
+
+	check(t, rd.ClearRateLimited("rlNot", []int64{3}))
+	check(t, rd.ClearRateLimited("rlOther", []int64{5}))
+	limited, err = rd.GetRateLimited()
+	check(t, err)
+	if len(limited) != 1 || len(limited["rlNot"]) != 1 || limited["rlNot"][0] != 4 {
+		t.Fatalf("Expected incident 4 of rlNot. Got %v.", limited)
+	}
+	check(t, rd.ClearRateLimited("rlNot", []int64{4}))
+}
+
+func TestTakeToken(t *testing.T) {
+	rd := testData.RateLimits()
+	now := time.Now().UTC().Truncate(time.Second)
+	// Buckets of 5 and 10 tokens that do not refill after their first use.
+	counts := map[string]float64{"ttGlobal": 10, "ttNot": 5}
+	refill := func(name string, b *models.TokenBucket) {
+		if b.Time.IsZero() {
+			b.Tokens, b.Time = counts[name], now
+		}
+	}
+	names := []string{"ttGlobal", "ttNot"}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	taken := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ok, err := rd.TakeToken(names, refill)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if ok {
+				mu.Lock()
+				taken++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if taken != 5 {
+		t.Fatalf("Expected 5 tokens taken. Got %d.", taken)
+	}
+	buckets, err := rd.GetBuckets(names...)
+	check(t, err)
+	if buckets["ttGlobal"].Tokens != 5 || buckets["ttNot"].Tokens != 0 {
+		t.Errorf("Expected 5 and 0 tokens left. Got %v and %v.", buckets["ttGlobal"].Tokens, buckets["ttNot"].Tokens)
+	}
+}
@@ -0,0 +1,67 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestAlertRuns(t *testing.T) {
+	rd := testData.Runs()
+	alert := "runsAlert"
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for i := 0; i < 3; i++ {
+		run := &models.AlertRun{Time: now.Add(time.Duration(i) * time.Minute), Normal: i}
+		check(t, rd.AddAlertRun(alert, run, 2))
+	}
+	check(t, rd.AddAlertRun("otherAlert", &models.AlertRun{Time: now, Error: "bad"}, 2))
+
+	runs, err := rd.GetAlertRuns(alert, 0)
+	check(t, err)
+	if len(runs) != 2 {
+		t.Fatalf("Expected 2 runs. Got %d.", len(runs))
+	}
+	if runs[0].Normal != 2 || runs[1].Normal != 1 || !runs[0].Time.Equal(now.Add(2*time.Minute)) {
+		t.Errorf("Expected the last 2 runs, most recent first. Got %+v, %+v.", runs[0], runs[1])
+	}
+
+	runs, err = rd.GetAlertRuns(alert, 1)
+	check(t, err)
+	if len(runs) != 1 || runs[0].Normal != 2 {
+		t.Fatalf("Expected the last run. Got %v.", runs)
+	}
+
+	runs, err = rd.GetAlertRuns("noRuns", 0)
+	check(t, err)
+	if len(runs) != 0 {
+		t.Fatalf("Expected no runs. Got %v.", runs)
+	}
+
+	names, err := rd.GetAlertsWithRuns()
+	check(t, err)
+	if !hasString(names, alert) || !hasString(names, "otherAlert") {
+		t.Errorf("Expected %s and otherAlert to have runs. Got %v.", alert, names)
+	}
+	check(t, rd.DeleteAlertRuns(alert))
+	runs, err = rd.GetAlertRuns(alert, 0)
+	check(t, err)
+	if len(runs) != 0 {
+		t.Fatalf("Expected deleted runs. Got %v.", runs)
+	}
+	names, err = rd.GetAlertsWithRuns()
+	check(t, err)
+	if hasString(names, alert) || !hasString(names, "otherAlert") {
+		t.Errorf("Expected only otherAlert to have runs. Got %v.", names)
+	}
+}
+
+func hasString(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/models"
+)
+
+func TestWorkers(t *testing.T) {
+	wd := testData.Workers()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	check(t, wd.Heartbeat(&models.Worker{Name: "bosun01:8070", LastSeen: now, Alerts: 3}))
+	check(t, wd.Heartbeat(&models.Worker{Name: "bosun02:8070", LastSeen: now, Alerts: 4}))
+	check(t, wd.Heartbeat(&models.Worker{Name: "bosun01:8070", LastSeen: now.Add(time.Second), Alerts: 2}))
+
+	workers, err := wd.GetWorkers()
+	check(t, err)
+	if len(workers) != 2 {
+		t.Fatalf("Expected 2 workers. Got %d.", len(workers))
+	}
+	for _, w := range workers {
+		if w.Name == "bosun01:8070" && (w.Alerts != 2 || !w.LastSeen.Equal(now.Add(time.Second))) {
+			t.Errorf("Expected last heartbeat of bosun01. Got %+v.", w)
+		}
+	}
+
+	check(t, wd.RemoveWorker("bosun02:8070"))
+	workers, err = wd.GetWorkers()
+	check(t, err)
+	if len(workers) != 1 || workers[0].Name != "bosun01:8070" {
+		t.Fatalf("Expected only bosun01. Got %v.", workers)
+	}
+}
+
+func TestAlertLeases(t *testing.T) {
+	wd := testData.Workers()
+	acquire := func(worker string, take bool, holder, requester string) {
+		leases := []*database.AlertLease{
+			{Alert: "lease.alert", Take: take},
+			{Alert: "lease.other"},
+		}
+		check(t, wd.AcquireAlertLeases(worker, time.Minute, leases))
+		if l := leases[0]; l.Holder != holder || l.Requester != requester {
+			t.Fatalf("%s: expected holder %q and requester %q. Got %q and %q.", worker, holder, requester, l.Holder, l.Requester)
+		}
+		if l := leases[1]; l.Holder != "" || l.Requester != "" {
+			t.Fatalf("%s: expected a free lease without take. Got %+v.", worker, l)
+		}
+	}
+	acquire("bosun01", false, "", "")
+	acquire("bosun01", true, "bosun01", "")
+	acquire("bosun02", true, "bosun01", "")
+	check(t, wd.RequestAlertLeases("bosun02", time.Minute, []string{"lease.alert"}))
+	acquire("bosun01", false, "bosun01", "bosun02")
+
+	// Only the holder releases the lease, which also clears the request.
+	check(t, wd.ReleaseAlertLeases("bosun02", []string{"lease.alert", "lease.other"}))
+	acquire("bosun02", false, "bosun01", "bosun02")
+	check(t, wd.ReleaseAlertLeases("bosun01", []string{"lease.alert", "lease.other"}))
+	acquire("bosun02", true, "bosun02", "")
+}
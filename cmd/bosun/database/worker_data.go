@@ -0,0 +1,251 @@
+package database
+
+import (
+	"encoding/json"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+workers : hash of worker name - json of models.Worker, written on every heartbeat.
+
+alertLease:{alert} : name of the worker checking the alert, expiring unless renewed.
+alertLeaseRequest:{alert} : name of the worker the alert is assigned to, which waits for the lease.
+
+*/
+
+const workersHash = "workers"
+
+func alertLeaseKey(alert string) string {
+	return "alertLease:" + alert
+}
+
+func alertLeaseRequestKey(alert string) string {
+	return "alertLeaseRequest:" + alert
+}
+
+type WorkerDataAccess interface {
+	Heartbeat(w *models.Worker) error
+	GetWorkers() ([]*models.Worker, error)
+	RemoveWorker(name string) error
+
+	AcquireAlertLeases(worker string, ttl time.Duration, leases []*AlertLease) error
+	RequestAlertLeases(worker string, ttl time.Duration, alerts []string) error
+	ReleaseAlertLeases(worker string, alerts []string) error
+}
+
+// AlertLease is the lease of a worker on the checks of an alert.
+type AlertLease struct {
+	Alert string
+	Take  bool // take the lease if it is free, else only renew it
+
+	// Set by AcquireAlertLeases to the worker holding the lease and the
+	// worker that requested it, if any.
+	Holder    string
+	Requester string
+}
+
+func (d *dataAccess) Workers() WorkerDataAccess {
+	return d
+}
+
+func (d *dataAccess) Heartbeat(w *models.Worker) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	dat, err := json.Marshal(w)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("HSET", workersHash, w.Name, dat)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetWorkers() ([]*models.Worker, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	m, err := redis.StringMap(conn.Do("HGETALL", workersHash))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	workers := make([]*models.Worker, 0, len(m))
+	for name, dat := range m {
+		w := &models.Worker{}
+		if err := json.Unmarshal([]byte(dat), w); err != nil {
+			slog.Errorf("Incorrect worker data for %s: %v", name, err)
+			continue
+		}
+		workers = append(workers, w)
+	}
+	return workers, nil
+}
+
+func (d *dataAccess) RemoveWorker(name string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HDEL", workersHash, name)
+	return slog.Wrap(err)
+}
+
+// acquireAlertLease renews the lease of a worker, or takes it if it is free
+// and ARGV[3] is 1, and returns the holder and requester of the lease.
+var acquireAlertLease = redis.NewScript(2, `
+local holder = redis.call('GET', KEYS[1])
+if holder == ARGV[1] or (not holder and ARGV[3] == '1') then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+	holder = ARGV[1]
+end
+return {holder or '', redis.call('GET', KEYS[2]) or ''}
+`)
+
+// releaseAlertLease deletes the lease and its request if the lease is held
+// by the worker.
+var releaseAlertLease = redis.NewScript(2, `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1], KEYS[2])
+end
+return 0
+`)
+
+// AcquireAlertLeases renews the leases of worker on the checks of the
+// alerts for ttl, or takes those that are free and to take, and sets their
+// holder and requester. The leases are acquired in a single round trip.
+func (d *dataAccess) AcquireAlertLeases(worker string, ttl time.Duration, leases []*AlertLease) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if d.isRedis {
+		for _, l := range leases {
+			take := 0
+			if l.Take {
+				take = 1
+			}
+			if err := acquireAlertLease.Send(conn, alertLeaseKey(l.Alert), alertLeaseRequestKey(l.Alert), worker, int64(ttl/time.Millisecond), take); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+		if err := conn.Flush(); err != nil {
+			return slog.Wrap(err)
+		}
+		var first error
+		for _, l := range leases {
+			res, err := redis.Strings(conn.Receive())
+			if err != nil {
+				if first == nil {
+					first = err
+				}
+				continue
+			}
+			l.Holder, l.Requester = res[0], res[1]
+		}
+		return slog.Wrap(first)
+	}
+	// Ledis can't run scripts, but is only used by a single process.
+	for _, l := range leases {
+		holder, err := getString(conn, alertLeaseKey(l.Alert))
+		if err != nil {
+			return err
+		}
+		if holder == worker || (holder == "" && l.Take) {
+			if err := setExpiring(conn, alertLeaseKey(l.Alert), worker, ttl); err != nil {
+				return err
+			}
+			holder = worker
+		}
+		l.Holder = holder
+		if l.Requester, err = getString(conn, alertLeaseRequestKey(l.Alert)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestAlertLeases asks the holders of the leases of the alerts to release
+// them to worker.
+func (d *dataAccess) RequestAlertLeases(worker string, ttl time.Duration, alerts []string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if d.isRedis {
+		for _, alert := range alerts {
+			if err := conn.Send("SET", alertLeaseRequestKey(alert), worker, "PX", int64(ttl/time.Millisecond)); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+		return receiveAll(conn, len(alerts))
+	}
+	for _, alert := range alerts {
+		if err := setExpiring(conn, alertLeaseRequestKey(alert), worker, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReleaseAlertLeases releases the leases of the alerts that worker holds.
+func (d *dataAccess) ReleaseAlertLeases(worker string, alerts []string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if d.isRedis {
+		for _, alert := range alerts {
+			if err := releaseAlertLease.Send(conn, alertLeaseKey(alert), alertLeaseRequestKey(alert), worker); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+		return receiveAll(conn, len(alerts))
+	}
+	for _, alert := range alerts {
+		holder, err := getString(conn, alertLeaseKey(alert))
+		if err != nil {
+			return err
+		}
+		if holder != worker {
+			continue
+		}
+		if _, err := conn.Do("DEL", alertLeaseKey(alert), alertLeaseRequestKey(alert)); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// receiveAll flushes the n commands sent on conn and receives their replies,
+// returning the first error.
+func receiveAll(conn redis.Conn, n int) error {
+	if err := conn.Flush(); err != nil {
+		return slog.Wrap(err)
+	}
+	var first error
+	for i := 0; i < n; i++ {
+		if _, err := conn.Receive(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return slog.Wrap(first)
+}
+
+// getString returns the value of key, or "" if it does not exist.
+func getString(conn redis.Conn, key string) (string, error) {
+	v, err := redis.String(conn.Do("GET", key))
+	if err == redis.ErrNil {
+		return "", nil
+	}
+	return v, slog.Wrap(err)
+}
+
+// setExpiring sets key to value for ttl, rounded up to seconds, which is the
+// resolution of expiry in ledis.
+func setExpiring(conn redis.Conn, key, value string, ttl time.Duration) error {
+	if _, err := conn.Do("SET", key, value); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("EXPIRE", key, int64((ttl+time.Second-1)/time.Second))
+	return slog.Wrap(err)
+}
@@ -237,11 +237,10 @@ func azureQuery(prefix string, e *State, metric, tagKeysCSV, rsg, resName, resou
 		return resp, err
 	}
 	// Get Azure metric values by calling the Azure API or via cache if available
-	val, err, hit := e.Cache.Get(cacheKey, getFn)
+	val, err := e.cacheGet("azure_ts", cacheKey, getFn)
 	if err != nil {
 		return r, err
 	}
-	collectCacheHit(e.Cache, "azure_ts", hit)
 	resp := val.(insights.Response)
 	rawReadsRemaining := resp.Header.Get("X-Ms-Ratelimit-Remaining-Subscription-Reads")
 	readsRemaining, err := strconv.ParseInt(rawReadsRemaining, 10, 64)
@@ -424,8 +423,7 @@ func azureListResources(prefix string, e *State) (AzureResources, error) {
 		}
 		return r, nil
 	}
-	val, err, hit := e.Cache.Get(key, getFn)
-	collectCacheHit(e.Cache, "azure_resource", hit)
+	val, err := e.cacheGet("azure_resource", key, getFn)
 	if err != nil {
 		return AzureResources{}, err
 	}
@@ -80,11 +80,10 @@ func AzureAIQuery(prefix string, e *State, metric, segmentCSV, filter string, ap
 			})
 			return resp, err
 		}
-		val, err, hit := e.Cache.Get(cacheKey, getFn)
+		val, err := e.cacheGet("azureai_ts", cacheKey, getFn)
 		if err != nil {
 			return r, err
 		}
-		collectCacheHit(e.Cache, "azureai_ts", hit)
 		res := val.(ainsights.MetricsResult)
 
 		basetags := opentsdb.TagSet{"app": appName}
@@ -301,8 +300,7 @@ func AzureAIListApps(prefix string, e *State) (r *Results, err error) {
 		r.Results = append(r.Results, &Result{Value: applist})
 		return r, nil
 	}
-	val, err, hit := e.Cache.Get(key, getFn)
-	collectCacheHit(e.Cache, "azure_aiapplist", hit)
+	val, err := e.cacheGet("azure_aiapplist", key, getFn)
 	if err != nil {
 		return r, err
 	}
@@ -251,10 +251,7 @@ func getCloudwatchData(e *State, req *cloudwatch.Request) (resp cloudwatch.Respo
 	}
 
 	var val interface{}
-	var hit bool
-	val, err, hit = e.Cache.Get(key, getFn)
-
-	collectCacheHit(e.Cache, "cloudwatch", hit)
+	val, err = e.cacheGet("cloudwatch", key, getFn)
 	resp = val.(cloudwatch.Response)
 
 	return
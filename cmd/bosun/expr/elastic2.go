@@ -129,9 +129,7 @@ func timeESRequest2(e *State, req *ElasticRequest2) (resp *elastic.SearchResult,
 			return e.ElasticHosts.Query2(req)
 		}
 		var val interface{}
-		var hit bool
-		val, err, hit = e.Cache.Get(key, getFn)
-		collectCacheHit(e.Cache, "elastic", hit)
+		val, err = e.cacheGet("elastic", key, getFn)
 		resp = val.(*elastic.SearchResult)
 	})
 	return
@@ -130,9 +130,7 @@ func timeESRequest5(e *State, req *ElasticRequest5) (resp *elastic.SearchResult,
 			return e.ElasticHosts.Query5(req)
 		}
 		var val interface{}
-		var hit bool
-		val, err, hit = e.Cache.Get(key, getFn)
-		collectCacheHit(e.Cache, "elastic", hit)
+		val, err = e.cacheGet("elastic", key, getFn)
 		resp = val.(*elastic.SearchResult)
 	})
 	return
@@ -130,9 +130,7 @@ func timeESRequest6(e *State, req *ElasticRequest6) (resp *elastic.SearchResult,
 			return e.ElasticHosts.Query6(req)
 		}
 		var val interface{}
-		var hit bool
-		val, err, hit = e.Cache.Get(key, getFn)
-		collectCacheHit(e.Cache, "elastic", hit)
+		val, err = e.cacheGet("elastic", key, getFn)
 		resp = val.(*elastic.SearchResult)
 	})
 	return
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"bosun.org/annotate/backend"
@@ -72,6 +73,38 @@ type BosunProviders struct {
 	History   AlertStatusProvider
 	Cache     *cache.Cache
 	Annotate  backend.Backend
+	// Cost, if not nil, records the backend queries and results of the
+	// execution.
+	Cost *Cost
+	// TSDBPlan, if not nil, coalesces the OpenTSDB requests of the
+	// execution with those of other executions.
+	TSDBPlan *TSDBPlan
+}
+
+// Cost records the cost of expression executions. It is safe for
+// concurrent use.
+type Cost struct {
+	sync.Mutex
+	Queries map[string]*models.QueryCost // By query type, like opentsdb
+	Results int
+}
+
+func (c *Cost) addQuery(qType string, hit bool, d time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+	if c.Queries == nil {
+		c.Queries = make(map[string]*models.QueryCost)
+	}
+	q := c.Queries[qType]
+	if q == nil {
+		q = &models.QueryCost{}
+		c.Queries[qType] = q
+	}
+	q.Queries++
+	if hit {
+		q.CacheHits++
+	}
+	q.Time += d.Seconds()
 }
 
 // Alert Status Provider is used to provide information about alert results.
@@ -121,7 +154,13 @@ func (e *Expr) Execute(backends *Backends, providers *BosunProviders, T miniprof
 		BosunProviders: providers,
 		Timer:          T,
 	}
-	return e.ExecuteState(s)
+	r, queries, err = e.ExecuteState(s)
+	if providers.Cost != nil && r != nil {
+		providers.Cost.Lock()
+		providers.Cost.Results += len(r.Results)
+		providers.Cost.Unlock()
+	}
+	return
 }
 
 func (e *Expr) ExecuteState(s *State) (r *Results, queries []opentsdb.Request, err error) {
@@ -839,6 +878,19 @@ func extract(res *Results) interface{} {
 	return res
 }
 
+// cacheGet gets the result of a query of type qType from the cache or by
+// calling getFn, and records it in the cache metrics and the cost of the
+// execution.
+func (e *State) cacheGet(qType, key string, getFn func() (interface{}, error)) (interface{}, error) {
+	start := time.Now()
+	val, err, hit := e.Cache.Get(key, getFn)
+	collectCacheHit(e.Cache, qType, hit)
+	if e.Cost != nil {
+		e.Cost.addQuery(qType, hit, time.Since(start))
+	}
+	return val, err
+}
+
 // collectCache is a helper function for collecting metrics on
 // the expression cache
 func collectCacheHit(c *cache.Cache, qType string, hit bool) {
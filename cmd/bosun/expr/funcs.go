@@ -124,6 +124,20 @@ func tagRename(args []parse.Node) (parse.Tags, error) {
 	return tags, nil
 }
 
+// Builtins returns the functions available in all expressions, regardless of
+// the enabled backends.
+func Builtins() map[string]parse.Func {
+	return builtins
+}
+
+//go:generate go run gendocs.go
+
+// FuncDoc returns the description of the expression function name from the
+// documentation, or "" if it has none.
+func FuncDoc(name string) string {
+	return funcDocs[name]
+}
+
 var builtins = map[string]parse.Func{
 	// Reduction functions
 
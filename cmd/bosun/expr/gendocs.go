@@ -0,0 +1,115 @@
+// +build ignore
+
+// gendocs writes funcdocs.go, the descriptions of the expression functions,
+// from the first paragraph of each function in docs/expressions.md, and the
+// example or list that follows it if it ends with a colon.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	heading = regexp.MustCompile(`^#{2,3} *([A-Za-z0-9_]+) *\(`)
+	item    = regexp.MustCompile(`^([*-]|\d+\.) `)
+	link    = regexp.MustCompile(`\]\(/`)
+)
+
+func main() {
+	f, err := os.Open("../../../docs/expressions.md")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	docs := make(map[string]string)
+	var name, block string
+	var para, more []string
+	inFunc, fence := false, false
+	end := func() {
+		if name != "" && len(para) > 0 {
+			if _, ok := docs[name]; !ok {
+				doc := strings.Join(para, " ")
+				if len(more) > 0 {
+					doc += "\n\n" + strings.Join(more, "\n")
+				}
+				docs[name] = link.ReplaceAllString(doc, "](https://bosun.org/")
+			}
+		}
+		name, block, para, more, inFunc, fence = "", "", nil, nil, false, false
+	}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		raw := strings.TrimRight(scanner.Text(), " \t")
+		line := strings.TrimSpace(raw)
+		switch {
+		case fence:
+			// The example or list introduced by a paragraph ending with a
+			// colon is kept as is.
+			more = append(more, raw)
+			if strings.HasPrefix(line, "```") {
+				end()
+			}
+		case block == "list":
+			if line == "" {
+				end()
+				continue
+			}
+			more = append(more, line)
+		case strings.HasPrefix(line, "#"):
+			end()
+			if m := heading.FindStringSubmatch(line); m != nil {
+				name = m[1]
+			}
+		case line == "{: .exprFunc}":
+			inFunc = name != ""
+		case !inFunc:
+		case line == "" && len(para) > 0 && strings.HasSuffix(para[len(para)-1], ":"):
+			block = "next"
+		case line == "":
+			if len(para) > 0 {
+				end()
+			}
+		case block == "next" && strings.HasPrefix(line, "```"):
+			fence = true
+			more = append(more, raw)
+		case block == "next" && item.MatchString(line):
+			block = "list"
+			more = append(more, line)
+		case block == "next", strings.HasPrefix(line, "```"):
+			end()
+		default:
+			para = append(para, line)
+		}
+	}
+	end()
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	names := make([]string, 0, len(docs))
+	for n := range docs {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	fmt.Fprint(&buf, "// Code generated by gendocs.go from docs/expressions.md. DO NOT EDIT.\n\npackage expr\n\nvar funcDocs = map[string]string{\n")
+	for _, n := range names {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", n, docs[n])
+	}
+	fmt.Fprint(&buf, "}\n")
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("funcdocs.go", b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -218,9 +218,7 @@ func timeGraphiteRequest(e *State, req *graphite.Request) (resp graphite.Respons
 			return e.GraphiteContext.Query(req)
 		}
 		var val interface{}
-		var hit bool
-		val, err, hit = e.Cache.Get(key, getFn)
-		collectCacheHit(e.Cache, "graphite", hit)
+		val, err = e.cacheGet("graphite", key, getFn)
 		resp = val.(graphite.Response)
 	})
 	return
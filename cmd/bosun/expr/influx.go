@@ -222,9 +222,7 @@ func timeInfluxRequest(e *State, db, query, startDuration, endDuration, groupByI
 		}
 		var val interface{}
 		var ok bool
-		var hit bool
-		val, err, hit = e.Cache.Get(q_key, getFn)
-		collectCacheHit(e.Cache, "influx", hit)
+		val, err = e.cacheGet("influx", q_key, getFn)
 		if s, ok = val.([]influxModels.Row); !ok {
 			err = fmt.Errorf("influx: did not get a valid result from InfluxDB")
 		}
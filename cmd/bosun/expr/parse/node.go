@@ -462,6 +462,8 @@ func Walk(n Node, f func(Node)) {
 		// Ignore.
 	case *UnaryNode:
 		Walk(n.Arg, f)
+	case *PrefixNode:
+		Walk(n.Arg, f)
 	default:
 		panic(fmt.Errorf("other type: %T", n))
 	}
@@ -440,8 +440,7 @@ func timePromRequest(e *State, prefix, query string, start, end time.Time, step
 			}
 			return m, nil
 		}
-		val, err, hit := e.Cache.Get(string(cacheKeyBytes), getFn)
-		collectCacheHit(e.Cache, "prom_ts", hit)
+		val, err := e.cacheGet("prom_ts", string(cacheKeyBytes), getFn)
 		var ok bool
 		if s, ok = val.(promModels.Matrix); !ok {
 			err = fmt.Errorf("prom: did not get valid result from prometheus, %v", err)
@@ -502,8 +501,7 @@ func PromMetricList(prefix string, e *State) (r *Results, err error) {
 		}
 		return metrics, nil
 	}
-	val, err, hit := e.Cache.Get(fmt.Sprintf("%v:metriclist", prefix), getFn)
-	collectCacheHit(e.Cache, "prom_metrics", hit)
+	val, err := e.cacheGet("prom_metrics", fmt.Sprintf("%v:metriclist", prefix), getFn)
 	if err != nil {
 		return nil, err
 	}
@@ -541,8 +539,7 @@ func PromTagInfo(prefix string, e *State, metric, sdur, edur string) (r *Results
 		}
 		return m, nil
 	}
-	val, err, hit := e.Cache.Get(fmt.Sprintf("%v:%v:taginfo", prefix, metric), getFn)
-	collectCacheHit(e.Cache, "prom_metrics", hit)
+	val, err := e.cacheGet("prom_metrics", fmt.Sprintf("%v:%v:taginfo", prefix, metric), getFn)
 	if err != nil {
 		return nil, err
 	}
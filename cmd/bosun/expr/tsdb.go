@@ -87,26 +87,47 @@ func timeTSDBRequest(e *State, req *opentsdb.Request) (s opentsdb.ResponseSet, e
 			}
 		}
 	}
-	b, _ := json.MarshalIndent(req, "", "  ")
+	// A request covered by a planned request is sliced from the responses
+	// of the planned request, which is made once for all its requests.
+	sent := req
+	if e.TSDBPlan != nil && e.autods == 0 {
+		if w := e.TSDBPlan.Cover(req); w != nil {
+			sent = w
+		}
+	}
+	b, _ := json.MarshalIndent(sent, "", "  ")
 	tries := 1
 	for {
 		e.Timer.StepCustomTiming("tsdb", "query", string(b), func() {
 			getFn := func() (interface{}, error) {
-				return e.TSDBContext.Query(req)
+				return e.TSDBContext.Query(sent)
 			}
 			var val interface{}
-			var hit bool
-			val, err, hit = e.Cache.Get(string(b), getFn)
-			collectCacheHit(e.Cache, "opentsdb", hit)
+			val, err = e.cacheGet("opentsdb", string(b), getFn)
 			rs := val.(opentsdb.ResponseSet)
-			s = rs.Copy()
+			if sent != req {
+				s = sliceResponses(rs, req)
+			} else {
+				s = rs.Copy()
+			}
 			for _, r := range rs {
 				if r.SQL != "" {
 					e.Timer.AddCustomTiming("sql", "query", time.Now(), time.Now(), r.SQL)
 				}
 			}
 		})
-		if err == nil || tries == tsdbMaxTries {
+		if err == nil {
+			break
+		}
+		// A failed planned request may be failing because it is wider than
+		// req, so req is then requested by itself.
+		if sent != req {
+			slog.Errorf("Error on planned tsdb query, querying alone: %s", err.Error())
+			sent = req
+			b, _ = json.MarshalIndent(sent, "", "  ")
+			continue
+		}
+		if tries == tsdbMaxTries {
 			break
 		}
 		slog.Errorf("Error on tsdb query %d: %s", tries, err.Error())
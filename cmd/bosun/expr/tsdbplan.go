@@ -0,0 +1,377 @@
+package expr
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/opentsdb"
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+// A TSDBPlan coalesces the OpenTSDB requests of expressions executed at the
+// same time, like the alerts of a check cycle. Requests of the same query
+// whose time ranges overlap, or whose time ranges are equal and which differ
+// only in the literal or wildcard values of their group by filters, are
+// merged into a wider request. The wider request is made once, and its
+// responses are sliced by time and tags for each request. A plan never
+// requests data that none of its requests asked for.
+//
+// Since a wider request is aggregated and downsampled over a longer time
+// range, values at the start of a time range may include points just
+// before it. Requests are not merged beyond tsdbPlanMaxValues filter values
+// or tsdbPlanMaxSpan, and a request whose planned request fails is made by
+// itself.
+type TSDBPlan struct {
+	now     time.Time
+	version opentsdb.Version
+	// windows are the merged requests by the key of their query.
+	windows map[string][]*opentsdb.Request
+}
+
+// NewTSDBPlan returns an empty plan for expressions executed at now against
+// an OpenTSDB of version.
+func NewTSDBPlan(now time.Time, version opentsdb.Version) *TSDBPlan {
+	return &TSDBPlan{
+		now:     now,
+		version: version,
+		windows: make(map[string][]*opentsdb.Request),
+	}
+}
+
+const (
+	// tsdbPlanMaxValues is the most values of a literal group by filter
+	// merged from the filters of several requests.
+	tsdbPlanMaxValues = 100
+	// tsdbPlanMaxSpan is the longest time range, in seconds, of a request
+	// merged from requests with overlapping time ranges.
+	tsdbPlanMaxSpan = int64(24 * time.Hour / time.Second)
+)
+
+// planContext is the OpenTSDB context used to find the requests of
+// expressions. It answers all requests without data.
+type planContext struct {
+	version opentsdb.Version
+}
+
+func (c planContext) Query(*opentsdb.Request) (opentsdb.ResponseSet, error) {
+	return nil, nil
+}
+
+func (c planContext) Version() opentsdb.Version {
+	return c.version
+}
+
+// Add adds the OpenTSDB requests of e to the plan. Only the calls of OpenTSDB
+// functions whose arguments are all literals are planned, the requests of
+// other calls are made as usual. Add must not be called once the plan is
+// used to execute expressions.
+func (p *TSDBPlan) Add(e *Expr) {
+	// Without filter support, queries are expanded by search when they are
+	// executed.
+	if !p.version.FilterSupport() {
+		return
+	}
+	s := &State{
+		Expr:     e,
+		now:      p.now,
+		Timer:    new(miniprofiler.Profile),
+		Backends: &Backends{TSDBContext: planContext{p.version}},
+		BosunProviders: &BosunProviders{
+			Squelched: func(opentsdb.TagSet) bool { return false },
+		},
+	}
+	parse.Walk(e.Tree.Root, func(n parse.Node) {
+		f, ok := n.(*parse.FuncNode)
+		if !ok {
+			return
+		}
+		fn, ok := TSDB[f.Name]
+		if !ok {
+			return
+		}
+		args := []reflect.Value{reflect.ValueOf(s)}
+		for _, a := range f.Args {
+			switch a := a.(type) {
+			case *parse.StringNode:
+				args = append(args, reflect.ValueOf(a.Text))
+			case *parse.NumberNode:
+				args = append(args, reflect.ValueOf(a.Float64))
+			default:
+				return
+			}
+		}
+		// Errors of the call are left to its execution.
+		defer func() { recover() }()
+		reflect.ValueOf(fn.F).Call(args)
+	})
+	for i := range s.tsdbQueries {
+		p.add(&s.tsdbQueries[i])
+	}
+}
+
+func (p *TSDBPlan) add(req *opentsdb.Request) {
+	key, ok := planKey(req)
+	if !ok {
+		return
+	}
+	start, end := planRange(req)
+	w := planRequest(req, start, end, groupByFilters(req))
+	windows := p.windows[key]
+	for merged := true; merged; {
+		merged = false
+		for i, o := range windows {
+			if m := mergeRequests(w, o); m != nil {
+				w = m
+				windows = append(windows[:i], windows[i+1:]...)
+				merged = true
+				break
+			}
+		}
+	}
+	p.windows[key] = append(windows, w)
+}
+
+// Cover returns the planned request that covers req, or nil if req was not
+// planned.
+func (p *TSDBPlan) Cover(req *opentsdb.Request) *opentsdb.Request {
+	key, ok := planKey(req)
+	if !ok {
+		return nil
+	}
+	for _, w := range p.windows[key] {
+		if covers(w, req) {
+			return w
+		}
+	}
+	return nil
+}
+
+// planKey returns the key of the query of req, which is the query without
+// the values of its group by filters. It returns false if req can not be
+// planned.
+func planKey(req *opentsdb.Request) (string, bool) {
+	if len(req.Queries) != 1 {
+		return "", false
+	}
+	if _, ok := req.Start.(int64); !ok {
+		return "", false
+	}
+	if _, ok := req.End.(int64); !ok {
+		return "", false
+	}
+	q := *req.Queries[0]
+	if len(q.Tags) != 0 {
+		return "", false
+	}
+	seen := make(map[string]bool)
+	q.Filters = nil
+	for _, f := range req.Queries[0].Filters {
+		if f.GroupBy {
+			if seen[f.TagK] {
+				return "", false
+			}
+			seen[f.TagK] = true
+			f.Type, f.Filter = "", ""
+		}
+		q.Filters = append(q.Filters, f)
+	}
+	sort.Slice(q.Filters, func(i, j int) bool {
+		return q.Filters[i].String() < q.Filters[j].String()
+	})
+	b, err := json.Marshal(q)
+	if err != nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+func planRange(req *opentsdb.Request) (start, end int64) {
+	return req.Start.(int64), req.End.(int64)
+}
+
+// planRequest returns a copy of req from start to end with the group by
+// filters filters.
+func planRequest(req *opentsdb.Request, start, end int64, filters map[string]opentsdb.Filter) *opentsdb.Request {
+	q := *req.Queries[0]
+	q.Filters = nil
+	for _, f := range req.Queries[0].Filters {
+		if f.GroupBy {
+			f = filters[f.TagK]
+		}
+		q.Filters = append(q.Filters, f)
+	}
+	r := *req
+	r.Start = start
+	r.End = end
+	r.Queries = []*opentsdb.Query{&q}
+	return &r
+}
+
+func groupByFilters(req *opentsdb.Request) map[string]opentsdb.Filter {
+	filters := make(map[string]opentsdb.Filter)
+	for _, f := range req.Queries[0].Filters {
+		if f.GroupBy {
+			filters[f.TagK] = f
+		}
+	}
+	return filters
+}
+
+// mergeRequests returns the request merging the requests a and b of the same
+// query, or nil if they are not merged.
+func mergeRequests(a, b *opentsdb.Request) *opentsdb.Request {
+	if covers(a, b) {
+		return a
+	}
+	if covers(b, a) {
+		return b
+	}
+	as, ae := planRange(a)
+	bs, be := planRange(b)
+	af, bf := groupByFilters(a), groupByFilters(b)
+	if reflect.DeepEqual(af, bf) && as <= be && bs <= ae {
+		if bs < as {
+			as = bs
+		}
+		if be > ae {
+			ae = be
+		}
+		if ae-as > tsdbPlanMaxSpan {
+			return nil
+		}
+		return planRequest(a, as, ae, af)
+	}
+	if as != bs || ae != be {
+		return nil
+	}
+	filters := make(map[string]opentsdb.Filter)
+	for k, f := range af {
+		m, ok := mergeFilters(f, bf[k])
+		if !ok {
+			return nil
+		}
+		filters[k] = m
+	}
+	return planRequest(a, as, ae, filters)
+}
+
+// isWildcard returns whether f matches all values of its tag key.
+func isWildcard(f opentsdb.Filter) bool {
+	return f.Type == "wildcard" && f.Filter == "*"
+}
+
+// mergeFilters returns the filter matching the values of the filters a and
+// b, which only exists for equal, literal or wildcard filters, and for
+// literal filters of at most tsdbPlanMaxValues values.
+func mergeFilters(a, b opentsdb.Filter) (opentsdb.Filter, bool) {
+	if a == b {
+		return a, true
+	}
+	if a.Type != "literal_or" && !isWildcard(a) || b.Type != "literal_or" && !isWildcard(b) {
+		return a, false
+	}
+	if isWildcard(a) {
+		return a, true
+	}
+	if isWildcard(b) {
+		return b, true
+	}
+	values := make(map[string]bool)
+	for _, v := range strings.Split(a.Filter+"|"+b.Filter, "|") {
+		values[v] = true
+	}
+	if len(values) > tsdbPlanMaxValues {
+		return a, false
+	}
+	var vs []string
+	for v := range values {
+		vs = append(vs, v)
+	}
+	sort.Strings(vs)
+	a.Filter = strings.Join(vs, "|")
+	return a, true
+}
+
+// covers returns whether the request w of the same query as req returns all
+// the data of req.
+func covers(w, req *opentsdb.Request) bool {
+	ws, we := planRange(w)
+	rs, re := planRange(req)
+	if rs < ws || re > we {
+		return false
+	}
+	wf := groupByFilters(w)
+	for k, f := range groupByFilters(req) {
+		if !filterCovers(wf[k], f) {
+			return false
+		}
+	}
+	return true
+}
+
+func filterCovers(w, f opentsdb.Filter) bool {
+	switch {
+	case w == f:
+		return true
+	case f.Type != "literal_or":
+		return false
+	case isWildcard(w):
+		return true
+	case w.Type != "literal_or":
+		return false
+	}
+	for _, v := range strings.Split(f.Filter, "|") {
+		if !filterMatches(w, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterMatches returns whether the tag value v matches f. Filters that are
+// neither literal nor wildcard filters are never merged, so they match the
+// values of the responses of their request.
+func filterMatches(f opentsdb.Filter, v string) bool {
+	if f.Type != "literal_or" {
+		return true
+	}
+	for _, l := range strings.Split(f.Filter, "|") {
+		if l == v {
+			return true
+		}
+	}
+	return false
+}
+
+// sliceResponses returns the responses of rs, the responses of a request
+// covering req, that are responses of req.
+func sliceResponses(rs opentsdb.ResponseSet, req *opentsdb.Request) opentsdb.ResponseSet {
+	start, end := planRange(req)
+	filters := groupByFilters(req)
+	var s opentsdb.ResponseSet
+Loop:
+	for _, r := range rs {
+		for k, f := range filters {
+			if !filterMatches(f, r.Tags[k]) {
+				continue Loop
+			}
+		}
+		n := r.Copy()
+		for k := range n.DPS {
+			t, err := strconv.ParseInt(k, 10, 64)
+			if err != nil || t < start || t > end {
+				delete(n.DPS, k)
+			}
+		}
+		if len(n.DPS) == 0 {
+			continue
+		}
+		s = append(s, n)
+	}
+	return s
+}
@@ -0,0 +1,221 @@
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"bosun.org/opentsdb"
+)
+
+// planTestContext is an OpenTSDB with a point per minute of the hosts a and
+// b, which records its distinct requests. Requests for which fail returns
+// true fail.
+type planTestContext struct {
+	sync.Mutex
+	requests map[string]bool
+	fail     func(*opentsdb.Request) bool
+}
+
+func (c *planTestContext) Query(req *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	c.Lock()
+	c.requests[string(b)] = true
+	c.Unlock()
+	if c.fail != nil && c.fail(req) {
+		return nil, fmt.Errorf("failed request")
+	}
+	start, end := req.Start.(int64), req.End.(int64)
+	var rs opentsdb.ResponseSet
+	for _, host := range []string{"a", "b"} {
+		if f := req.Queries[0].Filters[0]; f.Type == "literal_or" && !strings.Contains("|"+f.Filter+"|", "|"+host+"|") {
+			continue
+		}
+		r := &opentsdb.Response{
+			Metric: req.Queries[0].Metric,
+			Tags:   opentsdb.TagSet{"host": host},
+			DPS:    make(map[string]opentsdb.Point),
+		}
+		for t := start - start%60; t <= end; t += 60 {
+			if t >= start {
+				r.DPS[fmt.Sprint(t)] = 1
+			}
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (c *planTestContext) Version() opentsdb.Version {
+	return opentsdb.Version2_2
+}
+
+func TestTSDBPlan(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	exprs := []string{
+		`sum(q("sum:m{host=a}", "10m", ""))`,
+		`sum(q("sum:m{host=b}", "10m", ""))`,
+		`sum(q("sum:m{host=*}", "10m", ""))`,
+		`sum(q("sum:m{host=a}", "1h", "30m"))`,
+		`sum(q("sum:m{host=a}", "40m", "5m"))`,
+		`sum(q("sum:n{host=a}", "10m", ""))`,
+	}
+	expected := []string{
+		"{host=a}11",
+		"{host=b}11",
+		"{host=a}11{host=b}11",
+		"{host=a}31",
+		"{host=a}36",
+		"{host=a}11",
+	}
+	run := func(plan *TSDBPlan) int {
+		tsdb := &planTestContext{requests: make(map[string]bool)}
+		for i, text := range exprs {
+			e, err := New(text, TSDB)
+			if err != nil {
+				t.Fatal(err)
+			}
+			providers := &BosunProviders{TSDBPlan: plan}
+			r, _, err := e.Execute(&Backends{TSDBContext: tsdb}, providers, nil, now, 0, false, t.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := ""
+			for _, res := range r.Results {
+				got += fmt.Sprintf("%v%v", res.Group, res.Value)
+			}
+			if got != expected[i] {
+				t.Errorf("%s: expected %s, got %s", text, expected[i], got)
+			}
+		}
+		return len(tsdb.requests)
+	}
+	if n := run(nil); n != len(exprs) {
+		t.Errorf("expected %d requests without plan, got %d", len(exprs), n)
+	}
+	plan := NewTSDBPlan(now, opentsdb.Version2_2)
+	for _, text := range exprs {
+		e, err := New(text, TSDB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		plan.Add(e)
+	}
+	// The filters of the first three queries are merged, and the time
+	// ranges of the next two.
+	if n := run(plan); n != 3 {
+		t.Errorf("expected 3 requests with plan, got %d", n)
+	}
+}
+
+func TestMergeFilters(t *testing.T) {
+	lit := func(v string) opentsdb.Filter {
+		return opentsdb.Filter{Type: "literal_or", TagK: "host", Filter: v, GroupBy: true}
+	}
+	wild := opentsdb.Filter{Type: "wildcard", TagK: "host", Filter: "*", GroupBy: true}
+	re := opentsdb.Filter{Type: "regexp", TagK: "host", Filter: "a.*", GroupBy: true}
+	tests := []struct {
+		a, b opentsdb.Filter
+		m    opentsdb.Filter
+		ok   bool
+	}{
+		{lit("b|a"), lit("c|a"), lit("a|b|c"), true},
+		{lit("a"), wild, wild, true},
+		{re, re, re, true},
+		{re, lit("a"), re, false},
+		{wild, re, wild, false},
+		{lit(strings.Repeat("a|", tsdbPlanMaxValues-1) + "a"), lit("b"), lit("a|b"), true},
+	}
+	for _, test := range tests {
+		m, ok := mergeFilters(test.a, test.b)
+		if ok != test.ok || ok && m != test.m {
+			t.Errorf("%v, %v: expected %v %v, got %v %v", test.a, test.b, test.m, test.ok, m, ok)
+		}
+	}
+}
+
+func TestMergeFiltersMaxValues(t *testing.T) {
+	var a, b []string
+	for i := 0; i < tsdbPlanMaxValues; i++ {
+		a = append(a, fmt.Sprint("a", i))
+		b = append(b, fmt.Sprint("b", i))
+	}
+	f := opentsdb.Filter{Type: "literal_or", TagK: "host", GroupBy: true}
+	fa, fb := f, f
+	fa.Filter = strings.Join(a, "|")
+	fb.Filter = strings.Join(b, "|")
+	if _, ok := mergeFilters(fa, fb); ok {
+		t.Errorf("expected filters of %d values not to be merged", 2*tsdbPlanMaxValues)
+	}
+}
+
+func TestMergeRequestsMaxSpan(t *testing.T) {
+	req := func(start, end int64) *opentsdb.Request {
+		return &opentsdb.Request{
+			Start:   start,
+			End:     end,
+			Queries: []*opentsdb.Query{{Metric: "m", Aggregator: "sum"}},
+		}
+	}
+	day := tsdbPlanMaxSpan
+	if m := mergeRequests(req(0, day/2+1), req(day/2, day)); m == nil {
+		t.Error("expected requests within a day to be merged")
+	}
+	if m := mergeRequests(req(0, day/2+1), req(day/2, day+1)); m != nil {
+		t.Errorf("expected requests over a day not to be merged, got %v to %v", m.Start, m.End)
+	}
+	// A request covering another is used even if it is longer.
+	if m := mergeRequests(req(0, 2*day), req(day/2, day)); m == nil {
+		t.Error("expected covered request to be merged")
+	}
+}
+
+func TestTSDBPlanFallback(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	exprs := []string{
+		`sum(q("sum:m{host=a}", "10m", ""))`,
+		`sum(q("sum:m{host=b}", "10m", ""))`,
+	}
+	plan := NewTSDBPlan(now, opentsdb.Version2_2)
+	for _, text := range exprs {
+		e, err := New(text, TSDB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		plan.Add(e)
+	}
+	// The planned request of both hosts fails.
+	tsdb := &planTestContext{
+		requests: make(map[string]bool),
+		fail: func(req *opentsdb.Request) bool {
+			return strings.Contains(req.Queries[0].Filters[0].Filter, "|")
+		},
+	}
+	for i, text := range exprs {
+		e, err := New(text, TSDB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		providers := &BosunProviders{TSDBPlan: plan}
+		r, _, err := e.Execute(&Backends{TSDBContext: tsdb}, providers, nil, now, 0, false, t.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.Results) != 1 || r.Results[0].Value != Number(11) {
+			t.Errorf("%s: bad results %v", text, r.Results)
+		}
+		if host := []string{"a", "b"}[i]; len(r.Results) == 1 && r.Results[0].Group["host"] != host {
+			t.Errorf("%s: expected host %s, got %v", text, host, r.Results[0].Group)
+		}
+	}
+	// The planned request, then each request alone.
+	if n := len(tsdb.requests); n != 3 {
+		t.Errorf("expected 3 requests, got %d", n)
+	}
+}
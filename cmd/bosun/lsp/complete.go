@@ -0,0 +1,220 @@
+package lsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/cmd/bosun/expr"
+	eparse "bosun.org/cmd/bosun/expr/parse"
+)
+
+// maxItems is the maximum number of completion items returned, so that
+// thousands of metrics or tag values do not slow down the editor.
+const maxItems = 500
+
+// maxHoverLines is the maximum number of lines of a section shown on hover.
+const maxHoverLines = 20
+
+// funcs returns the expression functions available with backends in the
+// rule file c, including its funcs.
+func funcs(c *rule.Conf, backends conf.EnabledBackends) map[string]eparse.Func {
+	fs := c.GetFuncs(backends)
+	for name, f := range expr.Builtins() {
+		fs[name] = f
+	}
+	return fs
+}
+
+// signature returns the signature of the function name, like
+// q(string, string, string) seriesSet.
+func signature(name string, f eparse.Func) string {
+	args := make([]string, len(f.Args))
+	for i, a := range f.Args {
+		args[i] = a.String()
+		if f.VArgs && i == f.VArgsPos {
+			args[i] += "..."
+		}
+	}
+	return fmt.Sprintf("%s(%s) %s", name, strings.Join(args, ", "), f.Return)
+}
+
+func (s *Server) complete(d *document, off int) (*completionList, error) {
+	ctx := contextAt(d.text, off)
+	var names []string
+	var kind int
+	var err error
+	switch ctx.Kind {
+	case ctxKeyword:
+		names, kind = sectionTypes, kindKeyword
+	case ctxSection:
+		for _, n := range sectionNames(d.text) {
+			if n.Type == ctx.Section {
+				names = append(names, n.Name)
+			}
+		}
+		kind = kindReference
+	case ctxFunc:
+		for name := range d.funcs {
+			names = append(names, name)
+		}
+		kind = kindFunction
+	case ctxMetric:
+		if s.search != nil {
+			names, err = s.search.UniqueMetrics(0)
+		}
+		kind = kindField
+	case ctxTagKey:
+		if s.search != nil {
+			names, err = s.search.TagKeysByMetric(ctx.Metric)
+		}
+		kind = kindProperty
+	case ctxTagValue:
+		if s.search != nil {
+			names, err = s.search.TagValuesByMetricTagKey(ctx.Metric, ctx.TagKey, 0)
+		}
+		kind = kindValue
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	list := &completionList{Items: []completionItem{}}
+	r := Range{
+		Start: positionOf(d.text, off-len(ctx.Prefix)),
+		End:   positionOf(d.text, off),
+	}
+	for _, name := range names {
+		if !strings.HasPrefix(name, ctx.Prefix) {
+			continue
+		}
+		if len(list.Items) == maxItems {
+			list.IsIncomplete = true
+			break
+		}
+		item := completionItem{
+			Label:    name,
+			Kind:     kind,
+			TextEdit: &textEdit{Range: r, NewText: name},
+		}
+		if ctx.Kind == ctxFunc {
+			item.Detail = signature(name, d.funcs[name])
+		}
+		list.Items = append(list.Items, item)
+	}
+	return list, nil
+}
+
+// reference returns the context of the function or section name at the
+// offset off, and the offset of the start of the name.
+func reference(d *document, off int) (completionContext, int) {
+	_, end := wordAt(d.text, off)
+	ctx := contextAt(d.text, end)
+	if ctx.Prefix == "" {
+		return completionContext{}, end
+	}
+	return ctx, end - len(ctx.Prefix)
+}
+
+func (s *Server) hover(d *document, off int) *hover {
+	ctx, start := reference(d, off)
+	var value string
+	switch ctx.Kind {
+	case ctxFunc:
+		f, ok := d.funcs[ctx.Prefix]
+		if !ok {
+			return nil
+		}
+		value = fmt.Sprintf("```\n%s\n```\n\n", signature(ctx.Prefix, f))
+		if doc := expr.FuncDoc(ctx.Prefix); doc != "" {
+			value += doc + "\n\n"
+		}
+		value += "See https://bosun.org/expressions."
+	case ctxSection:
+		sstart, send, ok := d.section(ctx.Section, ctx.Prefix)
+		if !ok {
+			return nil
+		}
+		lines := strings.Split(d.text[sstart:send], "\n")
+		if len(lines) > maxHoverLines {
+			lines = append(lines[:maxHoverLines], "...")
+		}
+		value = "```\n" + strings.Join(lines, "\n") + "\n```"
+	default:
+		return nil
+	}
+	return &hover{
+		Contents: markupContent{Kind: "markdown", Value: value},
+		Range: &Range{
+			Start: positionOf(d.text, start),
+			End:   positionOf(d.text, start+len(ctx.Prefix)),
+		},
+	}
+}
+
+func (s *Server) definition(d *document, off int) *Location {
+	ctx, _ := reference(d, off)
+	if ctx.Kind != ctxSection {
+		return nil
+	}
+	start, end, ok := d.section(ctx.Section, ctx.Prefix)
+	if !ok {
+		return nil
+	}
+	return &Location{
+		URI: d.uri,
+		Range: Range{
+			Start: positionOf(d.text, start),
+			End:   positionOf(d.text, end),
+		},
+	}
+}
+
+// section returns the offsets of the start and end of the section name of
+// type typ. The locations recorded by the loaders are used if the text
+// parses, else only the line declaring the section is found.
+func (d *document) section(typ, name string) (start, end int, ok bool) {
+	if c := d.conf; c != nil {
+		var loc conf.Locator
+		switch typ {
+		case "alert":
+			if a := c.Alerts[name]; a != nil {
+				loc = a.Locator
+			}
+		case "template":
+			if t := c.Templates[name]; t != nil {
+				loc = t.Locator
+			}
+		case "notification":
+			if n := c.Notifications[name]; n != nil {
+				loc = n.Locator
+			}
+		case "lookup":
+			if l := c.Lookups[name]; l != nil {
+				loc = l.Locator
+			}
+		case "macro":
+			if m := c.Macros[name]; m != nil {
+				loc = m.Locator
+			}
+		case "func":
+			if f := c.Funcs[name]; f != nil {
+				loc = f.Locator
+			}
+		}
+		l, ok := loc.(rule.Location)
+		if !ok || len(l) != 2 || l[1] > len(d.text) {
+			return 0, 0, false
+		}
+		return l[0], l[1], true
+	}
+	for _, n := range sectionNames(d.text) {
+		if n.Type == typ && n.Name == name {
+			start := strings.LastIndexByte(d.text[:n.Start], '\n') + 1
+			return start, lineEnd(d.text, n.Start), true
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,230 @@
+// Package lsp implements a language server for bosun rule files, so they can
+// be edited in editors that speak the language server protocol.
+package lsp // import "bosun.org/cmd/bosun/lsp"
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	eparse "bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/cmd/bosun/search"
+	"bosun.org/slog"
+)
+
+// Server is a language server for rule files.
+type Server struct {
+	backends conf.EnabledBackends
+	sysVars  map[string]string
+	search   *search.Search
+	// funcs are the functions of rule files without funcs.
+	funcs map[string]eparse.Func
+
+	docs map[string]*document
+	w    io.Writer
+}
+
+// A document is a rule file open in the editor.
+type document struct {
+	uri     string
+	name    string
+	version int
+	text    string
+	// conf is the parsed text, or nil if it does not parse.
+	conf *rule.Conf
+	// funcs are the expression functions, including the funcs of conf.
+	funcs map[string]eparse.Func
+}
+
+// NewServer returns a server that checks rule files with the given backends
+// and system variables. Metrics and tags are completed from s, which may be
+// nil.
+func NewServer(backends conf.EnabledBackends, sysVars map[string]string, s *search.Search) *Server {
+	return &Server{
+		backends: backends,
+		sysVars:  sysVars,
+		search:   s,
+		funcs:    funcs(&rule.Conf{}, backends),
+		docs:     make(map[string]*document),
+	}
+}
+
+// Serve reads requests from r and writes responses to w until the client
+// exits or r is closed.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	s.w = w
+	br := bufio.NewReader(r)
+	for {
+		m, err := readMessage(br)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if m.Method == "exit" {
+			return nil
+		}
+		result, err := s.handle(m)
+		if m.ID == nil {
+			// Unknown notifications, like $/cancelRequest, are ignored.
+			if rerr, ok := err.(*responseError); err != nil && (!ok || rerr.Code != codeMethodNotFound) {
+				slog.Errorf("lsp: %s: %v", m.Method, err)
+			}
+			continue
+		}
+		resp := &message{ID: m.ID}
+		if err != nil {
+			rerr, ok := err.(*responseError)
+			if !ok {
+				rerr = &responseError{Code: codeInvalidParams, Message: err.Error()}
+			}
+			resp.Error = rerr
+		} else {
+			b, err := json.Marshal(result)
+			if err != nil {
+				return err
+			}
+			raw := json.RawMessage(b)
+			resp.Result = &raw
+		}
+		if err := writeMessage(w, resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return writeMessage(s.w, &message{Method: method, Params: b})
+}
+
+func (s *Server) handle(m *message) (interface{}, error) {
+	switch m.Method {
+	case "initialize":
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync": 1, // full
+				"completionProvider": map[string]interface{}{
+					"triggerCharacters": []string{"\"", ":", "{", "=", ",", "|"},
+				},
+				"hoverProvider":      true,
+				"definitionProvider": true,
+			},
+			"serverInfo": map[string]string{"name": "bosun"},
+		}, nil
+	case "initialized", "shutdown":
+		return nil, nil
+	case "textDocument/didOpen":
+		var p didOpenParams
+		if err := json.Unmarshal(m.Params, &p); err != nil {
+			return nil, err
+		}
+		return nil, s.update(p.TextDocument.URI, p.TextDocument.Version, p.TextDocument.Text)
+	case "textDocument/didChange":
+		var p didChangeParams
+		if err := json.Unmarshal(m.Params, &p); err != nil {
+			return nil, err
+		}
+		if len(p.ContentChanges) == 0 {
+			return nil, nil
+		}
+		text := p.ContentChanges[len(p.ContentChanges)-1].Text
+		return nil, s.update(p.TextDocument.URI, p.TextDocument.Version, text)
+	case "textDocument/didClose":
+		var p didCloseParams
+		if err := json.Unmarshal(m.Params, &p); err != nil {
+			return nil, err
+		}
+		delete(s.docs, p.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI:         p.TextDocument.URI,
+			Diagnostics: []diagnostic{},
+		})
+	case "textDocument/completion", "textDocument/hover", "textDocument/definition":
+		var p textDocumentPositionParams
+		if err := json.Unmarshal(m.Params, &p); err != nil {
+			return nil, err
+		}
+		d := s.docs[p.TextDocument.URI]
+		if d == nil {
+			return nil, nil
+		}
+		off := offsetOf(d.text, p.Position)
+		switch m.Method {
+		case "textDocument/completion":
+			return s.complete(d, off)
+		case "textDocument/hover":
+			return s.hover(d, off), nil
+		default:
+			return s.definition(d, off), nil
+		}
+	}
+	return nil, &responseError{Code: codeMethodNotFound, Message: "method not found: " + m.Method}
+}
+
+// update sets the text of the document uri and publishes its diagnostics.
+func (s *Server) update(uri string, version int, text string) error {
+	d := &document{
+		uri:     uri,
+		name:    path.Base(uri),
+		version: version,
+		text:    text,
+		funcs:   s.funcs,
+	}
+	s.docs[uri] = d
+	diags := []diagnostic{}
+	c, err := rule.NewConf(d.name, s.backends, s.sysVars, text)
+	if err != nil {
+		diags = append(diags, d.diagnostic(err))
+	} else {
+		d.conf = c
+		if len(c.Funcs) > 0 {
+			d.funcs = funcs(c, s.backends)
+		}
+	}
+	return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         uri,
+		Version:     version,
+		Diagnostics: diags,
+	})
+}
+
+// diagnostic returns the diagnostic of the error err of the parsers or
+// loaders, which has the location of the error in its message.
+func (d *document) diagnostic(err error) diagnostic {
+	msg := err.Error()
+	line, col := 0, 0
+	name := regexp.QuoteMeta(d.name)
+	if m := regexp.MustCompile(`(?s)^conf: ` + name + `:(\d+):(\d+): at <.*?>: (.*)$`).FindStringSubmatch(msg); m != nil {
+		line, _ = strconv.Atoi(m[1])
+		col, _ = strconv.Atoi(m[2])
+		msg = m[3]
+	} else if m := regexp.MustCompile(`(?s)^parse: ` + name + `:(\d+): (.*)$`).FindStringSubmatch(msg); m != nil {
+		line, _ = strconv.Atoi(m[1])
+		msg = m[2]
+	} else if m := regexp.MustCompile(`(?s)^conf: ` + name + `: (.*)$`).FindStringSubmatch(msg); m != nil {
+		msg = m[1]
+	}
+	start := offsetOf(d.text, Position{Line: line - 1})
+	if end := lineEnd(d.text, start); start+col <= end {
+		start += col
+	}
+	return diagnostic{
+		Range: Range{
+			Start: positionOf(d.text, start),
+			End:   positionOf(d.text, lineEnd(d.text, start)),
+		},
+		Severity: severityError,
+		Source:   "bosun",
+		Message:  msg,
+	}
+}
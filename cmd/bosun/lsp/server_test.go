@@ -0,0 +1,135 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"bosun.org/cmd/bosun/conf"
+)
+
+// TestServe runs a session of an editor opening, changing and querying a
+// rule file over the protocol.
+func TestServe(t *testing.T) {
+	const uri = "file:///etc/bosun/rules.conf"
+	valid := strings.Join([]string{
+		"template t {",
+		"	subject = x",
+		"	body = y",
+		"}",
+		"alert a {",
+		"	template = t",
+		`	warn = avg(q("sum:os.cpu", "5m", "")) > 1`,
+		"}",
+	}, "\n")
+	var in bytes.Buffer
+	id := 0
+	send := func(method string, params interface{}) {
+		b, err := json.Marshal(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := &message{Method: method, Params: b}
+		if !strings.HasPrefix(method, "textDocument/did") && method != "exit" {
+			id++
+			raw := json.RawMessage(strconv.Itoa(id))
+			m.ID = &raw
+		}
+		if err := writeMessage(&in, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	at := func(line, char int) textDocumentPositionParams {
+		p := textDocumentPositionParams{Position: Position{Line: line, Character: char}}
+		p.TextDocument.URI = uri
+		return p
+	}
+	send("initialize", map[string]interface{}{})
+	send("textDocument/didOpen", didOpenParams{TextDocument: textDocumentItem{
+		URI:     uri,
+		Version: 1,
+		Text:    strings.Replace(valid, "avg(", "nope(", 1),
+	}})
+	send("textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri, "version": 2},
+		"contentChanges": []map[string]string{{"text": valid}},
+	})
+	send("textDocument/completion", at(6, 10))
+	send("textDocument/hover", at(6, 10))
+	send("textDocument/definition", at(5, 13))
+	send("shutdown", nil)
+	send("exit", nil)
+
+	var out bytes.Buffer
+	s := NewServer(conf.EnabledBackends{OpenTSDB: true}, nil, nil)
+	if err := s.Serve(&in, &out); err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(&out)
+	next := func(v interface{}) *message {
+		m, err := readMessage(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Error != nil {
+			t.Fatalf("%s: %v", m.Method, m.Error)
+		}
+		b := []byte(m.Params)
+		if m.Result != nil {
+			b = *m.Result
+		}
+		if err := json.Unmarshal(b, v); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	var init struct {
+		Capabilities map[string]interface{}
+	}
+	next(&init)
+	if init.Capabilities["hoverProvider"] != true || init.Capabilities["definitionProvider"] != true {
+		t.Errorf("bad capabilities: %v", init.Capabilities)
+	}
+
+	var diags publishDiagnosticsParams
+	if m := next(&diags); m.Method != "textDocument/publishDiagnostics" || len(diags.Diagnostics) != 1 {
+		t.Fatalf("expected a diagnostic of the unknown function, got %s %+v", m.Method, diags)
+	}
+	if d := diags.Diagnostics[0]; d.Range.Start.Line != 6 || !strings.Contains(d.Message, "nope") {
+		t.Errorf("bad diagnostic: %+v", d)
+	}
+	if next(&diags); diags.Version != 2 || len(diags.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostics after the fix, got %+v", diags)
+	}
+
+	var list completionList
+	next(&list)
+	var avg *completionItem
+	for i, item := range list.Items {
+		if !strings.HasPrefix(item.Label, "av") {
+			t.Errorf("completion %s does not match the prefix av", item.Label)
+		}
+		if item.Label == "avg" {
+			avg = &list.Items[i]
+		}
+	}
+	if avg == nil || avg.Detail != "avg(series) number" {
+		t.Fatalf("expected completion of avg, got %+v", list.Items)
+	}
+
+	var h hover
+	next(&h)
+	if !strings.Contains(h.Contents.Value, "avg(series) number") || !strings.Contains(h.Contents.Value, "Average (arithmetic mean).") {
+		t.Errorf("expected the signature and description of avg, got %q", h.Contents.Value)
+	}
+
+	var loc Location
+	next(&loc)
+	if loc.URI != uri || loc.Range.Start.Line != 0 || loc.Range.End.Line != 3 {
+		t.Errorf("expected the definition of template t, got %+v", loc)
+	}
+}
@@ -0,0 +1,259 @@
+package lsp
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// offsetOf returns the byte offset in text of the position p, whose
+// character is counted in UTF-16 code units.
+func offsetOf(text string, p Position) int {
+	off := 0
+	for line := 0; line < p.Line; line++ {
+		i := strings.IndexByte(text[off:], '\n')
+		if i < 0 {
+			return len(text)
+		}
+		off += i + 1
+	}
+	for units := 0; off < len(text) && units < p.Character; {
+		r, w := utf8.DecodeRuneInString(text[off:])
+		if r == '\n' {
+			break
+		}
+		units += len(utf16.Encode([]rune{r}))
+		off += w
+	}
+	return off
+}
+
+// positionOf returns the position of the byte offset off in text.
+func positionOf(text string, off int) Position {
+	if off > len(text) {
+		off = len(text)
+	}
+	before := text[:off]
+	start := strings.LastIndexByte(before, '\n') + 1
+	return Position{
+		Line:      strings.Count(before, "\n"),
+		Character: len(utf16.Encode([]rune(before[start:]))),
+	}
+}
+
+// lineEnd returns the offset of the end of the line of the offset off.
+func lineEnd(text string, off int) int {
+	if i := strings.IndexByte(text[off:], '\n'); i >= 0 {
+		return off + i
+	}
+	return len(text)
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '.' || c == '-' || c == '$' ||
+		'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// wordAt returns the offsets of the start and end of the section name or
+// identifier around the offset off.
+func wordAt(text string, off int) (start, end int) {
+	start, end = off, off
+	for start > 0 && isWordByte(text[start-1]) {
+		start--
+	}
+	for end < len(text) && isWordByte(text[end]) {
+		end++
+	}
+	return start, end
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// identStart returns the offset of the start of the function name or
+// variable that ends at the end of e.
+func identStart(e string) int {
+	i := len(e)
+	for i > 0 && isIdentByte(e[i-1]) {
+		i--
+	}
+	return i
+}
+
+// sectionTypes are the types of the sections of a rule file.
+var sectionTypes = []string{"alert", "template", "notification", "lookup", "macro", "func", "report", "problem"}
+
+var sectionRE = regexp.MustCompile(`(?m)^\s*(alert|template|notification|lookup|macro|func|report|problem)\s+([\w\-\.\$]+)\s*\{`)
+
+// A sectionName is the name of a section found in the text of a rule file.
+type sectionName struct {
+	Type, Name string
+	// Start and End are the offsets of the name.
+	Start, End int
+}
+
+// sectionNames finds the sections of text like the rule editor does, so it
+// works for text that does not parse.
+func sectionNames(text string) []sectionName {
+	var names []sectionName
+	for _, m := range sectionRE.FindAllStringSubmatchIndex(text, -1) {
+		names = append(names, sectionName{
+			Type:  text[m[2]:m[3]],
+			Name:  text[m[4]:m[5]],
+			Start: m[4],
+			End:   m[5],
+		})
+	}
+	return names
+}
+
+type contextKind int
+
+const (
+	ctxNone     contextKind = iota
+	ctxKeyword              // type of a section
+	ctxSection              // name of a section of type Section
+	ctxFunc                 // expression function
+	ctxMetric               // metric of an OpenTSDB query
+	ctxTagKey               // tag key of Metric in an OpenTSDB query
+	ctxTagValue             // tag value of TagKey and Metric in an OpenTSDB query
+)
+
+// A completionContext is what is being typed at an offset of a rule file.
+type completionContext struct {
+	Kind    contextKind
+	Prefix  string // the text typed so far
+	Section string
+	Metric  string
+	TagKey  string
+}
+
+// queryFuncs are the functions whose first argument is an OpenTSDB query.
+var queryFuncs = map[string]bool{
+	"q":         true,
+	"band":      true,
+	"over":      true,
+	"shiftBand": true,
+	"change":    true,
+	"count":     true,
+	"window":    true,
+}
+
+// contextAt returns the completion context at the offset off of text. Only
+// the current line is looked at, since values rarely span lines.
+func contextAt(text string, off int) completionContext {
+	line := text[strings.LastIndexByte(text[:off], '\n')+1 : off]
+	eq := strings.IndexByte(line, '=')
+	if eq < 0 {
+		// Section contents are indented, section types are not.
+		if strings.TrimLeft(line, " \t") == line && !strings.ContainsAny(line, " \t{}") {
+			return completionContext{Kind: ctxKeyword, Prefix: line}
+		}
+		return completionContext{}
+	}
+	key := strings.TrimSpace(line[:eq])
+	value := strings.TrimLeft(line[eq+1:], " \t")
+	switch {
+	case key == "macro", key == "template":
+		return completionContext{Kind: ctxSection, Section: key, Prefix: value}
+	case key == "notification", key == "next", strings.HasSuffix(key, "Notification"):
+		prefix := strings.TrimLeft(value[strings.LastIndexByte(value, ',')+1:], " \t")
+		return completionContext{Kind: ctxSection, Section: "notification", Prefix: prefix}
+	case key == "crit", key == "warn", key == "depends", key == "expr", strings.HasPrefix(key, "$"):
+		return exprContext(value)
+	}
+	return completionContext{}
+}
+
+type call struct {
+	name string
+	arg  int
+}
+
+// exprContext returns the completion context at the end of the expression
+// prefix e.
+func exprContext(e string) completionContext {
+	var calls []call
+	inString := false
+	strStart := 0
+	for i := 0; i < len(e); i++ {
+		c := e[i]
+		if inString {
+			switch c {
+			case '\\':
+				i++
+			case '"':
+				inString = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			inString = true
+			strStart = i + 1
+		case '(':
+			calls = append(calls, call{name: e[identStart(e[:i]):i]})
+		case ',':
+			if len(calls) > 0 {
+				calls[len(calls)-1].arg++
+			}
+		case ')':
+			if len(calls) > 0 {
+				calls = calls[:len(calls)-1]
+			}
+		}
+	}
+	if !inString {
+		start := identStart(e)
+		if start > 0 && e[start-1] == '$' {
+			return completionContext{}
+		}
+		return completionContext{Kind: ctxFunc, Prefix: e[start:]}
+	}
+	if len(calls) == 0 {
+		return completionContext{}
+	}
+	s := e[strStart:]
+	switch c := calls[len(calls)-1]; {
+	case c.name == "lookup" && c.arg == 0, c.name == "lookupSeries" && c.arg == 1:
+		return completionContext{Kind: ctxSection, Section: "lookup", Prefix: s}
+	case c.name == "alert" && c.arg == 0:
+		return completionContext{Kind: ctxSection, Section: "alert", Prefix: s}
+	case queryFuncs[c.name] && c.arg == 0:
+		return queryContext(s)
+	}
+	return completionContext{}
+}
+
+// queryContext returns the completion context at the end of the OpenTSDB
+// query prefix q, like sum:rate:os.cpu{host=ny-*.
+func queryContext(q string) completionContext {
+	brace := strings.IndexByte(q, '{')
+	if brace < 0 {
+		colon := strings.LastIndexByte(q, ':')
+		if colon < 0 {
+			return completionContext{}
+		}
+		return completionContext{Kind: ctxMetric, Prefix: q[colon+1:]}
+	}
+	if strings.Contains(q[brace:], "}") {
+		return completionContext{}
+	}
+	metric := q[:brace]
+	metric = metric[strings.LastIndexByte(metric, ':')+1:]
+	tag := q[brace+1:]
+	tag = tag[strings.LastIndexByte(tag, ',')+1:]
+	eq := strings.IndexByte(tag, '=')
+	if eq < 0 {
+		return completionContext{Kind: ctxTagKey, Metric: metric, Prefix: tag}
+	}
+	value := tag[eq+1:]
+	return completionContext{
+		Kind:   ctxTagValue,
+		Metric: metric,
+		TagKey: tag[:eq],
+		Prefix: value[strings.LastIndexByte(value, '|')+1:],
+	}
+}
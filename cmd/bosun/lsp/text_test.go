@@ -0,0 +1,75 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestContextAt(t *testing.T) {
+	tests := []struct {
+		line string
+		ctx  completionContext
+	}{
+		{"al", completionContext{Kind: ctxKeyword, Prefix: "al"}},
+		{"\tfoo", completionContext{}},
+		{"\ttemplate = t", completionContext{Kind: ctxSection, Section: "template", Prefix: "t"}},
+		{"\tmacro = ", completionContext{Kind: ctxSection, Section: "macro"}},
+		{"\tcritNotification = a, b", completionContext{Kind: ctxSection, Section: "notification", Prefix: "b"}},
+		{"\twarn = av", completionContext{Kind: ctxFunc, Prefix: "av"}},
+		{"\twarn = $va", completionContext{}},
+		{"\texpr = $x * dou", completionContext{Kind: ctxFunc, Prefix: "dou"}},
+		{`	crit = q("sum:os.cpu{host=a}", "5m", "") > 1 && ab`, completionContext{Kind: ctxFunc, Prefix: "ab"}},
+		{`	crit = avg(q("sum:rate:os.c`, completionContext{Kind: ctxMetric, Prefix: "os.c"}},
+		{`	crit = avg(q("sum:rate:os.cpu{host=a,en`, completionContext{Kind: ctxTagKey, Metric: "os.cpu", Prefix: "en"}},
+		{`	$q = avg(q("sum:os.cpu{host=ny-a|ny-`, completionContext{Kind: ctxTagValue, Metric: "os.cpu", TagKey: "host", Prefix: "ny-"}},
+		{`	crit = avg(q("sum:os.cpu", "5m", "`, completionContext{}},
+		{`	crit = lookupSeries($x, "lo`, completionContext{Kind: ctxSection, Section: "lookup", Prefix: "lo"}},
+		{`	depends = alert("os.hi`, completionContext{Kind: ctxSection, Section: "alert", Prefix: "os.hi"}},
+	}
+	for _, test := range tests {
+		text := "alert a {\n" + test.line
+		if ctx := contextAt(text, len(text)); ctx != test.ctx {
+			t.Errorf("%q: expected %+v, got %+v", test.line, test.ctx, ctx)
+		}
+	}
+}
+
+func TestPosition(t *testing.T) {
+	text := "a\nxé𝄞b\n"
+	off := len("a\nxé𝄞")
+	p := positionOf(text, off)
+	// 𝄞 is two UTF-16 code units.
+	if p != (Position{Line: 1, Character: 4}) {
+		t.Fatalf("bad position: %+v", p)
+	}
+	if o := offsetOf(text, p); o != off {
+		t.Errorf("expected offset %d, got %d", off, o)
+	}
+	if o := offsetOf(text, Position{Line: 0, Character: 10}); o != 1 {
+		t.Errorf("expected offset at end of line, got %d", o)
+	}
+}
+
+func TestMessages(t *testing.T) {
+	var buf bytes.Buffer
+	id := json.RawMessage(`1`)
+	if err := writeMessage(&buf, &message{ID: &id, Method: "initialize", Params: json.RawMessage(`{}`)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeMessage(&buf, &message{Method: "exit"}); err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(&buf)
+	m, err := readMessage(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Method != "initialize" || m.ID == nil || string(*m.ID) != "1" || m.JSONRPC != "2.0" {
+		t.Errorf("bad message: %+v", m)
+	}
+	if m, err = readMessage(r); err != nil || m.Method != "exit" || m.ID != nil {
+		t.Errorf("bad message: %+v, %v", m, err)
+	}
+}
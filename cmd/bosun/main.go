@@ -24,8 +24,10 @@ import (
 	"bosun.org/cmd/bosun/conf/rule"
 	"bosun.org/cmd/bosun/database"
 	"bosun.org/cmd/bosun/expr"
+	"bosun.org/cmd/bosun/lsp"
 	"bosun.org/cmd/bosun/ping"
 	"bosun.org/cmd/bosun/sched"
+	"bosun.org/cmd/bosun/search"
 	"bosun.org/cmd/bosun/web"
 	"bosun.org/collect"
 	"bosun.org/graphite"
@@ -98,6 +100,11 @@ var (
 	flagDev      = flag.Bool("dev", false, "enable dev mode: use local resources; no syslog")
 	flagSkipLast = flag.Bool("skiplast", false, "skip loading last datapoints from and to redis: useful for speeding up bosun startup time during development")
 	flagVersion  = flag.Bool("version", false, "Prints the version and exits")
+	flagLogLevel = flag.String("loglevel", "info", "minimum level of logged messages: debug, info, warning or error")
+	flagLogJSON  = flag.Bool("logjson", false, "log JSON objects, one per line, to stderr instead of text")
+
+	flagMigrateAnnotations = flag.Bool("migrateannotations", false, "copy all annotations from the AnnotateConf Elastic hosts to the DBConf database and exit")
+	flagMigrateSince       = flag.String("migratesince", backend.CopySince.Format("2006-01-02"), "with -migrateannotations, copy the annotations ending since this date, to resume a migration that failed")
 
 	mains []func() // Used to hook up syslog on *nix systems
 )
@@ -111,6 +118,17 @@ func main() {
 	for _, m := range mains {
 		m()
 	}
+	if *flagLogJSON {
+		slog.SetJSON(os.Stderr)
+	}
+	logLevel, err := slog.ParseLevel(*flagLogLevel)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	slog.SetLevel(logLevel)
+	if flag.Arg(0) == "lsp" {
+		runLSP()
+	}
 	systemConf, err := conf.LoadSystemConfigFile(*flagConf)
 	if err != nil {
 		slog.Fatalf("couldn't read system configuration: %v", err)
@@ -155,25 +173,21 @@ func main() {
 	if err != nil {
 		slog.Fatal(err)
 	}
+	if *flagMigrateAnnotations {
+		if err := migrateAnnotations(sysProvider, da); err != nil {
+			slog.Fatal(err)
+		}
+		os.Exit(0)
+	}
 	if sysProvider.GetMaxRenderedTemplateAge() != 0 {
 		go da.State().CleanupOldRenderedTemplates(time.Hour * 24 * time.Duration(sysProvider.GetMaxRenderedTemplateAge()))
 	}
 	var annotateBackend backend.Backend
 	if sysProvider.AnnotateEnabled() {
-		index := sysProvider.GetAnnotateIndex()
-		if index == "" {
-			index = "annotate"
-		}
-		config := sysProvider.GetAnnotateElasticHosts()
-		switch config.Version {
-		case expr.ESV2:
-			annotateBackend = backend.NewElastic2([]string(config.Hosts), config.SimpleClient, index, config.ClientOptionFuncs.([]elastic2.ClientOptionFunc))
-		case expr.ESV5:
-			annotateBackend = backend.NewElastic5([]string(config.Hosts), config.SimpleClient, index, config.ClientOptionFuncs.([]elastic5.ClientOptionFunc))
-		case expr.ESV6:
-			annotateBackend = backend.NewElastic6([]string(config.Hosts), config.SimpleClient, index, config.ClientOptionFuncs.([]elastic6.ClientOptionFunc))
-		case expr.ESV7:
-			annotateBackend = backend.NewElastic7([]string(config.Hosts), config.SimpleClient, index, config.ClientOptionFuncs.([]elastic7.ClientOptionFunc))
+		if sysProvider.GetAnnotateBackend() == conf.AnnotateBackendDB {
+			annotateBackend = backend.NewRedis(da, "")
+		} else {
+			annotateBackend = newAnnotateElastic(sysProvider)
 		}
 		go func() {
 			for {
@@ -321,6 +335,97 @@ func quit() {
 	os.Exit(0)
 }
 
+// newAnnotateElastic returns an annotate backend for the AnnotateConf Elastic hosts.
+func newAnnotateElastic(sysProvider conf.SystemConfProvider) backend.Backend {
+	index := sysProvider.GetAnnotateIndex()
+	if index == "" {
+		index = "annotate"
+	}
+	config := sysProvider.GetAnnotateElasticHosts()
+	switch config.Version {
+	case expr.ESV2:
+		return backend.NewElastic2([]string(config.Hosts), config.SimpleClient, index, config.ClientOptionFuncs.([]elastic2.ClientOptionFunc))
+	case expr.ESV5:
+		return backend.NewElastic5([]string(config.Hosts), config.SimpleClient, index, config.ClientOptionFuncs.([]elastic5.ClientOptionFunc))
+	case expr.ESV6:
+		return backend.NewElastic6([]string(config.Hosts), config.SimpleClient, index, config.ClientOptionFuncs.([]elastic6.ClientOptionFunc))
+	case expr.ESV7:
+		return backend.NewElastic7([]string(config.Hosts), config.SimpleClient, index, config.ClientOptionFuncs.([]elastic7.ClientOptionFunc))
+	}
+	return nil
+}
+
+// migrateAnnotations copies the annotations in the AnnotateConf Elastic index
+// to the database.
+func migrateAnnotations(sysProvider conf.SystemConfProvider, da database.DataAccess) error {
+	if sysProvider.GetAnnotateBackend() != conf.AnnotateBackendDB {
+		return fmt.Errorf("migrating annotations requires AnnotateConf.Backend = %q", conf.AnnotateBackendDB)
+	}
+	since, err := time.Parse("2006-01-02", *flagMigrateSince)
+	if err != nil {
+		return fmt.Errorf("bad -migratesince: %v", err)
+	}
+	src := newAnnotateElastic(sysProvider)
+	if src == nil {
+		return fmt.Errorf("migrating annotations requires AnnotateConf.Hosts")
+	}
+	dst := backend.NewRedis(da, "")
+	for _, b := range []backend.Backend{src, dst} {
+		if err := b.InitBackend(); err != nil {
+			return err
+		}
+	}
+	n, err := backend.Copy(dst, src, since, func(day time.Time, n int) {
+		slog.Infof("copied %d annotations to the database up to %s", n, day.Format("2006-01-02"))
+	})
+	if err != nil {
+		return fmt.Errorf("copied %d annotations: %v", n, err)
+	}
+	slog.Infof("copied %d annotations to the database", n)
+	return nil
+}
+
+// runLSP serves the language server protocol on stdin and stdout, so rule
+// files can be edited in other editors, and exits. The backends and rule
+// variables of the system config are used if it can be read, else all
+// backends are enabled. Metrics and tags are only completed with Redis, since
+// the Ledis database is owned by the running bosun.
+func runLSP() {
+	backends := conf.EnabledBackends{
+		OpenTSDB:     true,
+		Graphite:     true,
+		Influx:       true,
+		Elastic:      true,
+		Annotate:     true,
+		AzureMonitor: true,
+		CloudWatch:   true,
+		Prom:         true,
+	}
+	var vars map[string]string
+	var s *search.Search
+	systemConf, err := conf.LoadSystemConfigFile(*flagConf)
+	if err != nil {
+		slog.Warningf("couldn't read system configuration, enabling all backends: %v", err)
+	} else {
+		backends = systemConf.EnabledBackends()
+		vars = systemConf.GetRuleVars()
+		if len(systemConf.GetRedisHost()) != 0 {
+			da := database.NewDataAccess(
+				systemConf.GetRedisHost(),
+				systemConf.IsRedisClientSetName(),
+				systemConf.GetRedisMasterName(),
+				systemConf.GetRedisDb(),
+				systemConf.GetRedisPassword(),
+			)
+			s = search.NewSearch(da, true)
+		}
+	}
+	if err := lsp.NewServer(backends, vars, s).Serve(os.Stdin, os.Stdout); err != nil {
+		slog.Fatal(err)
+	}
+	os.Exit(0)
+}
+
 func initDataAccess(systemConf conf.SystemConfProvider) (database.DataAccess, error) {
 	var da database.DataAccess
 	if len(systemConf.GetRedisHost()) != 0 {
@@ -6,6 +6,7 @@ import (
 
 	"bosun.org/cmd/bosun/cache"
 	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/expr"
 	"bosun.org/slog"
 )
 
@@ -15,8 +16,13 @@ func (s *Schedule) Run() error {
 		return fmt.Errorf("sched: nil configuration")
 	}
 	s.nc = make(chan interface{}, 1)
+	s.initCluster()
 	go s.dispatchNotifications()
+	go s.runReports()
+	go s.deleteRemovedAlertRuns()
 	type alertCh struct {
+		name   string
+		alert  *conf.Alert
 		ch     chan<- *checkContext
 		modulo int
 		shift  int // used to distribute alert runs
@@ -36,10 +42,10 @@ func (s *Schedule) Run() error {
 		go s.runAlert(a, ch)
 
 		if s.SystemConf.GetAlertCheckDistribution() == "simple" { // only apply shifts if the respective option is set
-			chs = append(chs, alertCh{ch: ch, modulo: re, shift: circular_shifts[re]})
+			chs = append(chs, alertCh{name: a.Name, alert: a, ch: ch, modulo: re, shift: circular_shifts[re]})
 		} else {
 			// there are no shifts if option is off
-			chs = append(chs, alertCh{ch: ch, modulo: re, shift: 0})
+			chs = append(chs, alertCh{name: a.Name, alert: a, ch: ch, modulo: re, shift: 0})
 		}
 
 		// the shifts for a given period range 0..(period - 1)
@@ -53,12 +59,23 @@ func (s *Schedule) Run() error {
 			return nil
 		default:
 		}
-		ctx := &checkContext{utcNow(), cache.New("alerts", 0)}
+		ctx := &checkContext{runTime: utcNow(), checkCache: cache.New("alerts", 0)}
 		s.LastCheck = utcNow()
+		var due []alertCh
+		var alerts []*conf.Alert
 		for _, a := range chs {
 			if (i+a.shift)%a.modulo != 0 {
 				continue
 			}
+			// With sharding, another worker checks alerts it is assigned.
+			if !s.ownsAlert(a.name) {
+				continue
+			}
+			due = append(due, a)
+			alerts = append(alerts, a.alert)
+		}
+		ctx.tsdbPlan = s.planTSDB(alerts, ctx.runTime)
+		for _, a := range due {
 			// Put on channel. If that fails, the alert is backed up pretty bad.
 			// Because channel is buffered size 1, it will continue as soon as it finishes.
 			// Master scheduler will never block here.
@@ -72,6 +89,7 @@ func (s *Schedule) Run() error {
 		s.Lock("CollectStates")
 		s.CollectStates()
 		s.Unlock()
+		s.expirePendingAlerts(utcNow())
 	}
 }
 
@@ -93,7 +111,15 @@ func (s *Schedule) runAlert(a *conf.Alert, ch <-chan *checkContext) {
 }
 
 func (s *Schedule) checkAlert(a *conf.Alert, ctx *checkContext) {
+	checkStart := utcNow()
 	rh := s.NewRunHistory(ctx.runTime, ctx.checkCache)
+	rh.Cost = &expr.Cost{}
+	rh.TSDBPlan = ctx.tsdbPlan
+	defer func() {
+		d := time.Since(checkStart)
+		s.recordCheck(d)
+		s.recordCost(a.Name, d, rh.Cost)
+	}()
 	// s.CheckAlert will return early if the schedule has been closed
 	cancelled := s.CheckAlert(nil, rh, a)
 	if cancelled {
@@ -103,4 +129,46 @@ func (s *Schedule) checkAlert(a *conf.Alert, ctx *checkContext) {
 	start := utcNow()
 	s.RunHistory(rh)
 	slog.Infof("runHistory on %s took %v\n", a.Name, time.Since(start))
+	if run := rh.Runs[a.Name]; run != nil {
+		if err := s.DataAccess.Runs().AddAlertRun(a.Name, run, s.SystemConf.GetMaxAlertRuns()); err != nil {
+			slog.Errorf("Error saving run of alert %s: %v", a.Name, err)
+		}
+	}
+}
+
+// deleteRemovedAlertRuns deletes the runs of the alerts that are not in the
+// rule configuration.
+func (s *Schedule) deleteRemovedAlertRuns() {
+	names, err := s.DataAccess.Runs().GetAlertsWithRuns()
+	if err != nil {
+		slog.Errorf("Error getting alerts with runs: %v", err)
+		return
+	}
+	alerts := s.RuleConf.GetAlerts()
+	for _, name := range names {
+		if _, ok := alerts[name]; ok {
+			continue
+		}
+		if err := s.DataAccess.Runs().DeleteAlertRuns(name); err != nil {
+			slog.Errorf("Error deleting runs of removed alert %s: %v", name, err)
+		}
+	}
+}
+
+// planTSDB returns the plan coalescing the OpenTSDB queries of alerts checked
+// at now, or nil if queries are not coalesced.
+func (s *Schedule) planTSDB(alerts []*conf.Alert, now time.Time) *expr.TSDBPlan {
+	tsdb := s.SystemConf.GetTSDBContext()
+	if tsdb == nil || !s.SystemConf.GetTSDBCoalesceQueries() {
+		return nil
+	}
+	p := expr.NewTSDBPlan(now, tsdb.Version())
+	for _, a := range alerts {
+		for _, e := range []*expr.Expr{a.Depends, a.Warn, a.Crit} {
+			if e != nil {
+				p.Add(e)
+			}
+		}
+	}
+	return p
 }
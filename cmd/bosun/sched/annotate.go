@@ -0,0 +1,216 @@
+package sched
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"bosun.org/annotate"
+	"bosun.org/models"
+	"bosun.org/slog"
+	"github.com/twinj/uuid"
+)
+
+// Automatic annotations have Source annotateSource and one of these
+// categories. Messages start with the alert key or alert they are about, so
+// they can be filtered with message:alert*.
+const (
+	annotateSource          = "bosun"
+	annotateCategoryInc     = "incident"
+	annotateCategoryAction  = "action"
+	annotateCategoryConfig  = "config"
+	annotateCategorySilence = "silence"
+)
+
+// autoAnnotate reports if s should create annotations for incidents,
+// actions, config saves and silences.
+func (s *Schedule) autoAnnotate() bool {
+	return s.annotate != nil && s.SystemConf.AutoAnnotateEnabled()
+}
+
+// annotationWrites runs the writes of each annotation in order, without
+// blocking the callers. Writes of different annotations run concurrently.
+type annotationWrites struct {
+	sync.Mutex
+	// pending are the writes waiting for the running write of an
+	// annotation id. An id is present while its writes are running.
+	pending map[string][]func()
+	wg      sync.WaitGroup
+}
+
+// write runs f after the previous writes of the annotation id.
+func (w *annotationWrites) write(id string, f func()) {
+	w.wg.Add(1)
+	w.Lock()
+	defer w.Unlock()
+	if w.pending == nil {
+		w.pending = make(map[string][]func())
+	}
+	q, running := w.pending[id]
+	w.pending[id] = append(q, f)
+	if running {
+		return
+	}
+	go func() {
+		for {
+			w.Lock()
+			q := w.pending[id]
+			if len(q) == 0 {
+				delete(w.pending, id)
+				w.Unlock()
+				return
+			}
+			f := q[0]
+			w.pending[id] = q[1:]
+			w.Unlock()
+			f()
+			w.wg.Done()
+		}
+	}()
+}
+
+// wait waits for the pending writes.
+func (w *annotationWrites) wait() {
+	w.wg.Wait()
+}
+
+// insertAnnotation inserts a without blocking the caller.
+func (s *Schedule) insertAnnotation(a annotate.Annotation) {
+	s.annotationWrites.write(a.Id, func() {
+		if err := s.annotate.InsertAnnotation(&a); err != nil {
+			slog.With("annotation", a.Id, "category", a.Category).Errorf("annotate: %v", err)
+		}
+	})
+}
+
+// annotateIncident inserts or updates the annotation spanning st. Its id is
+// derived from the incident id so closing the incident updates its end.
+func (s *Schedule) annotateIncident(st *models.IncidentState) {
+	if !s.autoAnnotate() || st.Id == 0 {
+		return
+	}
+	end := utcNow()
+	if st.End != nil {
+		end = *st.End
+	}
+	status := "opened"
+	if !st.Open {
+		status = "closed"
+	}
+	a := annotate.NewAnnotation(fmt.Sprintf("bosun-incident-%d", st.Id), st.Start, end, "", "", annotateSource,
+		st.AlertKey.Group()["host"], annotateCategoryInc, s.incidentLink(st.Id),
+		fmt.Sprintf("%s: incident #%d %s (%s): %s", st.AlertKey, st.Id, status, st.WorstStatus, st.Subject))
+	s.insertAnnotation(a)
+}
+
+// annotateAction annotates acknowledging and closing st.
+func (s *Schedule) annotateAction(st *models.IncidentState, action models.Action) {
+	switch action.Type {
+	case models.ActionAcknowledge, models.ActionClose, models.ActionForceClose, models.ActionDelayedClose:
+	default:
+		return
+	}
+	if !s.autoAnnotate() {
+		return
+	}
+	msg := fmt.Sprintf("%s: %s incident #%d", st.AlertKey, strings.ToLower(action.Type.String()), st.Id)
+	if action.Message != "" {
+		msg += ": " + action.Message
+	}
+	a := annotate.NewAnnotation(uuid.NewV4().String(), action.Time, action.Time, action.User, "", annotateSource,
+		st.AlertKey.Group()["host"], annotateCategoryAction, s.incidentLink(st.Id), msg)
+	s.insertAnnotation(a)
+}
+
+// AnnotateConfigSave annotates a rule configuration save by user. diff is the
+// contextual diff of the change, which is summarized as the number of lines
+// added and removed.
+func (s *Schedule) AnnotateConfigSave(user, message, diff string) {
+	if !s.autoAnnotate() {
+		return
+	}
+	added, removed := diffStat(diff)
+	msg := fmt.Sprintf("rule config saved (+%d -%d lines)", added, removed)
+	if message != "" {
+		msg += ": " + message
+	}
+	now := utcNow()
+	a := annotate.NewAnnotation(uuid.NewV4().String(), now, now, user, "", annotateSource,
+		"", annotateCategoryConfig, s.SystemConf.MakeLink("/config", nil), msg)
+	s.insertAnnotation(a)
+}
+
+// diffStat returns the number of lines added and removed by a unified diff.
+func diffStat(diff string) (added, removed int) {
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		case strings.HasPrefix(line, "+"):
+			added++
+		case strings.HasPrefix(line, "-"):
+			removed++
+		}
+	}
+	return
+}
+
+// annotateSilence annotates the span of si.
+func (s *Schedule) annotateSilence(si *models.Silence) {
+	if !s.autoAnnotate() {
+		return
+	}
+	var host string
+	if h := si.Tags["host"]; !strings.ContainsAny(h, "*|") {
+		host = h
+	}
+	target := si.Alert
+	if len(si.Tags) > 0 {
+		target += si.Tags.String()
+	}
+	msg := fmt.Sprintf("%s: silenced", target)
+	if si.Message != "" {
+		msg += ": " + si.Message
+	}
+	a := annotate.NewAnnotation(silenceAnnotationID(si.ID()), si.Start, si.End, si.User, "", annotateSource,
+		host, annotateCategorySilence, s.SystemConf.MakeLink("/silence", nil), msg)
+	s.insertAnnotation(a)
+}
+
+// endSilenceAnnotation ends the annotation of the silence id now, if it
+// would end later.
+func (s *Schedule) endSilenceAnnotation(id string) {
+	if !s.autoAnnotate() {
+		return
+	}
+	s.annotationWrites.write(silenceAnnotationID(id), func() {
+		a, found, err := s.annotate.GetAnnotation(silenceAnnotationID(id))
+		if err != nil || !found {
+			if err != nil {
+				slog.With("silence", id).Errorf("annotate: %v", err)
+			}
+			return
+		}
+		now := utcNow()
+		if !a.EndDate.After(now) {
+			return
+		}
+		a.EndDate.Time = now
+		if a.StartDate.After(now) {
+			a.StartDate.Time = now
+		}
+		if err := s.annotate.InsertAnnotation(a); err != nil {
+			slog.With("silence", id).Errorf("annotate: %v", err)
+		}
+	})
+}
+
+func silenceAnnotationID(id string) string {
+	return "bosun-silence-" + id
+}
+
+func (s *Schedule) incidentLink(id int64) string {
+	return s.SystemConf.MakeLink("/incident", &url.Values{
+		"id": []string{fmt.Sprint(id)},
+	})
+}
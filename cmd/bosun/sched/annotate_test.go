@@ -0,0 +1,209 @@
+package sched
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"bosun.org/annotate"
+	"bosun.org/annotate/backend"
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/host"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"bosun.org/util"
+)
+
+func TestDiffStat(t *testing.T) {
+	diff := `--- a/bosun.conf
++++ b/bosun.conf
+@@ -1,4 +1,5 @@
+ alert cpu {
+-	crit = avg(q("avg:os.cpu", "5m", "")) > 90
++	crit = avg(q("avg:os.cpu", "5m", "")) > 95
++	warn = avg(q("avg:os.cpu", "5m", "")) > 80
+ }
+`
+	added, removed := diffStat(diff)
+	if added != 2 || removed != 1 {
+		t.Errorf("expected +2 -1, got +%d -%d", added, removed)
+	}
+	if added, removed := diffStat(""); added != 0 || removed != 0 {
+		t.Errorf("expected no changes, got +%d -%d", added, removed)
+	}
+}
+
+// testAnnotations is an annotate backend keeping annotations in memory.
+type testAnnotations struct {
+	sync.Mutex
+	annotations map[string]annotate.Annotation
+	// delay, if set, delays inserting the nth annotation.
+	delay   func(n int) time.Duration
+	inserts int
+}
+
+func newTestAnnotations() *testAnnotations {
+	return &testAnnotations{annotations: make(map[string]annotate.Annotation)}
+}
+
+func (b *testAnnotations) InsertAnnotation(a *annotate.Annotation) error {
+	b.Lock()
+	n := b.inserts
+	b.inserts++
+	b.Unlock()
+	if b.delay != nil {
+		time.Sleep(b.delay(n))
+	}
+	b.Lock()
+	b.annotations[a.Id] = *a
+	b.Unlock()
+	return nil
+}
+
+func (b *testAnnotations) GetAnnotation(id string) (*annotate.Annotation, bool, error) {
+	b.Lock()
+	defer b.Unlock()
+	a, ok := b.annotations[id]
+	return &a, ok, nil
+}
+
+func (b *testAnnotations) GetAnnotations(start, end *time.Time, filters ...backend.FieldFilter) (annotate.Annotations, error) {
+	return nil, nil
+}
+
+func (b *testAnnotations) DeleteAnnotation(id string) error {
+	return nil
+}
+
+func (b *testAnnotations) GetFieldValues(field string) ([]string, error) {
+	return nil, nil
+}
+
+func (b *testAnnotations) InitBackend() error {
+	return nil
+}
+
+// byCategory returns the annotations of category.
+func (b *testAnnotations) byCategory(category string) []annotate.Annotation {
+	b.Lock()
+	defer b.Unlock()
+	var as []annotate.Annotation
+	for _, a := range b.annotations {
+		if a.Category == category {
+			as = append(as, a)
+		}
+	}
+	return as
+}
+
+func initAnnotateSched(t *testing.T, b backend.Backend) *Schedule {
+	hm, err := host.NewManager(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	util.SetHostManager(hm)
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			warn = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc := &conf.SystemConf{AnnotateConf: conf.AnnotateConf{Hosts: []string{"elastic"}}}
+	s := new(Schedule)
+	if err := s.Init("test_annotate", sc, c, db, b, false, false); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestAnnotateIncident(t *testing.T) {
+	defer setup()()
+	b := newTestAnnotations()
+	s := initAnnotateSched(t, b)
+	ak := models.NewAlertKey("a", opentsdb.TagSet{"host": "x"})
+	s.RunHistory(&RunHistory{
+		Start:  utcNow(),
+		Events: map[models.AlertKey]*models.Event{ak: {Status: models.StWarning}},
+	})
+	s.annotationWrites.wait()
+	incident, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := fmt.Sprintf("bosun-incident-%d", incident.Id)
+	as := b.byCategory(annotateCategoryInc)
+	if len(as) != 1 || as[0].Id != id || as[0].Host != "x" || !strings.Contains(as[0].Message, "opened") {
+		t.Fatalf("expected an opened incident annotation %s, got %+v", id, as)
+	}
+
+	for _, action := range []models.ActionType{models.ActionAcknowledge, models.ActionForceClose} {
+		if err := s.ActionByAlertKey("user", "done", action, nil, ak); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.annotationWrites.wait()
+	as = b.byCategory(annotateCategoryInc)
+	if len(as) != 1 || as[0].Id != id || !strings.Contains(as[0].Message, "closed") {
+		t.Errorf("expected the incident annotation %s to be closed, got %+v", id, as)
+	}
+	actions := b.byCategory(annotateCategoryAction)
+	if len(actions) != 2 {
+		t.Fatalf("expected 2 action annotations, got %+v", actions)
+	}
+	for _, a := range actions {
+		if a.CreationUser != "user" || !strings.HasSuffix(a.Message, fmt.Sprintf("incident #%d: done", incident.Id)) {
+			t.Errorf("unexpected action annotation %+v", a)
+		}
+	}
+}
+
+func TestAnnotateSilence(t *testing.T) {
+	defer setup()()
+	b := newTestAnnotations()
+	s := initAnnotateSched(t, b)
+	now := utcNow()
+	if _, err := s.AddSilence(now.Add(-time.Minute), now.Add(time.Hour), "a", "host=x", false, true, "", "user", "maintenance"); err != nil {
+		t.Fatal(err)
+	}
+	s.annotationWrites.wait()
+	as := b.byCategory(annotateCategorySilence)
+	if len(as) != 1 || as[0].Host != "x" || as[0].Message != "a{host=x}: silenced: maintenance" {
+		t.Fatalf("expected a silence annotation, got %+v", as)
+	}
+	if !as[0].EndDate.After(utcNow()) {
+		t.Fatalf("expected the silence annotation to end in the future, got %v", as[0].EndDate)
+	}
+	if err := s.ClearSilence(strings.TrimPrefix(as[0].Id, "bosun-silence-")); err != nil {
+		t.Fatal(err)
+	}
+	s.annotationWrites.wait()
+	as = b.byCategory(annotateCategorySilence)
+	if len(as) != 1 || as[0].EndDate.After(utcNow()) {
+		t.Errorf("expected the silence annotation to end when cleared, got %+v", as)
+	}
+}
+
+func TestAnnotationWritesOrder(t *testing.T) {
+	b := newTestAnnotations()
+	// The first insert is slower than the next, which must still be
+	// applied after it.
+	b.delay = func(n int) time.Duration {
+		if n == 0 {
+			return 50 * time.Millisecond
+		}
+		return 0
+	}
+	s := &Schedule{annotate: b}
+	for _, msg := range []string{"opened", "closed"} {
+		s.insertAnnotation(annotate.Annotation{AnnotationFields: annotate.AnnotationFields{Id: "bosun-incident-1", Message: msg}})
+	}
+	s.annotationWrites.wait()
+	if a := b.annotations["bosun-incident-1"]; a.Message != "closed" {
+		t.Errorf("expected the last write to be applied last, got %q", a.Message)
+	}
+}
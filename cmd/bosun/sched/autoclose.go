@@ -0,0 +1,48 @@
+package sched
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+)
+
+// autoClosePolicy returns the auto close policy that closes the open and
+// normal incident st as of t, or nil if no policy applies. The policy of the
+// alert is used if it has one, otherwise any policy of the notifications
+// the incident was sent to.
+func (s *Schedule) autoClosePolicy(a *conf.Alert, st *models.IncidentState, t time.Time) *conf.AutoClose {
+	normalFor := t.Sub(st.Last().Time)
+	if a.AutoClose != nil {
+		if a.AutoClose.Matches(st, normalFor) {
+			return a.AutoClose
+		}
+		return nil
+	}
+	names := append([]string(nil), st.Notifications...)
+	sort.Strings(names)
+	for _, name := range names {
+		n := s.RuleConf.GetNotification(name)
+		if n != nil && n.AutoClose != nil && n.AutoClose.Matches(st, normalFor) {
+			return n.AutoClose
+		}
+	}
+	return nil
+}
+
+// autoClose closes the incident of ak on behalf of the policy p and sends
+// the close action notifications.
+func (s *Schedule) autoClose(ak models.AlertKey, p *conf.AutoClose) {
+	log := akLog(ak)
+	message := fmt.Sprintf("Auto close because the incident was %v.", p)
+	log.Infof("auto close because the incident was %v", p)
+	if err := s.ActionByAlertKey("bosun", message, models.ActionClose, nil, ak); err != nil {
+		log.Errorln(err)
+		return
+	}
+	if err := s.ActionNotify(models.ActionClose, "bosun", message, []models.AlertKey{ak}); err != nil {
+		log.Errorln(err)
+	}
+}
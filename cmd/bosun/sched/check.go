@@ -52,6 +52,13 @@ type RunHistory struct {
 	Start    time.Time
 	Backends *expr.Backends
 	Events   map[models.AlertKey]*models.Event
+	// Runs are the results of the alert checks by alert name.
+	Runs map[string]*models.AlertRun
+	// Cost, if not nil, records the cost of the alert checks.
+	Cost *expr.Cost
+	// TSDBPlan, if not nil, coalesces the OpenTSDB queries of the alert
+	// checks with those of the other alerts of the check cycle.
+	TSDBPlan *expr.TSDBPlan
 	schedule *Schedule
 }
 
@@ -68,6 +75,7 @@ func (s *Schedule) NewRunHistory(start time.Time, cache *cache.Cache) *RunHistor
 		Cache:    cache,
 		Start:    start,
 		Events:   make(map[models.AlertKey]*models.Event),
+		Runs:     make(map[string]*models.AlertRun),
 		schedule: s,
 		Backends: &expr.Backends{
 			TSDBContext:       s.SystemConf.GetTSDBContext(),
@@ -90,7 +98,7 @@ func (s *Schedule) RunHistory(r *RunHistory) {
 		shouldNotify, err := s.runHistory(r, ak, event, silenced)
 		checkNotify = checkNotify || shouldNotify
 		if err != nil {
-			slog.Errorf("Error in runHistory for %s. %s.", ak, err)
+			akLog(ak).Errorf("Error in runHistory. %s.", err)
 		}
 	}
 	if checkNotify && s.nc != nil {
@@ -117,6 +125,14 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 
 	si := silenced(ak)
 
+	// auto close once the incident is saved, so the close is not overwritten.
+	var autoClose *conf.AutoClose
+	defer func() {
+		if autoClose != nil && err == nil {
+			s.autoClose(ak, autoClose)
+		}
+	}()
+
 	// get existing open incident if exists
 	var incident *models.IncidentState
 	rt := &models.RenderedTemplates{}
@@ -143,6 +159,9 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		if err != nil {
 			return
 		}
+		if recovering(a, incident, event) {
+			return
+		}
 		for i, action := range incident.Actions {
 			if action.Type == models.ActionDelayedClose && !(action.Fullfilled || action.Cancelled) {
 				if event.Status > incident.WorstStatus {
@@ -164,7 +183,7 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 				}
 				if r.Start.Before(*action.Deadline) {
 					if event.Status == models.StNormal {
-						slog.Infof("closing alert %v on delayed close because the alert has returned to normal before deadline", incident.AlertKey)
+						incidentLog(incident).Info("closing alert on delayed close because the alert has returned to normal before deadline")
 						if event.Status != incident.CurrentStatus {
 							incident.Events = append(incident.Events, *event)
 						}
@@ -187,7 +206,7 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 					}
 				} else {
 					// We are after Deadline
-					slog.Infof("force closing alert %v on delayed close because the alert is after the deadline", incident.AlertKey)
+					incidentLog(incident).Info("force closing alert on delayed close because the alert is after the deadline")
 					incident.Actions[i].Fullfilled = true
 					err = s.ActionByAlertKey("bosun", fmt.Sprintf("forceclose on behalf of delayed close by %v", action.User), models.ActionForceClose, nil, ak)
 					if err != nil {
@@ -202,6 +221,15 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 			}
 		}
 	}
+	// Wait for the pending duration before opening an incident
+	var pending *models.PendingAlert
+	if incident == nil && a.PendingDuration > 0 && !event.Unevaluated {
+		var wait bool
+		if pending, wait, err = s.updatePending(a, ak, event); err != nil || wait {
+			return
+		}
+	}
+
 	// If nothing is out of the ordinary we are done
 	if event.Status <= models.StNormal && incident == nil {
 		return
@@ -217,8 +245,15 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 
 	shouldNotify := false
 	newIncident := false
+	// started is when the incident started by the time of the checks,
+	// which is not the wall clock time of its start.
+	started := event.Time
 	if incident == nil {
 		incident = NewIncident(ak)
+		if pending != nil {
+			incident.Start = pending.Since
+			started = pending.Since
+		}
 		newIncident = true
 		shouldNotify = true
 	}
@@ -275,6 +310,9 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 					return
 				}
 			}
+			if err = s.correlate(incident, started); err != nil {
+				return
+			}
 		}
 	}
 
@@ -285,6 +323,9 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		if a.Log {
 			incident.Open = false
 		}
+		if newIncident {
+			s.annotateIncident(incident)
+		}
 	}
 
 	// On state increase, clear old notifications and notify current.
@@ -311,15 +352,22 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 			incident.Open = false
 			//auto forget
 			if si != nil && si.Forget {
-				slog.Infof("Auto forget enabled for %s", ak)
+				log := incidentLog(incident)
+				log.Info("Auto forget enabled")
 				err := s.ActionByAlertKey("bosun", "Auto forget was enabled", models.ActionForget, nil, ak)
 				if err != nil {
-					slog.Errorln(err)
+					log.Errorln(err)
 				}
 			}
 			return
 		}
 		incident.NeedAck = true
+		if replaced, err := s.notifyProblem(incident, rt, event.Status); err != nil {
+			incidentLog(incident).Errorf("notifying problem: %v", err)
+		} else if replaced {
+			checkNotify = true
+			return
+		}
 		switch event.Status {
 		case models.StCritical, models.StUnknown:
 			notify(a.CritNotification)
@@ -341,12 +389,15 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	// finally close an open alert with silence once it goes back to normal.
 	if si := silenced(ak); si != nil && event.Status == models.StNormal {
 		go func(ak models.AlertKey) {
-			slog.Infof("auto close %s because was silenced", ak)
+			log := akLog(ak)
+			log.Info("auto close because was silenced")
 			err := s.ActionByAlertKey("bosun", "Auto close because was silenced.", models.ActionClose, nil, ak)
 			if err != nil {
-				slog.Errorln(err)
+				log.Errorln(err)
 			}
 		}(ak)
+	} else if event.Status == models.StNormal && incident.Open {
+		autoClose = s.autoClosePolicy(a, incident, r.Start)
 	}
 	s.Unlock()
 	return checkNotify, nil
@@ -366,7 +417,7 @@ func (s *Schedule) executeTemplates(st *models.IncidentState, event *models.Even
 	rt, errs := s.ExecuteAll(r, a, st, true)
 	if len(errs) > 0 {
 		for _, err := range errs {
-			slog.Errorf("rendering templates for %s: %s", a.Name, err)
+			incidentLog(st).Errorf("rendering templates: %s", err)
 		}
 		subject, body, err := s.ExecuteBadTemplate(errs, r, a, st)
 		if err != nil {
@@ -518,7 +569,7 @@ func (s *Schedule) CollectStates() {
 func (s *Schedule) GetUnknownAndUnevaluatedAlertKeys(alert string) (unknown, uneval []models.AlertKey) {
 	unknown, uneval, err := s.DataAccess.State().GetUnknownAndUnevalAlertKeys(alert)
 	if err != nil {
-		slog.Errorf("Error getting unknown/unevaluated alert keys: %s", err)
+		slog.With("alert", alert).Errorf("Error getting unknown/unevaluated alert keys: %s", err)
 		return nil, nil
 	}
 	return unknown, uneval
@@ -546,7 +597,7 @@ func (s *Schedule) findUnknownAlerts(now time.Time, alert string) []models.Alert
 	maxTouched := now.UTC().Unix() - int64(t.Seconds())
 	untouched, err := s.DataAccess.State().GetUntouchedSince(alert, maxTouched)
 	if err != nil {
-		slog.Errorf("Error finding unknown alerts for alert %s: %s.", alert, err)
+		slog.With("alert", alert).Errorf("Error finding unknown alerts: %s.", err)
 		return keys
 	}
 	for _, ak := range untouched {
@@ -559,7 +610,8 @@ func (s *Schedule) findUnknownAlerts(now time.Time, alert string) []models.Alert
 }
 
 func (s *Schedule) CheckAlert(T miniprofiler.Timer, r *RunHistory, a *conf.Alert) (cancelled bool) {
-	slog.Infof("check alert %v start with now set to %v", a.Name, r.Start.Format("2006-01-02 15:04:05.999999999"))
+	log := slog.With("alert", a.Name)
+	log.Infof("check alert start with now set to %v", r.Start.Format("2006-01-02 15:04:05.999999999"))
 	start := utcNow()
 	for _, ak := range s.findUnknownAlerts(r.Start, a.Name) {
 		r.Events[ak] = &models.Event{Status: models.StUnknown}
@@ -599,15 +651,45 @@ func (s *Schedule) CheckAlert(T miniprofiler.Timer, r *RunHistory, a *conf.Alert
 		return true
 	}
 	unevalCount, unknownCount := markDependenciesUnevaluated(r.Events, deps, a.Name)
+	run := &models.AlertRun{
+		Time:     r.Start,
+		Duration: time.Since(start).Seconds(),
+	}
 	if err != nil {
-		slog.Errorf("Error checking alert %s: %s", a.Name, err.Error())
+		log.Errorf("Error checking alert: %s", err.Error())
 		removeUnknownEvents(r.Events, a.Name)
 		s.markAlertError(a.Name, err)
+		run.Error = err.Error()
 	} else {
 		s.markAlertSuccessful(a.Name)
 	}
+	for ak, ev := range r.Events {
+		if ak.Name() != a.Name {
+			continue
+		}
+		switch ev.Status {
+		case models.StNormal:
+			run.Normal++
+		case models.StWarning:
+			run.Warning++
+		case models.StCritical:
+			run.Critical++
+		case models.StUnknown:
+			run.Unknown++
+		}
+		if ev.Unevaluated {
+			run.Unevaluated++
+		}
+	}
+	r.Runs[a.Name] = run
 	collect.Put("check.duration", opentsdb.TagSet{"name": a.Name}, time.Since(start).Seconds())
-	slog.Infof("check alert %v done (%s): %v crits, %v warns, %v unevaluated, %v unknown", a.Name, time.Since(start), len(crits), len(warns), unevalCount, unknownCount)
+	log.With(
+		"duration", time.Since(start).Seconds(),
+		"crits", len(crits),
+		"warns", len(warns),
+		"unevaluated", unevalCount,
+		"unknown", unknownCount,
+	).Info("check alert done")
 	return false
 }
 
@@ -669,6 +751,8 @@ func (s *Schedule) executeExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Ale
 		Squelched: s.RuleConf.AlertSquelched(a),
 		History:   s,
 		Annotate:  s.annotate,
+		Cost:      rh.Cost,
+		TSDBPlan:  rh.TSDBPlan,
 	}
 	origin := fmt.Sprintf("Schedule: Alert Name: %s", a.Name)
 	results, _, err := e.Execute(rh.Backends, providers, T, rh.Start, 0, a.UnjoinedOK, origin)
@@ -684,7 +768,7 @@ func (s *Schedule) CheckExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Alert
 			return
 		}
 		collect.Add("check.errs", opentsdb.TagSet{"metric": a.Name}, 1)
-		slog.Errorln(err)
+		slog.With("alert", a.Name).Errorln(err)
 	}()
 	type res struct {
 		results *expr.Results
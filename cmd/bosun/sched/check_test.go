@@ -349,6 +349,226 @@ func TestIncidentIds(t *testing.T) {
 	expect(2)
 }
 
+func TestCheckPending(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			warn = 1
+			for = 5m
+			recoverAfter = 10m
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{CheckFrequency: conf.Duration{Duration: time.Minute}, DefaultRunEvery: 1}, c)
+	ak := models.NewAlertKey("a", nil)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	run := func(m int, status models.Status) {
+		r := &RunHistory{
+			Start: start.Add(time.Duration(m) * time.Minute),
+			Events: map[models.AlertKey]*models.Event{
+				ak: {Status: status},
+			},
+		}
+		s.RunHistory(r)
+	}
+	expect := func(m int, pending bool, status models.Status) {
+		data := s.DataAccess.State()
+		p, err := data.GetPendingAlert(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (p != nil) != pending {
+			t.Fatalf("minute %d: expected pending %v. Got %v.", m, pending, p)
+		}
+		incident, err := data.GetOpenIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status == models.StNone {
+			if incident != nil {
+				t.Fatalf("minute %d: unexpected incident %v.", m, incident)
+			}
+			return
+		}
+		if incident == nil || incident.CurrentStatus != status {
+			t.Fatalf("minute %d: expected %v incident. Got %v.", m, status, incident)
+		}
+	}
+
+	run(0, models.StWarning)
+	expect(0, true, models.StNone)
+	run(1, models.StNormal)
+	expect(1, false, models.StNone)
+	for m := 2; m < 7; m++ {
+		run(m, models.StWarning)
+		expect(m, true, models.StNone)
+	}
+	run(7, models.StWarning)
+	expect(7, false, models.StWarning)
+	incident, err := s.DataAccess.State().GetOpenIncident(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if since := start.Add(2 * time.Minute); !incident.Start.Equal(since) {
+		t.Fatalf("Expected incident to start at %v. Got %v.", since, incident.Start)
+	}
+	run(8, models.StNormal)
+	expect(8, false, models.StWarning)
+	run(17, models.StNormal)
+	expect(17, false, models.StNormal)
+
+	// A key that was not checked for more than two intervals, because it
+	// stopped reporting or bosun was down, starts its pending time over.
+	ak = models.NewAlertKey("a", opentsdb.TagSet{"host": "b"})
+	run(20, models.StWarning)
+	expect(20, true, models.StNone)
+	run(23, models.StWarning)
+	expect(23, true, models.StNone)
+	p, err := s.DataAccess.State().GetPendingAlert(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if since := start.Add(23 * time.Minute); !p.Since.Equal(since) {
+		t.Fatalf("Expected pending since %v. Got %v.", since, p.Since)
+	}
+	// Pending alerts that are no longer checked expire.
+	s.expirePendingAlerts(start.Add(24 * time.Minute))
+	expect(24, true, models.StNone)
+	s.expirePendingAlerts(start.Add(26 * time.Minute))
+	expect(26, false, models.StNone)
+}
+
+func TestCheckAutoClose(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			warn = 1
+			autoClose = 30m
+			autoCloseIf = unacknowledged
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	run := func(ak models.AlertKey, m int, status models.Status) {
+		r := &RunHistory{
+			Start: start.Add(time.Duration(m) * time.Minute),
+			Events: map[models.AlertKey]*models.Event{
+				ak: {Status: status},
+			},
+		}
+		s.RunHistory(r)
+	}
+	expectOpen := func(ak models.AlertKey, m int, open bool) {
+		incident, err := s.DataAccess.State().GetLatestIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if incident.Open != open {
+			t.Fatalf("minute %d: expected %s open %v. Got %v.", m, ak, open, incident.Open)
+		}
+	}
+
+	ak := models.NewAlertKey("a", opentsdb.TagSet{"host": "a"})
+	run(ak, 0, models.StWarning)
+	run(ak, 1, models.StNormal)
+	expectOpen(ak, 1, true)
+	run(ak, 20, models.StNormal)
+	expectOpen(ak, 20, true)
+	run(ak, 31, models.StNormal)
+	expectOpen(ak, 31, false)
+	incident, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := incident.Actions[len(incident.Actions)-1]; last.Type != models.ActionClose || last.User != "bosun" {
+		t.Fatalf("Expected close by bosun. Got %v.", last)
+	}
+
+	// Acknowledged incidents are not closed.
+	acked := models.NewAlertKey("a", opentsdb.TagSet{"host": "b"})
+	run(acked, 0, models.StWarning)
+	if err := s.ActionByAlertKey("user", "", models.ActionAcknowledge, nil, acked); err != nil {
+		t.Fatal(err)
+	}
+	run(acked, 1, models.StNormal)
+	run(acked, 40, models.StNormal)
+	expectOpen(acked, 40, true)
+}
+
+func TestCheckProblem(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			warn = 1
+		}
+		alert b {
+			warn = 1
+		}
+		problem p {
+			tags = host
+			window = 5m
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	run := func(m int, status models.Status, aks ...models.AlertKey) {
+		r := &RunHistory{
+			Start:  start.Add(time.Duration(m) * time.Minute),
+			Events: map[models.AlertKey]*models.Event{},
+		}
+		for _, ak := range aks {
+			r.Events[ak] = &models.Event{Status: status}
+		}
+		s.RunHistory(r)
+	}
+	problemOf := func(ak models.AlertKey) int64 {
+		incident, err := s.DataAccess.State().GetLatestIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return incident.ProblemId
+	}
+
+	ax := models.NewAlertKey("a", opentsdb.TagSet{"host": "x"})
+	bx := models.NewAlertKey("b", opentsdb.TagSet{"host": "x"})
+	ay := models.NewAlertKey("a", opentsdb.TagSet{"host": "y"})
+	late := models.NewAlertKey("b", opentsdb.TagSet{"host": "x", "disk": "c"})
+	run(0, models.StWarning, ax)
+	run(1, models.StWarning, bx, ay)
+	id := problemOf(ax)
+	if id == 0 || problemOf(bx) != id {
+		t.Fatalf("Expected %s and %s in the same problem. Got %d and %d.", ax, bx, id, problemOf(bx))
+	}
+	// The window is measured from the last incident of the problem at
+	// minute 1.
+	run(10, models.StWarning, late)
+	if problemOf(ay) == id || problemOf(late) == id {
+		t.Fatalf("Expected %s and %s not in problem %d.", ay, late, id)
+	}
+
+	run(2, models.StNormal, ax, bx)
+	for _, ak := range []models.AlertKey{ax, bx} {
+		if err := s.ActionByAlertKey("user", "", models.ActionClose, nil, ak); err != nil {
+			t.Fatal(err)
+		}
+		p, err := s.DataAccess.Problems().GetProblem(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if open := ak != bx; p.Open != open {
+			t.Fatalf("After closing %s expected problem open %v. Got %v.", ak, open, p.Open)
+		}
+	}
+}
+
 func TestCheckNotify(t *testing.T) {
 	defer setup()()
 	nc := make(chan string)
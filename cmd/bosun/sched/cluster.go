@@ -0,0 +1,322 @@
+package sched
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/models"
+	"bosun.org/slog"
+	"bosun.org/util"
+)
+
+// cluster shards alert checks across the bosun processes (workers) sharing
+// the database. Every worker sends heartbeats to the database and assigns
+// alerts to the live workers by consistent hashing of the alert names, so
+// all workers agree on the assignment and only the alerts of a worker that
+// joins or leaves move. Workers see a change at their own heartbeat, so
+// while they disagree an alert is checked by the worker holding its lease
+// in the database (see renewAlertLeases). Incidents, notifications and silences
+// are already kept in the database, so any worker serves a consistent
+// dashboard.
+type cluster struct {
+	// Updated atomically, so first for 64-bit alignment.
+	checks    int64
+	checkTime int64 // nanoseconds
+	lastCheck int64 // unix nanoseconds
+
+	conf conf.ClusterConf
+	self models.Worker
+
+	sync.RWMutex
+	ring    *hashRing
+	workers []*models.Worker
+	leases  map[string]bool // alerts this worker holds the lease of
+}
+
+// hashRing assigns keys to nodes by consistent hashing.
+type hashRing struct {
+	hashes []uint32
+	nodes  map[uint32]string
+}
+
+// ringReplicas is the number of points of each node on the ring. More
+// points spread the keys more evenly.
+const ringReplicas = 128
+
+func newHashRing(nodes []string) *hashRing {
+	r := &hashRing{nodes: make(map[uint32]string)}
+	for _, n := range nodes {
+		for i := 0; i < ringReplicas; i++ {
+			h := ringHash(fmt.Sprintf("%s#%d", n, i))
+			r.hashes = append(r.hashes, h)
+			r.nodes[h] = n
+		}
+	}
+	sort.Slice(r.hashes, func(i, j int) bool { return r.hashes[i] < r.hashes[j] })
+	return r
+}
+
+// owner returns the node key is assigned to, or "" if the ring is nil or
+// empty.
+func (r *hashRing) owner(key string) string {
+	if r == nil || len(r.hashes) == 0 {
+		return ""
+	}
+	h := ringHash(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.nodes[r.hashes[i]]
+}
+
+// ringHash hashes s with SHA-1, as FNV spreads similar names like
+// alert.1 and alert.2 poorly.
+func ringHash(s string) uint32 {
+	h := sha1.Sum([]byte(s))
+	return binary.BigEndian.Uint32(h[:4])
+}
+
+// initCluster sets up sharding if it is enabled and joins the cluster, so
+// the alerts of this worker are known before the first check.
+func (s *Schedule) initCluster() {
+	c := s.SystemConf.GetClusterConf()
+	if !c.Enabled {
+		s.cluster = nil
+		return
+	}
+	name := c.Name
+	if name == "" {
+		_, port, _ := net.SplitHostPort(s.SystemConf.GetHTTPListen())
+		if port == "" {
+			_, port, _ = net.SplitHostPort(s.SystemConf.GetHTTPSListen())
+		}
+		name = util.GetHostManager().GetHostName() + ":" + port
+	}
+	s.cluster = &cluster{
+		conf: c,
+		self: models.Worker{Name: name, Started: utcNow()},
+	}
+	s.heartbeat()
+	s.renewAlertLeases()
+	go s.runHeartbeats()
+}
+
+func (s *Schedule) runHeartbeats() {
+	ticker := time.NewTicker(s.cluster.conf.HeartbeatInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.runnerContext.Done():
+			return
+		case <-ticker.C:
+			s.heartbeat()
+			s.renewAlertLeases()
+		}
+	}
+}
+
+// heartbeat announces this worker and its load, then rebuilds the ring from
+// the live workers. Workers that stopped sending heartbeats are removed.
+func (s *Schedule) heartbeat() {
+	c := s.cluster
+	now := utcNow()
+	self := c.self
+	self.LastSeen = now
+	self.Checks = atomic.LoadInt64(&c.checks)
+	self.CheckTime = time.Duration(atomic.LoadInt64(&c.checkTime)).Seconds()
+	if last := atomic.LoadInt64(&c.lastCheck); last != 0 {
+		self.LastCheck = time.Unix(0, last).UTC()
+	}
+	c.RLock()
+	if c.ring != nil {
+		for name := range s.RuleConf.GetAlerts() {
+			if c.ring.owner(name) == self.Name {
+				self.Alerts++
+			}
+		}
+	}
+	c.RUnlock()
+	log := slog.With("worker", self.Name)
+	wd := s.DataAccess.Workers()
+	if err := wd.Heartbeat(&self); err != nil {
+		log.Errorf("cluster heartbeat: %v", err)
+		return
+	}
+	all, err := wd.GetWorkers()
+	if err != nil {
+		log.Errorf("cluster heartbeat: %v", err)
+		return
+	}
+	var workers []*models.Worker
+	var names []string
+	for _, w := range all {
+		if !w.Alive(now, c.conf.Timeout.Duration) {
+			log.With("removed", w.Name, "last_seen", w.LastSeen).Info("cluster worker has not been seen, removing it")
+			if err := wd.RemoveWorker(w.Name); err != nil {
+				log.Errorf("cluster heartbeat: %v", err)
+			}
+			continue
+		}
+		workers = append(workers, w)
+		names = append(names, w.Name)
+	}
+	sort.Slice(workers, func(i, j int) bool { return workers[i].Name < workers[j].Name })
+	sort.Strings(names)
+	c.Lock()
+	changed := len(c.workers) != len(workers)
+	for i := 0; !changed && i < len(workers); i++ {
+		changed = c.workers[i].Name != workers[i].Name
+	}
+	c.workers = workers
+	if changed {
+		c.ring = newHashRing(names)
+	}
+	c.Unlock()
+	if changed {
+		log.With("workers", strings.Join(names, ",")).Info("cluster workers changed, rebalancing alerts")
+	}
+}
+
+// leaveCluster removes this worker and releases the leases of its alerts so
+// the others take over its alerts at their next heartbeat.
+func (s *Schedule) leaveCluster() {
+	c := s.cluster
+	if c == nil {
+		return
+	}
+	log := slog.With("worker", c.self.Name)
+	wd := s.DataAccess.Workers()
+	if err := wd.RemoveWorker(c.self.Name); err != nil {
+		log.Errorf("leaving cluster: %v", err)
+	}
+	c.Lock()
+	var held []string
+	for name := range c.leases {
+		held = append(held, name)
+	}
+	c.leases = nil
+	c.Unlock()
+	if err := wd.ReleaseAlertLeases(c.self.Name, held); err != nil {
+		log.Errorf("leaving cluster: %v", err)
+	}
+}
+
+// renewAlertLeases renews the leases of this worker on the alerts, at every
+// heartbeat and in a few round trips to the database, so checking the alerts
+// doesn't wait for it.
+//
+// The worker an alert is assigned to by the ring takes a lease on the alert,
+// which it renews at every heartbeat. If another worker holds the lease, the
+// owner requests it, and the holder keeps checking the alert until it sees
+// the request from the owner of its own ring and releases the lease. So
+// while the workers disagree about the ring, an alert is checked by exactly
+// one of them, and the alerts of a worker that stopped are taken over when
+// its leases expire.
+func (s *Schedule) renewAlertLeases() {
+	c := s.cluster
+	self := c.self.Name
+	// The lease must outlast the time to the next heartbeat, and a missed
+	// one.
+	ttl := c.conf.HeartbeatInterval.Duration + c.conf.Timeout.Duration
+	owners := make(map[string]string)
+	var leases []*database.AlertLease
+	c.RLock()
+	for name := range s.RuleConf.GetAlerts() {
+		owners[name] = c.ring.owner(name)
+		leases = append(leases, &database.AlertLease{Alert: name, Take: owners[name] == self})
+	}
+	c.RUnlock()
+	log := slog.With("worker", self)
+	wd := s.DataAccess.Workers()
+	held := make(map[string]bool)
+	if err := wd.AcquireAlertLeases(self, ttl, leases); err != nil {
+		log.Errorf("alert leases: %v", err)
+		leases = nil
+	}
+	var release, request []string
+	for _, l := range leases {
+		owner := owners[l.Alert]
+		switch {
+		case l.Holder == self && owner != self && l.Requester != "" && l.Requester == owner:
+			release = append(release, l.Alert)
+		case l.Holder == self:
+			held[l.Alert] = true
+		case owner == self && l.Requester != self:
+			request = append(request, l.Alert)
+		}
+	}
+	c.Lock()
+	c.leases = held
+	c.Unlock()
+	if len(release) > 0 {
+		if err := wd.ReleaseAlertLeases(self, release); err != nil {
+			log.Errorf("alert leases: %v", err)
+		}
+	}
+	if len(request) > 0 {
+		if err := wd.RequestAlertLeases(self, ttl, request); err != nil {
+			log.Errorf("alert leases: %v", err)
+		}
+	}
+}
+
+// ownsAlert returns if this worker should check the alert name, which it
+// does if it held the lease of the alert at the last heartbeat. Without
+// sharding every alert is checked.
+func (s *Schedule) ownsAlert(name string) bool {
+	c := s.cluster
+	if c == nil {
+		return true
+	}
+	c.RLock()
+	defer c.RUnlock()
+	return c.leases[name]
+}
+
+// isLeader returns if this worker runs the tasks that only one worker of a
+// cluster should run, like sending notifications that were queued in the
+// database. The leader is the live worker with the lowest name.
+func (s *Schedule) isLeader() bool {
+	c := s.cluster
+	if c == nil {
+		return true
+	}
+	c.RLock()
+	defer c.RUnlock()
+	return len(c.workers) > 0 && c.workers[0].Name == c.self.Name
+}
+
+// recordCheck adds a check of duration d to the load of this worker.
+func (s *Schedule) recordCheck(d time.Duration) {
+	c := s.cluster
+	if c == nil {
+		return
+	}
+	atomic.AddInt64(&c.checks, 1)
+	atomic.AddInt64(&c.checkTime, int64(d))
+	atomic.StoreInt64(&c.lastCheck, utcNow().UnixNano())
+}
+
+// ClusterWorkers returns the name of this worker, if it is the leader, and
+// the live workers with their load as of the last heartbeat. The name is
+// empty if alert checks are not sharded.
+func (s *Schedule) ClusterWorkers() (name string, leader bool, workers []*models.Worker) {
+	c := s.cluster
+	if c == nil {
+		return "", false, nil
+	}
+	leader = s.isLeader()
+	c.RLock()
+	defer c.RUnlock()
+	return c.self.Name, leader, c.workers
+}
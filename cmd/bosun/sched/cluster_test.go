@@ -0,0 +1,161 @@
+package sched
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+)
+
+func TestHashRing(t *testing.T) {
+	alerts := make([]string, 1000)
+	for i := range alerts {
+		alerts[i] = fmt.Sprintf("alert.%d", i)
+	}
+	three := newHashRing([]string{"bosun01:8070", "bosun02:8070", "bosun03:8070"})
+	counts := make(map[string]int)
+	for _, a := range alerts {
+		counts[three.owner(a)]++
+	}
+	if len(counts) != 3 {
+		t.Fatalf("expected alerts on 3 workers, got %v", counts)
+	}
+	for w, n := range counts {
+		if n < 200 || n > 470 {
+			t.Errorf("unbalanced assignment, %s has %d of %d alerts: %v", w, n, len(alerts), counts)
+		}
+	}
+
+	// When a worker leaves only its alerts move.
+	two := newHashRing([]string{"bosun01:8070", "bosun03:8070"})
+	for _, a := range alerts {
+		before, after := three.owner(a), two.owner(a)
+		if before != "bosun02:8070" && before != after {
+			t.Errorf("%s moved from %s to %s", a, before, after)
+		}
+	}
+
+	var empty *hashRing
+	if o := empty.owner("alert.1"); o != "" {
+		t.Errorf("expected no owner on an empty ring, got %s", o)
+	}
+}
+
+func TestOwnsAlertRebalance(t *testing.T) {
+	defer setup()()
+	// An alert that moves to b when b joins a.
+	var name string
+	for i := 0; name == ""; i++ {
+		if n := fmt.Sprintf("alert%d", i); newHashRing([]string{"a", "b"}).owner(n) == "b" {
+			name = n
+		}
+	}
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, fmt.Sprintf("alert %s {\n\twarn = 1\n}", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	worker := func(self string, nodes ...string) *Schedule {
+		s, err := initSched(&conf.SystemConf{}, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.cluster = &cluster{
+			conf: conf.ClusterConf{Enabled: true, Timeout: conf.Duration{Duration: time.Minute}},
+			self: models.Worker{Name: self},
+			ring: newHashRing(nodes),
+		}
+		return s
+	}
+	a, b := worker("a", "a"), worker("b", "a", "b")
+	// check renews the leases of both workers, first on first, then runs a
+	// check cycle and returns the worker that checked the alert.
+	check := func(first, second *Schedule) string {
+		first.renewAlertLeases()
+		second.renewAlertLeases()
+		var checked []string
+		for _, s := range []*Schedule{first, second} {
+			if s.ownsAlert(name) {
+				checked = append(checked, s.cluster.self.Name)
+			}
+		}
+		if len(checked) != 1 {
+			t.Fatalf("expected one worker to check %s, got %v", name, checked)
+		}
+		return checked[0]
+	}
+	if w := check(a, b); w != "a" {
+		t.Fatalf("expected a to check %s before it sees b, got %s", name, w)
+	}
+	// b joined, but a has not seen it yet, so a keeps checking.
+	for i := 0; i < 2; i++ {
+		if w := check(b, a); w != "a" {
+			t.Fatalf("expected a to check %s until it sees b, got %s", name, w)
+		}
+	}
+	// a hands the alert over once it sees b. It releases the lease at its
+	// heartbeat, and b takes it at its next one.
+	a.cluster.ring = newHashRing([]string{"a", "b"})
+	a.renewAlertLeases()
+	if a.ownsAlert(name) {
+		t.Fatalf("expected a to hand %s over to b", name)
+	}
+	for i := 0; i < 2; i++ {
+		if w := check(b, a); w != "b" {
+			t.Fatalf("expected b to check %s after the hand over, got %s", name, w)
+		}
+	}
+	// b leaves. Its lease is released, so a takes the alert over.
+	b.leaveCluster()
+	a.cluster.ring = newHashRing([]string{"a"})
+	a.renewAlertLeases()
+	if !a.ownsAlert(name) {
+		t.Fatalf("expected a to check %s after b left", name)
+	}
+}
+
+func TestLeaderNotificationWait(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+notification a {
+	print = true
+}
+notification b {
+	print = true
+	next = a
+	timeout = 10m
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc := &conf.SystemConf{CheckFrequency: conf.Duration{Duration: time.Minute}}
+	workers := []*models.Worker{{Name: "a"}, {Name: "b"}}
+	worker := func(self string) *Schedule {
+		s, err := initSched(sc, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.cluster = &cluster{
+			conf:    conf.ClusterConf{Enabled: true, Timeout: conf.Duration{Duration: time.Minute}},
+			self:    models.Worker{Name: self},
+			ring:    newHashRing([]string{"a", "b"}),
+			workers: workers,
+		}
+		return s
+	}
+	a, b := worker("a"), worker("b")
+	if !a.isLeader() || b.isLeader() {
+		t.Fatal("expected a to lead the cluster")
+	}
+	// The leader computes its wake up before b queues the escalation of an
+	// alert b checks, and is not woken when b does.
+	next := a.CheckNotifications()
+	if err := b.QueueNotification("x{}", c.GetNotification("a"), utcNow().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if limit := utcNow().Add(sc.CheckFrequency.Duration); next.After(limit) {
+		t.Fatalf("expected the leader to look at the queue again within %v, waits until %v", sc.CheckFrequency.Duration, next)
+	}
+}
@@ -0,0 +1,80 @@
+package sched
+
+import (
+	"sync"
+	"time"
+
+	"bosun.org/cmd/bosun/expr"
+	"bosun.org/models"
+)
+
+// alertCosts accumulates the cost of checking each alert, so expensive
+// alerts can be found. It is kept across rule reloads but only in memory, so
+// it only covers the checks run by this process since it started.
+type alertCosts struct {
+	sync.Mutex
+	m     map[string]*models.AlertCost
+	since time.Time
+}
+
+func newAlertCosts() *alertCosts {
+	return &alertCosts{m: make(map[string]*models.AlertCost), since: utcNow()}
+}
+
+// recordCost adds a check of the alert name that took d and ran the queries
+// recorded in c.
+func (s *Schedule) recordCost(name string, d time.Duration, c *expr.Cost) {
+	ac := s.costs
+	ac.Lock()
+	defer ac.Unlock()
+	cost := ac.m[name]
+	if cost == nil {
+		cost = &models.AlertCost{Alert: name, Backends: make(map[string]*models.QueryCost)}
+		ac.m[name] = cost
+	}
+	t := d.Seconds()
+	cost.Checks++
+	cost.Time += t
+	cost.MeanTime = cost.Time / float64(cost.Checks)
+	if t > cost.MaxTime {
+		cost.MaxTime = t
+	}
+	cost.LastTime = t
+	cost.LastCheck = utcNow()
+	c.Lock()
+	defer c.Unlock()
+	cost.Results += int64(c.Results)
+	cost.LastResults = c.Results
+	for qType, q := range c.Queries {
+		b := cost.Backends[qType]
+		if b == nil {
+			b = &models.QueryCost{}
+			cost.Backends[qType] = b
+		}
+		b.Add(q)
+	}
+}
+
+// AlertCosts returns the cost of checking the alerts of the current rule
+// configuration that have been checked by this process, and the time since
+// which the costs were recorded.
+func (s *Schedule) AlertCosts() ([]*models.AlertCost, time.Time) {
+	alerts := s.RuleConf.GetAlerts()
+	ac := s.costs
+	ac.Lock()
+	defer ac.Unlock()
+	costs := make([]*models.AlertCost, 0, len(ac.m))
+	for name, cost := range ac.m {
+		if _, ok := alerts[name]; !ok {
+			continue
+		}
+		c := *cost
+		c.Backends = make(map[string]*models.QueryCost, len(cost.Backends))
+		for qType, q := range cost.Backends {
+			b := *q
+			c.Backends[qType] = &b
+		}
+		costs = append(costs, &c)
+	}
+	return costs, ac.since
+}
@@ -0,0 +1,39 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/expr"
+	"bosun.org/models"
+)
+
+func TestRecordCost(t *testing.T) {
+	s := &Schedule{costs: newAlertCosts()}
+	s.recordCost("a", 3*time.Second, &expr.Cost{
+		Queries: map[string]*models.QueryCost{
+			"opentsdb": {Queries: 2, CacheHits: 1, Time: 2},
+		},
+		Results: 10,
+	})
+	s.recordCost("a", time.Second, &expr.Cost{
+		Queries: map[string]*models.QueryCost{
+			"opentsdb": {Queries: 2, Time: 0.5},
+			"graphite": {Queries: 1, Time: 0.25},
+		},
+		Results: 4,
+	})
+	c := s.costs.m["a"]
+	if c.Checks != 2 || c.Time != 4 || c.MeanTime != 2 || c.MaxTime != 3 || c.LastTime != 1 {
+		t.Errorf("unexpected check times: %+v", c)
+	}
+	if c.Results != 14 || c.LastResults != 4 {
+		t.Errorf("expected 14 results, 4 last, got %d, %d", c.Results, c.LastResults)
+	}
+	if q := c.Backends["opentsdb"]; *q != (models.QueryCost{Queries: 4, CacheHits: 1, Time: 2.5}) {
+		t.Errorf("unexpected opentsdb cost: %+v", q)
+	}
+	if q := c.Backends["graphite"]; q == nil || q.Queries != 1 {
+		t.Errorf("unexpected graphite cost: %+v", q)
+	}
+}
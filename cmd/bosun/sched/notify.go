@@ -33,6 +33,7 @@ func (s *Schedule) dispatchNotifications() {
 			nextAt(s.CheckNotifications())
 		case <-ticker.C:
 			s.sendUnknownNotifications()
+			s.sendRateLimitedSummaries()
 		}
 	}
 
@@ -61,6 +62,13 @@ func (s *Schedule) CheckNotifications() time.Time {
 	silenced := s.Silenced()
 	s.Lock("CheckNotifications")
 	defer s.Unlock()
+	if !s.isLeader() {
+		// Notifications queued in the database are sent by the leader of
+		// the cluster, this worker only sends the ones of its own checks.
+		s.sendNotifications(silenced)
+		s.pendingNotifications = nil
+		return utcNow().Add(time.Minute)
+	}
 	latestTime := utcNow()
 	notifications, err := s.DataAccess.Notifications().GetDueNotifications()
 	if err != nil {
@@ -69,7 +77,7 @@ func (s *Schedule) CheckNotifications() time.Time {
 	}
 	for ak, ns := range notifications {
 		if si := silenced(ak); si != nil {
-			slog.Infoln("silencing", ak)
+			akLog(ak).Info("silencing")
 			continue
 		}
 		for name, t := range ns {
@@ -94,21 +102,22 @@ func (s *Schedule) CheckNotifications() time.Time {
 			}
 			st, err := s.DataAccess.State().GetLatestIncident(ak)
 			if err != nil {
-				slog.Error(err)
+				akLog(ak).With("notification", name).Error(err)
 				continue
 			}
 			if st == nil {
 				continue
 			}
+			log := incidentLog(st).With("notification", name)
 			rt, err := s.DataAccess.State().GetRenderedTemplates(st.Id)
 			if err != nil {
-				slog.Error(err)
+				log.Error(err)
 				continue
 			}
 			if s.Notify(st, rt, n) {
 				_, err = s.DataAccess.State().UpdateIncidentState(st)
 				if err != nil {
-					slog.Error(err)
+					log.Error(err)
 					continue
 				}
 			}
@@ -126,18 +135,30 @@ func (s *Schedule) CheckNotifications() time.Time {
 		slog.Error("Error getting next notification time", err)
 		return utcNow().Add(time.Minute)
 	}
+	if s.cluster != nil {
+		// The other workers queue notifications, like the escalations of
+		// the alerts they check, without waking the leader, so look at the
+		// queue again at the next check.
+		if next := utcNow().Add(s.SystemConf.GetCheckFrequency()); next.Before(timeout) {
+			timeout = next
+		}
+	}
 	return timeout
 }
 
 // sendNotifications processes the schedule's pendingNotifications queue. It silences notifications,
-// moves unknown notifications to the unknownNotifications queue so they can be grouped, calls the notification
-// Notify method to trigger notification actions, and queues notifications that are in the future because they
-// are part of a notification chain
+// moves unknown notifications to the unknownNotifications queue so they can be grouped, records notifications
+// over their rate limits to be summarized, calls the notification Notify method to trigger notification actions,
+// and queues notifications that are in the future because they are part of a notification chain
 func (s *Schedule) sendNotifications(silenced SilenceTester) {
 	if s.quiet {
 		slog.Infoln("quiet mode prevented", len(s.pendingNotifications), "notifications")
 		return
 	}
+	var limited map[string]bool
+	if len(s.pendingNotifications) > 0 {
+		limited = s.rateLimitedNotifications()
+	}
 	for n, states := range s.pendingNotifications {
 		for _, st := range states {
 			ak := st.AlertKey
@@ -146,22 +167,25 @@ func (s *Schedule) sendNotifications(silenced SilenceTester) {
 				continue
 			}
 			silenced := silenced(ak) != nil
+			log := incidentLog(st.IncidentState).With("notification", n.Name)
 			if st.CurrentStatus == models.StUnknown {
 				if silenced {
-					slog.Infoln("silencing unknown", ak)
+					log.Info("silencing unknown")
 					continue
 				}
 				gk := notificationGroupKey{notification: n, template: alert.Template}
 				s.pendingUnknowns[gk] = append(s.pendingUnknowns[gk], st.IncidentState)
 			} else if silenced {
-				slog.Infof("silencing %s", ak)
+				log.Info("silencing")
 				continue
 			} else if !alert.Log && (!st.Open || !st.NeedAck) {
-				slog.Errorf("Cannot notify acked or closed alert %s. Clearing.", ak)
+				log.Error("Cannot notify acked or closed alert. Clearing.")
 				if err := s.DataAccess.Notifications().ClearNotifications(ak); err != nil {
-					slog.Error(err)
+					log.Error(err)
 				}
 				continue
+			} else if s.rateLimit(st.IncidentState, n, limited) {
+				log.Info("rate limited")
 			} else {
 				s.notify(st.IncidentState, st.RenderedTemplates, n)
 			}
@@ -0,0 +1,127 @@
+package sched
+
+import (
+	"sort"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/slog"
+	"github.com/kylebrandt/boolq"
+)
+
+// updatePending tracks the alert keys of alerts with a pending duration
+// that are abnormal while no incident is open. It returns the pending alert
+// of ak, if it is abnormal, and if the incident must not be opened yet
+// because ak has not been abnormal for the pending duration. Pending alerts
+// are kept in the database so they survive restarts, but the pending
+// duration starts over if ak was not checked for a while, as it may have
+// been normal in between.
+func (s *Schedule) updatePending(a *conf.Alert, ak models.AlertKey, event *models.Event) (p *models.PendingAlert, wait bool, err error) {
+	data := s.DataAccess.State()
+	p, err = data.GetPendingAlert(ak)
+	if err != nil {
+		return nil, false, err
+	}
+	if event.Status <= models.StNormal {
+		if p != nil {
+			err = data.ClearPendingAlert(ak)
+		}
+		return nil, false, err
+	}
+	t := event.Time.UTC()
+	if p == nil || s.pendingExpired(a, p, t) {
+		p = &models.PendingAlert{AlertKey: ak, Alert: ak.Name(), Since: t}
+	}
+	p.Status = event.Status
+	if event.Status > p.WorstStatus {
+		p.WorstStatus = event.Status
+	}
+	p.LastCheck = t
+	if t.Sub(p.Since) < a.PendingDuration {
+		return p, true, data.SetPendingAlert(p)
+	}
+	return p, false, data.ClearPendingAlert(ak)
+}
+
+// pendingExpired returns if the pending alert p of the alert a was last
+// checked more than two check intervals of a before t, so a missed check is
+// tolerated.
+func (s *Schedule) pendingExpired(a *conf.Alert, p *models.PendingAlert, t time.Time) bool {
+	runEvery := a.RunEvery
+	if runEvery == 0 {
+		runEvery = s.SystemConf.GetDefaultRunEvery()
+	}
+	return t.Sub(p.LastCheck) > 2*time.Duration(runEvery)*s.SystemConf.GetCheckFrequency()
+}
+
+// expirePendingAlerts removes the pending alerts that expired at now, and
+// those of alerts that were removed or no longer have a pending duration.
+func (s *Schedule) expirePendingAlerts(now time.Time) {
+	data := s.DataAccess.State()
+	all, err := data.GetAllPendingAlerts()
+	if err != nil {
+		slog.Errorf("Error getting pending alerts: %v", err)
+		return
+	}
+	for _, p := range all {
+		if a := s.RuleConf.GetAlert(p.Alert); a != nil && a.PendingDuration > 0 && !s.pendingExpired(a, p, now) {
+			continue
+		}
+		if err := data.ClearPendingAlert(p.AlertKey); err != nil {
+			akLog(p.AlertKey).Errorf("Error clearing pending alert: %v", err)
+		}
+	}
+}
+
+// recovering returns if the incident should keep its abnormal status
+// although event is normal, because the incident has not been normal for
+// the recover after duration of its alert yet.
+func recovering(a *conf.Alert, incident *models.IncidentState, event *models.Event) bool {
+	if a.RecoverAfter == 0 || event.Status != models.StNormal || event.Unevaluated || incident.CurrentStatus <= models.StNormal {
+		return false
+	}
+	return event.Time.Sub(incident.LastAbnormalTime.Time) < a.RecoverAfter
+}
+
+// getPendingAlerts returns the pending alerts of the alerts of the current
+// rule configuration that match the dashboard filter, sorted by alert key.
+func (s *Schedule) getPendingAlerts(filter string, silenced SilenceTester) ([]*models.PendingAlert, error) {
+	all, err := s.DataAccess.State().GetAllPendingAlerts()
+	if err != nil {
+		return nil, err
+	}
+	parsedExpr, err := boolq.Parse(filter)
+	if err != nil {
+		return nil, err
+	}
+	now := utcNow()
+	var pending []*models.PendingAlert
+	for _, p := range all {
+		if a := s.RuleConf.GetAlert(p.Alert); a == nil || a.PendingDuration == 0 || s.pendingExpired(a, p, now) {
+			continue
+		}
+		// Filter like an incident that would be opened for the alert key.
+		is, err := MakeIncidentSummary(s.RuleConf, silenced, &models.IncidentState{
+			Start:         p.Since,
+			AlertKey:      p.AlertKey,
+			Alert:         p.Alert,
+			Tags:          p.AlertKey.Group().Tags(),
+			CurrentStatus: p.Status,
+			WorstStatus:   p.WorstStatus,
+			Events:        []models.Event{{Status: p.Status, Time: p.LastCheck}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		match, err := boolq.AskParsedExpr(parsedExpr, is)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			pending = append(pending, p)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].AlertKey < pending[j].AlertKey })
+	return pending, nil
+}
@@ -0,0 +1,257 @@
+package sched
+
+import (
+	"sort"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/database"
+	eparse "bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// dependsOn returns the names of the alerts the depends expression of a
+// uses with the alert function.
+func dependsOn(a *conf.Alert) []string {
+	if a == nil || a.Depends == nil {
+		return nil
+	}
+	var names []string
+	eparse.Walk(a.Depends.Root, func(n eparse.Node) {
+		f, ok := n.(*eparse.FuncNode)
+		if !ok || f.Name != "alert" || len(f.Args) == 0 {
+			return
+		}
+		if name, ok := f.Args[0].(*eparse.StringNode); ok {
+			names = append(names, name.Text)
+		}
+	})
+	return names
+}
+
+// dependent returns if the alert a depends on the alert b or b on a.
+func (s *Schedule) dependent(a, b string) bool {
+	for _, d := range dependsOn(s.RuleConf.GetAlert(a)) {
+		if d == b {
+			return true
+		}
+	}
+	for _, d := range dependsOn(s.RuleConf.GetAlert(b)) {
+		if d == a {
+			return true
+		}
+	}
+	return false
+}
+
+// correlates returns if the rule r adds the incident st, which started at t,
+// to the problem p.
+func (s *Schedule) correlates(r *conf.ProblemRule, p *models.Problem, st *models.IncidentState, t time.Time) bool {
+	if p.Rule != r.Name || t.Sub(p.Last) > r.Window {
+		return false
+	}
+	if len(r.Tags) == 0 && !r.Depends {
+		return true
+	}
+	group := st.AlertKey.Group()
+	for _, k := range r.Tags {
+		if v, ok := group[k]; ok && p.HasTagValue(k, v) {
+			return true
+		}
+	}
+	if r.Depends {
+		for _, a := range p.Alerts {
+			if s.dependent(st.Alert, a) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// correlate adds the new incident st, which started at t, to the open
+// problem it correlates with, or opens a problem for it. t is the time of the
+// check that started st, so windows are measured in check time. The problem
+// rules are tried by name, the first rule that matches the alert of the
+// incident is used.
+func (s *Schedule) correlate(st *models.IncidentState, t time.Time) error {
+	rules := s.RuleConf.GetProblemRules()
+	names := make([]string, 0, len(rules))
+	for name, r := range rules {
+		if r.MatchAlert(st.Alert) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	sort.Strings(names)
+	r := rules[names[0]]
+	var problem *models.Problem
+	err := s.DataAccess.Problems().ChangeOpenProblems(func(open []*models.Problem) ([]*models.Problem, error) {
+		sort.Slice(open, func(i, j int) bool { return open[i].Id < open[j].Id })
+		problem = nil
+		for _, p := range open {
+			if s.correlates(r, p, st, t) {
+				problem = p
+				break
+			}
+		}
+		if problem == nil {
+			problem = &models.Problem{
+				Rule:  r.Name,
+				Start: t,
+				Last:  t,
+				Open:  true,
+			}
+		}
+		problem.Add(st, t, r.Tags)
+		return []*models.Problem{problem}, nil
+	})
+	if err != nil {
+		return err
+	}
+	st.ProblemId = problem.Id
+	if len(problem.IncidentIds) > 1 {
+		incidentLog(st).With("problem", problem.Id, "incidents", len(problem.IncidentIds)).Info("correlated into problem")
+	}
+	return nil
+}
+
+// notifyProblem sends the notification of the problem rule of the incident
+// st, if it has one, instead of the notifications of the alert of st. It is
+// sent once per problem, and again when the problem reaches a worse status.
+// It returns if the notifications of st are replaced by the problem.
+func (s *Schedule) notifyProblem(st *models.IncidentState, rt *models.RenderedTemplates, status models.Status) (bool, error) {
+	if st.ProblemId == 0 {
+		return false, nil
+	}
+	var n *conf.Notification
+	notify := false
+	err := s.DataAccess.Problems().ChangeProblem(st.ProblemId, func(p *models.Problem) (bool, error) {
+		n, notify = nil, false
+		r := s.RuleConf.GetProblemRule(p.Rule)
+		if r == nil || r.Notification == nil {
+			return false, nil
+		}
+		n = r.Notification
+		if status > p.WorstStatus {
+			p.WorstStatus = status
+		}
+		if status > p.NotifiedStatus {
+			p.NotifiedStatus = status
+			notify = true
+		}
+		return true, nil
+	})
+	if err != nil || n == nil {
+		return false, err
+	}
+	// Notify only once the problem is saved, so workers that correlated
+	// incidents into the same problem at the same time do not both notify.
+	if notify {
+		s.Notify(st, rt, n)
+	}
+	return true, nil
+}
+
+// updateProblem closes the problem of st once all of its incidents are
+// closed. Incidents that no longer exist, because they were forgotten or
+// purged, count as closed.
+func (s *Schedule) updateProblem(st *models.IncidentState) {
+	if st.ProblemId == 0 {
+		return
+	}
+	log := incidentLog(st).With("problem", st.ProblemId)
+	closed := false
+	err := s.DataAccess.Problems().ChangeProblem(st.ProblemId, func(p *models.Problem) (bool, error) {
+		closed = false
+		if !p.Open {
+			return false, nil
+		}
+		for _, id := range p.IncidentIds {
+			if id == st.Id {
+				if st.Open {
+					return false, nil
+				}
+				continue
+			}
+			incident, err := s.DataAccess.State().GetIncidentState(id)
+			if err != nil && !database.IsRedisNil(err) {
+				return false, err
+			}
+			if incident != nil && incident.Open {
+				return false, nil
+			}
+		}
+		now := utcNow()
+		p.Open = false
+		p.End = &now
+		closed = true
+		return true, nil
+	})
+	if err != nil {
+		log.Errorf("updating problem: %v", err)
+		return
+	}
+	if closed {
+		log.Info("closed problem because all of its incidents are closed")
+	}
+}
+
+// ProblemView is a problem with its incidents.
+type ProblemView struct {
+	*models.Problem
+	Incidents []*IncidentSummaryView
+}
+
+// GetOpenProblems returns the open problems with their incidents, newest
+// first.
+func (s *Schedule) GetOpenProblems() ([]*ProblemView, error) {
+	open, err := s.DataAccess.Problems().GetOpenProblems()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(open, func(i, j int) bool { return open[i].Id > open[j].Id })
+	views := make([]*ProblemView, 0, len(open))
+	for _, p := range open {
+		v, err := s.problemView(p)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, v)
+	}
+	return views, nil
+}
+
+// GetProblem returns the problem id with its incidents, or nil if it does
+// not exist.
+func (s *Schedule) GetProblem(id int64) (*ProblemView, error) {
+	p, err := s.DataAccess.Problems().GetProblem(id)
+	if err != nil || p == nil {
+		return nil, err
+	}
+	return s.problemView(p)
+}
+
+func (s *Schedule) problemView(p *models.Problem) (*ProblemView, error) {
+	silenced := s.Silenced()
+	v := &ProblemView{Problem: p, Incidents: []*IncidentSummaryView{}}
+	for _, id := range p.IncidentIds {
+		st, err := s.DataAccess.State().GetIncidentState(id)
+		if err != nil {
+			if database.IsRedisNil(err) {
+				continue
+			}
+			return nil, err
+		}
+		is, err := MakeIncidentSummary(s.RuleConf, silenced, st)
+		if err != nil {
+			slog.Errorf("problem %d: %v", p.Id, err)
+			continue
+		}
+		v.Incidents = append(v.Incidents, is)
+	}
+	return v, nil
+}
@@ -0,0 +1,141 @@
+package sched
+
+import (
+	"sort"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// globalRateLimit is the name of the token bucket of the rate limit of all
+// alert notifications.
+const globalRateLimit = "global"
+
+func notificationRateLimit(n *conf.Notification) string {
+	return "notification:" + n.Name
+}
+
+// takeNotificationToken takes a token from the buckets of the global rate
+// limit and of the rate limit of n. It returns false, taking no token, if one
+// of the buckets is empty.
+func (s *Schedule) takeNotificationToken(n *conf.Notification) (bool, error) {
+	limits := make(map[string]*conf.RateLimit)
+	if l := s.SystemConf.GetNotificationRateLimit(); l != nil {
+		limits[globalRateLimit] = l
+	}
+	if n.RateLimit != nil {
+		limits[notificationRateLimit(n)] = n.RateLimit
+	}
+	if len(limits) == 0 {
+		return true, nil
+	}
+	var names []string
+	for name := range limits {
+		names = append(names, name)
+	}
+	now := utcNow()
+	return s.DataAccess.RateLimits().TakeToken(names, func(name string, b *models.TokenBucket) {
+		l := limits[name]
+		b.Refill(l.Count, l.Per, now)
+	})
+}
+
+// rateLimitedNotifications returns the notifications with incidents waiting
+// to be sent in a rate limit summary.
+func (s *Schedule) rateLimitedNotifications() map[string]bool {
+	limited := make(map[string]bool)
+	ids, err := s.DataAccess.RateLimits().GetRateLimited()
+	if err != nil {
+		slog.Errorf("getting rate limited notifications: %v", err)
+		return limited
+	}
+	for name := range ids {
+		limited[name] = true
+	}
+	return limited
+}
+
+// rateLimit returns whether the alert notification of st by n is over its
+// rate limits, in which case st is recorded to be sent in the next summary
+// of n. Once n is over its limits, its notifications wait for the summary,
+// which limited tracks. The notification is sent if the limits can not be
+// checked.
+func (s *Schedule) rateLimit(st *models.IncidentState, n *conf.Notification, limited map[string]bool) bool {
+	if !limited[n.Name] {
+		ok, err := s.takeNotificationToken(n)
+		if err != nil {
+			slog.Errorf("checking rate limit of notification %s: %v", n.Name, err)
+			return false
+		}
+		if ok {
+			return false
+		}
+	}
+	if err := s.DataAccess.RateLimits().AddRateLimited(n.Name, st.Id); err != nil {
+		slog.Errorf("recording rate limited notification %s: %v", n.Name, err)
+		return false
+	}
+	limited[n.Name] = true
+	return true
+}
+
+// sendRateLimitedSummaries sends the summaries of the incidents whose alert
+// notifications were over rate limits. The summaries of a notification take
+// a token from its buckets, and wait until one is available. The incidents
+// are summarized by the template of their alert, which renders the
+// notification's rateLimited template keys.
+func (s *Schedule) sendRateLimitedSummaries() {
+	s.Lock("RateLimitedSummaries")
+	defer s.Unlock()
+	if !s.isLeader() {
+		return
+	}
+	limited, err := s.DataAccess.RateLimits().GetRateLimited()
+	if err != nil {
+		slog.Errorf("getting rate limited notifications: %v", err)
+		return
+	}
+	for name, ids := range limited {
+		n := s.RuleConf.GetNotification(name)
+		if n != nil {
+			ok, err := s.takeNotificationToken(n)
+			if err != nil {
+				slog.Errorf("checking rate limit of notification %s: %v", name, err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			summaries := make(map[*conf.Template][]*models.IncidentState)
+			var templates []*conf.Template
+			for _, id := range ids {
+				st, err := s.DataAccess.State().GetIncidentState(id)
+				if err != nil {
+					slog.Errorf("getting rate limited incident %d: %v", id, err)
+					continue
+				}
+				alert := s.RuleConf.GetAlert(st.AlertKey.Name())
+				if alert == nil {
+					continue
+				}
+				if summaries[alert.Template] == nil {
+					templates = append(templates, alert.Template)
+				}
+				summaries[alert.Template] = append(summaries[alert.Template], st)
+			}
+			for _, t := range templates {
+				slog.Infof("sending rate limited summary of %d incidents by notification %s", len(summaries[t]), name)
+				if !s.quiet {
+					n.NotifyRateLimited(t, s.SystemConf, summaries[t])
+				}
+			}
+		}
+		// Incidents of notifications removed from the rule configuration
+		// are dropped.
+		if err := s.DataAccess.RateLimits().ClearRateLimited(name, ids); err != nil {
+			slog.Errorf("clearing rate limited notification %s: %v", name, err)
+		}
+	}
+}
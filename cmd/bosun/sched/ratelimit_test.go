@@ -0,0 +1,83 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+)
+
+func TestRateLimit(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		template t {
+			subject = x
+			body = x
+		}
+		notification n {
+			print = true
+			rateLimit = 2/1h
+		}
+		alert a {
+			template = t
+			critNotification = n
+			crit = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := c.GetNotification("n")
+	da := s.DataAccess.State()
+	for _, host := range []string{"a", "b", "c", "d", "e"} {
+		ak := models.AlertKey("a{host=" + host + "}")
+		st := &models.IncidentState{AlertKey: ak, Alert: ak.Name(), Tags: ak.Group().Tags(), Open: true, NeedAck: true, CurrentStatus: models.StCritical, WorstStatus: models.StCritical, Events: []models.Event{{Status: models.StCritical}}}
+		if st.Id, err = da.UpdateIncidentState(st); err != nil {
+			t.Fatal(err)
+		}
+		s.Notify(st, &models.RenderedTemplates{}, n)
+	}
+	noSilence := func(models.AlertKey) *models.Silence { return nil }
+	s.sendNotifications(noSilence)
+	s.pendingNotifications = nil
+
+	rateLimited := func() int {
+		limited, err := s.DataAccess.RateLimits().GetRateLimited()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(limited["n"])
+	}
+	if l := rateLimited(); l != 3 {
+		t.Fatalf("expected 3 rate limited incidents, got %d", l)
+	}
+	// The bucket is empty, so the summary waits.
+	s.sendRateLimitedSummaries()
+	if l := rateLimited(); l != 3 {
+		t.Fatalf("expected the summary to wait for a token, got %d rate limited incidents", l)
+	}
+
+	// An hour later, the bucket is full again.
+	name := notificationRateLimit(n)
+	buckets := map[string]*models.TokenBucket{name: {Time: utcNow().Add(-time.Hour)}}
+	if err := s.DataAccess.RateLimits().SetBuckets(buckets); err != nil {
+		t.Fatal(err)
+	}
+	s.sendRateLimitedSummaries()
+	if l := rateLimited(); l != 0 {
+		t.Fatalf("expected the summary to be sent, got %d rate limited incidents", l)
+	}
+	buckets, err = s.DataAccess.RateLimits().GetBuckets(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens := buckets[name].Tokens; tokens < 0.99 || tokens > 1.01 {
+		t.Errorf("expected the summary to take 1 of 2 tokens, %v left", tokens)
+	}
+}
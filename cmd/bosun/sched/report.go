@@ -0,0 +1,98 @@
+package sched
+
+import (
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.report.sent", metadata.Counter, metadata.Count,
+		"The number of scheduled reports rendered and sent.")
+	metadata.AddMetricMeta("bosun.report.errs", metadata.Counter, metadata.Count,
+		"The number of scheduled reports that were not sent because their template failed to render.")
+}
+
+// runReports sends the reports of the rule configuration on their schedules
+// until the schedule is closed. With sharded alert checks only the leader
+// sends reports.
+func (s *Schedule) runReports() {
+	reports := s.RuleConf.GetReports()
+	next := make(map[string]time.Time, len(reports))
+	now := utcNow()
+	for name, r := range reports {
+		if t := r.Schedule.Next(now); !t.IsZero() {
+			next[name] = t
+		}
+	}
+	for len(next) > 0 {
+		var first time.Time
+		for _, t := range next {
+			if first.IsZero() || t.Before(first) {
+				first = t
+			}
+		}
+		timer := time.NewTimer(first.Sub(utcNow()))
+		select {
+		case <-s.runnerContext.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		now := utcNow()
+		for name, t := range next {
+			if t.After(now) {
+				continue
+			}
+			r := reports[name]
+			if n := r.Schedule.Next(now); !n.IsZero() {
+				next[name] = n
+			} else {
+				delete(next, name)
+			}
+			if s.isLeader() {
+				go s.sendReport(r, t)
+			}
+		}
+	}
+}
+
+// sendReport renders r as of t and sends it to its notifications, unless
+// bosun is in quiet mode.
+func (s *Schedule) sendReport(r *conf.Report, t time.Time) {
+	log := slog.With("report", r.Name)
+	rt, errs := s.renderReport(r, t)
+	if len(errs) > 0 {
+		for _, err := range errs {
+			log.Errorf("rendering report: %v", err)
+		}
+		collect.Add("report.errs", opentsdb.TagSet{"report": r.Name}, 1)
+		return
+	}
+	if s.quiet {
+		log.Infoln("quiet mode prevented report to", len(r.Notifications), "notifications")
+		return
+	}
+	for _, n := range r.Notifications {
+		n.PrepareAlert(rt, r.Name).Send(s.SystemConf)
+	}
+	collect.Add("report.sent", opentsdb.TagSet{"report": r.Name}, 1)
+}
+
+// renderReport renders the template of r as of t. The template is executed
+// like the template of an alert named like the report without tags or
+// incident, so all template functions are available.
+func (s *Schedule) renderReport(r *conf.Report, t time.Time) (*models.RenderedTemplates, []error) {
+	rh := s.NewRunHistory(t, nil)
+	st := &models.IncidentState{
+		AlertKey: models.NewAlertKey(r.Name, nil),
+		Alert:    r.Name,
+		Start:    t,
+	}
+	return s.ExecuteAll(rh, r.Alert(), st, false)
+}
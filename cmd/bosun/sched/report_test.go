@@ -0,0 +1,62 @@
+package sched
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+)
+
+func TestSendReportQuiet(t *testing.T) {
+	defer setup()()
+	nc := make(chan struct{}, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nc <- struct{}{}
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, fmt.Sprintf(`
+		template t {
+			subject = s
+			body = b
+		}
+		notification n {
+			post = http://%s/
+		}
+		report r {
+			schedule = 0 9 * * *
+			template = t
+			notification = n
+		}
+	`, u.Host))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := c.Reports["r"]
+	s.quiet = true
+	s.sendReport(r, utcNow())
+	select {
+	case <-nc:
+		t.Fatal("report sent in quiet mode")
+	case <-time.After(100 * time.Millisecond):
+	}
+	s.quiet = false
+	s.sendReport(r, utcNow())
+	select {
+	case <-nc:
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive report before timeout")
+	}
+}
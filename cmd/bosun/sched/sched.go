@@ -13,6 +13,7 @@ import (
 	"bosun.org/cmd/bosun/cache"
 	"bosun.org/cmd/bosun/conf"
 	"bosun.org/cmd/bosun/database"
+	"bosun.org/cmd/bosun/expr"
 	"bosun.org/cmd/bosun/search"
 	"bosun.org/collect"
 	"bosun.org/metadata"
@@ -43,6 +44,13 @@ type Schedule struct {
 	Search *search.Search
 
 	annotate backend.Backend
+	// annotationWrites orders the writes of automatic annotations
+	annotationWrites annotationWrites
+
+	// cluster is set if alert checks are sharded across processes
+	cluster *cluster
+
+	costs *alertCosts
 
 	skipLast bool
 	quiet    bool
@@ -86,7 +94,7 @@ func (s *Schedule) Init(name string, systemConf conf.SystemConfProvider, ruleCon
 	s.pendingUnknowns = make(map[notificationGroupKey][]*models.IncidentState)
 	s.lastLogTimes = make(map[models.AlertKey]time.Time)
 	s.LastCheck = utcNow()
-	s.ctx = &checkContext{utcNow(), cache.New(name, 0)}
+	s.ctx = &checkContext{runTime: utcNow(), checkCache: cache.New(name, 0)}
 	s.DataAccess = dataAccess
 	// Initialize the context and waitgroup used to gracefully shutdown bosun as well as reload
 	s.runnerContext, s.cancelChecks = context.WithCancel(context.Background())
@@ -95,12 +103,23 @@ func (s *Schedule) Init(name string, systemConf conf.SystemConfProvider, ruleCon
 	if s.Search == nil {
 		s.Search = search.NewSearch(s.DataAccess, skipLast)
 	}
+	if s.costs == nil {
+		s.costs = newAlertCosts()
+	}
+	cc := systemConf.GetCardinalityConf()
+	s.Search.SetLimits(search.Limits{
+		MaxSeriesPerMetric: cc.MaxSeriesPerMetric,
+		MaxTagValues:       cc.MaxTagValues,
+	})
 	return nil
 }
 
 type checkContext struct {
 	runTime    time.Time
 	checkCache *cache.Cache
+	// tsdbPlan, if not nil, coalesces the OpenTSDB queries of the alerts
+	// checked at runTime.
+	tsdbPlan *expr.TSDBPlan
 }
 
 func init() {
@@ -352,6 +371,9 @@ type StateGroups struct {
 		NeedAck      []*StateGroup `json:",omitempty"`
 		Acknowledged []*StateGroup `json:",omitempty"`
 	}
+	// Pending are the alert keys that wait for the pending duration of
+	// their alert before an incident is opened.
+	Pending                       []*models.PendingAlert `json:",omitempty"`
 	TimeAndDate                   []int
 	FailingAlerts, UnclosedErrors int
 }
@@ -383,9 +405,10 @@ func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGro
 		for k, v := range status2 {
 			a := s.RuleConf.GetAlert(k.Name())
 			if a == nil {
-				slog.Errorf("unknown alert %s. Force closing.", k.Name())
+				log := akLog(k)
+				log.Error("unknown alert. Force closing.")
 				if err2 = s.ActionByAlertKey("bosun", "closing because alert doesn't exist.", models.ActionForceClose, nil, k); err2 != nil {
-					slog.Error(err2)
+					log.Error(err2)
 				}
 				continue
 			}
@@ -408,6 +431,12 @@ func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGro
 	if err != nil {
 		return nil, err
 	}
+	T.Step("Pending", func(miniprofiler.Timer) {
+		t.Pending, err = s.getPendingAlerts(filter, silenced)
+	})
+	if err != nil {
+		return nil, err
+	}
 	T.Step("GroupStates", func(T miniprofiler.Timer) {
 		groups = status.GroupStates(silenced)
 	})
@@ -509,6 +538,9 @@ func Close(reload bool) {
 func (s *Schedule) Close(reload bool) {
 	s.cancelChecks()
 	s.checksRunning.Wait()
+	if !reload {
+		s.leaveCluster()
+	}
 	if s.skipLast || reload {
 		return
 	}
@@ -519,7 +551,7 @@ func (s *Schedule) Close(reload bool) {
 }
 
 func (s *Schedule) Reset() {
-	DefaultSched = &Schedule{}
+	DefaultSched = &Schedule{costs: s.costs}
 }
 
 func Reset() {
@@ -646,7 +678,12 @@ func (s *Schedule) action(user, message string, t models.ActionType, at *time.Ti
 		if err := s.DataAccess.Notifications().ClearNotifications(st.AlertKey); err != nil {
 			return "", err
 		}
-		return st.AlertKey, s.DataAccess.State().Forget(st.AlertKey)
+		if err := s.DataAccess.State().Forget(st.AlertKey); err != nil {
+			return "", err
+		}
+		st.Open = false
+		s.updateProblem(st)
+		return st.AlertKey, nil
 	case models.ActionNote:
 		// pass
 	default:
@@ -658,12 +695,34 @@ func (s *Schedule) action(user, message string, t models.ActionType, at *time.Ti
 	if err != nil {
 		return "", err
 	}
+	log := incidentLog(st).With("action", action.Type.String(), "user", user)
+	log.Info("incident action")
+	s.annotateAction(st, action)
+	if !st.Open {
+		s.annotateIncident(st)
+		s.updateProblem(st)
+	}
 	if err := collect.Add("actions", opentsdb.TagSet{"user": user, "alert": st.AlertKey.Name(), "type": t.String()}, 1); err != nil {
-		slog.Errorln(err)
+		log.Errorln(err)
 	}
 	return st.AlertKey, nil
 }
 
+// akLog returns a logger with the alert name, key and host of ak as fields.
+func akLog(ak models.AlertKey) *slog.Entry {
+	log := slog.With("alert", ak.Name(), "alertkey", string(ak))
+	if host := ak.Group()["host"]; host != "" {
+		log = log.With("host", host)
+	}
+	return log
+}
+
+// incidentLog returns a logger with the alert key and incident ID of st as
+// fields.
+func incidentLog(st *models.IncidentState) *slog.Entry {
+	return akLog(st.AlertKey).With("incident", st.Id)
+}
+
 type IncidentStatus struct {
 	IncidentID         int64
 	Active             bool
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -174,6 +175,49 @@ func TestCrit(t *testing.T) {
 	if !s.AlertSuccessful("a") {
 		t.Fatal("Expected alert a to be successful")
 	}
+	runs, err := s.DataAccess.Runs().GetAlertRuns("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || !runs[0].Time.Equal(queryTime) || runs[0].Critical != 1 || runs[0].Error != "" {
+		t.Errorf("Expected a run with a critical alert key. Got %+v.", runs)
+	}
+}
+
+func TestAlertRunsRetention(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `alert a {
+		crit = 1
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{MaxAlertRuns: 2}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a", "removed"} {
+		if err := s.DataAccess.Runs().AddAlertRun(name, &models.AlertRun{Time: utcNow()}, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		check(s, utcNow())
+	}
+	runs, err := s.DataAccess.Runs().GetAlertRuns("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 2 {
+		t.Errorf("Expected 2 runs of a. Got %d.", len(runs))
+	}
+	s.deleteRemovedAlertRuns()
+	if runs, err = s.DataAccess.Runs().GetAlertRuns("removed", 0); err != nil || len(runs) != 0 {
+		t.Errorf("Expected the runs of the removed alert to be deleted. Got %v, %v.", runs, err)
+	}
+	if runs, err = s.DataAccess.Runs().GetAlertRuns("a", 0); err != nil || len(runs) != 2 {
+		t.Errorf("Expected the runs of a to be kept. Got %v, %v.", runs, err)
+	}
 }
 
 func TestBandDisableUnjoined(t *testing.T) {
@@ -284,6 +328,13 @@ func TestUnknown_WithError(t *testing.T) {
 	if s.AlertSuccessful("a") {
 		t.Fatal("Expected alert a to be in a failed state")
 	}
+	runs, err := s.DataAccess.Runs().GetAlertRuns("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || runs[0].Error == "" || runs[0].Unknown != 0 {
+		t.Errorf("Expected a run with an error. Got %+v.", runs)
+	}
 }
 
 func TestRename(t *testing.T) {
@@ -357,3 +408,21 @@ func TestUnknownsAreNormal(t *testing.T) {
 		},
 	})
 }
+
+func TestAkLog(t *testing.T) {
+	for _, test := range []struct {
+		ak     models.AlertKey
+		fields string
+	}{
+		{"a{host=web01,iface=eth0}", "alert=a alertkey=a{host=web01,iface=eth0} host=web01"},
+		{"a{iface=eth0}", "alert=a alertkey=a{iface=eth0}"},
+	} {
+		var fields []string
+		for _, f := range akLog(test.ak).Fields() {
+			fields = append(fields, fmt.Sprintf("%s=%v", f.Key, f.Value))
+		}
+		if got := strings.Join(fields, " "); got != test.fields {
+			t.Errorf("%s: expected fields %s, got %s", test.ak, test.fields, got)
+		}
+	}
+}
@@ -78,6 +78,10 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forge
 		if err := s.DataAccess.Silence().AddSilence(si); err != nil {
 			return nil, err
 		}
+		if edit != "" && edit != si.ID() {
+			s.endSilenceAnnotation(edit)
+		}
+		s.annotateSilence(si)
 		return nil, nil
 	}
 	aks := make(map[models.AlertKey]bool)
@@ -94,5 +98,9 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forge
 }
 
 func (s *Schedule) ClearSilence(id string) error {
-	return s.DataAccess.Silence().DeleteSilence(id)
+	if err := s.DataAccess.Silence().DeleteSilence(id); err != nil {
+		return err
+	}
+	s.endSilenceAnnotation(id)
+	return nil
 }
@@ -104,6 +104,16 @@ func (c *Context) GetIncidentState(id int64) *models.IncidentState {
 	return is
 }
 
+// OpenIncidents returns the open incidents, so reports can list them.
+func (c *Context) OpenIncidents() []*models.IncidentState {
+	is, err := c.schedule.DataAccess.State().GetAllOpenIncidents()
+	if err != nil {
+		c.addError(err)
+		return nil
+	}
+	return is
+}
+
 // Expr takes an expression in the form of a string, changes the tags to
 // match the context of the alert, and returns a link to the expression page.
 func (c *Context) Expr(v string) string {
@@ -55,6 +55,7 @@ type IncidentSummaryView struct {
 	LastAbnormalTime       models.Epoch
 	Unevaluated            bool
 	NeedAck                bool
+	Open                   bool
 	Silenced               bool
 	Actions                []EpochAction
 	Events                 []EventSummary
@@ -103,6 +104,7 @@ func MakeIncidentSummary(c conf.RuleConfProvider, s SilenceTester, is *models.In
 		LastAbnormalTime:       is.LastAbnormalTime,
 		Unevaluated:            is.Unevaluated,
 		NeedAck:                is.NeedAck,
+		Open:                   is.Open,
 		Silenced:               s(is.AlertKey) != nil,
 		Actions:                actions,
 		Events:                 eventSummaries,
@@ -0,0 +1,153 @@
+package search
+
+import (
+	"sort"
+
+	"bosun.org/collect"
+	"bosun.org/opentsdb"
+)
+
+// Limits restricts the number of series that are indexed. A limit of zero
+// disables it.
+type Limits struct {
+	// MaxSeriesPerMetric is the maximum number of tag sets of a metric.
+	MaxSeriesPerMetric int
+	// MaxTagValues is the maximum number of values of a tag key of a metric.
+	MaxTagValues int
+}
+
+// MetricCardinality describes the number of series of a metric.
+type MetricCardinality struct {
+	Metric string
+	Series int
+	// TagKeys is the number of values of each tag key.
+	TagKeys map[string]int
+	// Limited is the number of data points refused by limits.
+	Limited int64
+}
+
+// SetLimits sets the limits used by Limit.
+func (s *Search) SetLimits(l Limits) {
+	s.Lock()
+	defer s.Unlock()
+	s.limits = l
+}
+
+// LimitsEnabled returns true if any limit is set.
+func (s *Search) LimitsEnabled() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.limits.MaxSeriesPerMetric > 0 || s.limits.MaxTagValues > 0
+}
+
+// Limit splits mdp into the data points that may be indexed and those that
+// would create a new series over a limit. Data points of known series are
+// always admitted. Refused data points are counted in bosun.search.limited.
+func (s *Search) Limit(mdp opentsdb.MultiDataPoint) (admitted, limited opentsdb.MultiDataPoint) {
+	s.RLock()
+	// series and tag values first seen in mdp
+	series := make(map[string]map[string]bool)
+	values := make(map[string]map[string]map[string]bool)
+	reasons := make(map[string]int64)
+	for _, dp := range mdp {
+		if reason := s.admit(dp, series, values); reason != "" {
+			limited = append(limited, dp)
+			reasons[reason]++
+		} else {
+			admitted = append(admitted, dp)
+		}
+	}
+	s.RUnlock()
+	if len(limited) == 0 {
+		return admitted, nil
+	}
+	s.Lock()
+	for _, dp := range limited {
+		s.limited[dp.Metric]++
+	}
+	s.Unlock()
+	for reason, n := range reasons {
+		collect.Add("search.limited", opentsdb.TagSet{"reason": reason}, n)
+	}
+	return admitted, limited
+}
+
+// admit returns the limit that refuses dp, or "" if dp is admitted, in which
+// case its series and tag values are recorded in series and values. s must
+// be read locked.
+func (s *Search) admit(dp *opentsdb.DataPoint, series map[string]map[string]bool, values map[string]map[string]map[string]bool) string {
+	tags := dp.Tags.String()
+	if _, ok := s.last[dp.Metric][tags]; ok || series[dp.Metric][tags] {
+		return ""
+	}
+	if max := s.limits.MaxSeriesPerMetric; max > 0 && len(s.last[dp.Metric])+len(series[dp.Metric]) >= max {
+		return "series"
+	}
+	if max := s.limits.MaxTagValues; max > 0 {
+		for k, v := range dp.Tags {
+			known, added := s.tagValues[dp.Metric][k], values[dp.Metric][k]
+			if !known[v] && !added[v] && len(known)+len(added) >= max {
+				return "tag_values"
+			}
+		}
+	}
+	if series[dp.Metric] == nil {
+		series[dp.Metric] = make(map[string]bool)
+		values[dp.Metric] = make(map[string]map[string]bool)
+	}
+	series[dp.Metric][tags] = true
+	for k, v := range dp.Tags {
+		if values[dp.Metric][k] == nil {
+			values[dp.Metric][k] = make(map[string]bool)
+		}
+		values[dp.Metric][k][v] = true
+	}
+	return ""
+}
+
+// addTagValues records the tag values of a new series. s must be locked.
+func (s *Search) addTagValues(metric string, tags opentsdb.TagSet) {
+	keys := s.tagValues[metric]
+	if keys == nil {
+		keys = make(map[string]map[string]bool)
+		s.tagValues[metric] = keys
+	}
+	for k, v := range tags {
+		if keys[k] == nil {
+			keys[k] = make(map[string]bool)
+		}
+		keys[k][v] = true
+	}
+}
+
+// Cardinality returns the cardinality of every indexed metric, ordered by
+// descending number of series.
+func (s *Search) Cardinality() []MetricCardinality {
+	s.RLock()
+	defer s.RUnlock()
+	r := make([]MetricCardinality, 0, len(s.last))
+	for metric, mmap := range s.last {
+		mc := MetricCardinality{
+			Metric:  metric,
+			Series:  len(mmap),
+			TagKeys: make(map[string]int),
+			Limited: s.limited[metric],
+		}
+		for k, vals := range s.tagValues[metric] {
+			mc.TagKeys[k] = len(vals)
+		}
+		r = append(r, mc)
+	}
+	for metric, n := range s.limited {
+		if _, ok := s.last[metric]; !ok {
+			r = append(r, MetricCardinality{Metric: metric, TagKeys: map[string]int{}, Limited: n})
+		}
+	}
+	sort.Slice(r, func(i, j int) bool {
+		if r[i].Series != r[j].Series {
+			return r[i].Series > r[j].Series
+		}
+		return r[i].Metric < r[j].Metric
+	})
+	return r
+}
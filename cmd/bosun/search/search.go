@@ -27,6 +27,12 @@ type Search struct {
 	// metric -> tags -> struct
 	last map[string]map[string]*database.LastInfo
 
+	// metric -> tag key -> tag values of the series in last
+	tagValues map[string]map[string]map[string]bool
+	// metric -> number of data points refused by limits
+	limited map[string]int64
+	limits  Limits
+
 	indexQueue chan *opentsdb.DataPoint
 	sync.RWMutex
 }
@@ -34,12 +40,15 @@ type Search struct {
 func init() {
 	metadata.AddMetricMeta("bosun.search.index_queue", metadata.Gauge, metadata.Count, "Number of datapoints queued for indexing to redis")
 	metadata.AddMetricMeta("bosun.search.dropped", metadata.Counter, metadata.Count, "Number of datapoints discarded without being saved to redis")
+	metadata.AddMetricMeta("bosun.search.limited", metadata.Counter, metadata.Count, "Number of datapoints of new series refused because a cardinality limit was reached")
 }
 
 func NewSearch(data database.DataAccess, skipLast bool) *Search {
 	s := Search{
 		DataAccess: data,
 		last:       make(map[string]map[string]*database.LastInfo),
+		tagValues:  make(map[string]map[string]map[string]bool),
+		limited:    make(map[string]int64),
 		indexQueue: make(chan *opentsdb.DataPoint, 300000),
 	}
 	collect.Set("search.index_queue", opentsdb.TagSet{}, func() interface{} { return len(s.indexQueue) })
@@ -77,6 +86,7 @@ func (s *Search) Index(mdp opentsdb.MultiDataPoint) {
 		if p == nil {
 			p = &database.LastInfo{}
 			mmap[dp.Tags.String()] = p
+			s.addTagValues(dp.Metric, dp.Tags)
 		}
 		if p.Timestamp < dp.Timestamp {
 			if fv, err := getFloat(dp.Value); err == nil {
@@ -212,6 +222,15 @@ func (s *Search) loadLast() {
 	} else {
 		s.last = m
 	}
+	for metric, mmap := range s.last {
+		for tags := range mmap {
+			ts, err := opentsdb.ParseTags(strings.Trim(tags, "{}"))
+			if err != nil {
+				continue
+			}
+			s.addTagValues(metric, ts)
+		}
+	}
 	slog.Info("Done")
 }
 
@@ -74,3 +74,32 @@ func TestIndex(t *testing.T) {
 		t.Fatalf("Expected 2 filtered results. Found %d.", len(filtered))
 	}
 }
+
+func TestLimit(t *testing.T) {
+	s := NewSearch(testSearch.DataAccess, true)
+	s.SetLimits(Limits{MaxSeriesPerMetric: 3, MaxTagValues: 2})
+	s.Index(opentsdb.MultiDataPoint{
+		&opentsdb.DataPoint{Metric: "m", Value: 1, Timestamp: 1, Tags: opentsdb.TagSet{"host": "a", "id": "1"}},
+		&opentsdb.DataPoint{Metric: "m", Value: 1, Timestamp: 1, Tags: opentsdb.TagSet{"host": "a", "id": "2"}},
+	})
+	dp := func(host, id string) *opentsdb.DataPoint {
+		return &opentsdb.DataPoint{Metric: "m", Value: 1, Timestamp: 2, Tags: opentsdb.TagSet{"host": host, "id": id}}
+	}
+	admitted, limited := s.Limit(opentsdb.MultiDataPoint{
+		dp("a", "1"), // known series
+		dp("a", "3"), // third id value
+		dp("b", "1"), // new series, but only two host values
+		dp("b", "2"), // fourth series
+		dp("a", "1"),
+	})
+	if len(admitted) != 3 || len(limited) != 2 {
+		t.Fatalf("expected 3 admitted and 2 limited data points, got %v and %v", admitted, limited)
+	}
+	if admitted[1].Tags["host"] != "b" || limited[0].Tags["id"] != "3" || limited[1].Tags["id"] != "2" {
+		t.Errorf("unexpected admitted %v and limited %v", admitted, limited)
+	}
+	c := s.Cardinality()
+	if len(c) != 1 || c[0].Series != 2 || c[0].TagKeys["id"] != 2 || c[0].Limited != 2 {
+		t.Errorf("unexpected cardinality %+v", c)
+	}
+}
@@ -238,24 +238,7 @@ func ExprGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (in
 	} else if e.Root.Return() != models.TypeSeriesSet {
 		return nil, fmt.Errorf("egraph: requires an expression that returns a series")
 	}
-	// it may not strictly be necessary to recreate the contexts each time, but we do to be safe
-	backends := &expr.Backends{
-		TSDBContext:       schedule.SystemConf.GetTSDBContext(),
-		GraphiteContext:   schedule.SystemConf.GetGraphiteContext(),
-		InfluxConfig:      schedule.SystemConf.GetInfluxContext(),
-		ElasticHosts:      schedule.SystemConf.GetElasticContext(),
-		AzureMonitor:      schedule.SystemConf.GetAzureMonitorContext(),
-		PromConfig:        schedule.SystemConf.GetPromContext(),
-		CloudWatchContext: schedule.SystemConf.GetCloudWatchContext(),
-	}
-	providers := &expr.BosunProviders{
-		Cache:     cacheObj,
-		Search:    schedule.Search,
-		Annotate:  AnnotateBackend,
-		Squelched: nil,
-		History:   nil,
-	}
-	res, _, err := e.Execute(backends, providers, t, now, autods, false, "Web: chart creation")
+	res, _, err := e.Execute(exprBackends(), exprProviders(), t, now, autods, false, "Web: chart creation")
 	if err != nil {
 		return nil, err
 	}
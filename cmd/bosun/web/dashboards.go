@@ -0,0 +1,239 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/gorilla/mux"
+)
+
+// dashboardNameRE matches the names of dashboards, which are used in URLs.
+var dashboardNameRE = regexp.MustCompile(`^[\w.-]+$`)
+
+// dashboardVarRE matches the names of the variables of dashboards.
+var dashboardVarRE = regexp.MustCompile(`^\w+$`)
+
+// dashboardExprVarRE matches the variables in the expressions of panels.
+var dashboardExprVarRE = regexp.MustCompile(`\$(\w+)`)
+
+// validateDashboard returns an error if d is not a valid dashboard.
+func validateDashboard(d *models.Dashboard) error {
+	if !dashboardNameRE.MatchString(d.Name) {
+		return fmt.Errorf("invalid dashboard name %q: names may only contain letters, numbers, _, . and -", d.Name)
+	}
+	seen := make(map[string]bool)
+	for _, v := range d.Variables {
+		if !dashboardVarRE.MatchString(v.Name) {
+			return fmt.Errorf("invalid variable name %q", v.Name)
+		}
+		if seen[v.Name] {
+			return fmt.Errorf("duplicate variable %s", v.Name)
+		}
+		seen[v.Name] = true
+		if v.TagKey == "" {
+			return fmt.Errorf("variable %s: TagKey required", v.Name)
+		}
+	}
+	for i, p := range d.Panels {
+		var field, value string
+		switch p.Type {
+		case models.PanelExpr, models.PanelTable:
+			field, value = "Expr", p.Expr
+		case models.PanelQuery:
+			field, value = "Query", p.Query
+			// The variables of a query are in its strings, so it is JSON
+			// before they are replaced.
+			if _, err := opentsdb.RequestFromJSON([]byte(p.Query)); p.Query != "" && err != nil {
+				return fmt.Errorf("panel %d: bad Query: %v", i+1, err)
+			}
+		case models.PanelIncidents:
+			// An empty filter matches all open incidents.
+		case models.PanelHost:
+			field, value = "Host", p.Host
+		default:
+			return fmt.Errorf("panel %d: unknown type %q", i+1, p.Type)
+		}
+		if field != "" && value == "" {
+			return fmt.Errorf("panel %d: %s required for %s panels", i+1, field, p.Type)
+		}
+		if p.Width < 0 || p.Width > 12 {
+			return fmt.Errorf("panel %d: Width must be between 1 and 12", i+1)
+		}
+	}
+	return nil
+}
+
+// ListDashboards returns all saved dashboards sorted by name.
+func ListDashboards(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.DataAccess.Dashboards().GetDashboards()
+}
+
+type dashboardResponse struct {
+	*models.Dashboard
+	// Values are the values of the variables of the dashboard, by variable
+	// name.
+	Values map[string][]string
+}
+
+// GetDashboard returns a saved dashboard and the values of its variables.
+func GetDashboard(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	name := mux.Vars(r)["name"]
+	d, err := schedule.DataAccess.Dashboards().GetDashboard(name)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, fmt.Errorf("no dashboard named %q", name)
+	}
+	since, err := getSince(r)
+	if err != nil {
+		return nil, err
+	}
+	res := dashboardResponse{
+		Dashboard: d,
+		Values:    make(map[string][]string),
+	}
+	for _, v := range d.Variables {
+		var values []string
+		if v.Metric != "" {
+			values, err = schedule.Search.TagValuesByMetricTagKey(v.Metric, v.TagKey, since)
+		} else {
+			values, err = schedule.Search.TagValuesByTagKey(v.TagKey, since)
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Values[v.Name] = values
+	}
+	return res, nil
+}
+
+// DashboardExpr executes the expression of an expr or table panel of a saved
+// dashboard, given by its index from 0, so the panels can be viewed without
+// permission to run any expression. The values of the variables of the
+// dashboard are the var-{name} parameters.
+func DashboardExpr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v interface{}, err error) {
+	defer func() {
+		if pan := recover(); pan != nil {
+			v = nil
+			err = fmt.Errorf("%v", pan)
+		}
+	}()
+	name := mux.Vars(r)["name"]
+	d, err := schedule.DataAccess.Dashboards().GetDashboard(name)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, fmt.Errorf("no dashboard named %q", name)
+	}
+	i, err := strconv.Atoi(mux.Vars(r)["panel"])
+	if err != nil {
+		return nil, fmt.Errorf("bad panel: %v", err)
+	}
+	text, err := dashboardPanelExpr(d, i, func(name string) string {
+		return r.FormValue("var-" + name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return executeExpr(t, r, text)
+}
+
+// dashboardPanelExpr returns the expression of the expr or table panel i of
+// d with the variables of d replaced by their value. Values may only be tag
+// values or the * wildcard, joined by |, so they can not change what the
+// expression does.
+func dashboardPanelExpr(d *models.Dashboard, i int, value func(name string) string) (string, error) {
+	if i < 0 || i >= len(d.Panels) {
+		return "", fmt.Errorf("dashboard %s has no panel %d", d.Name, i)
+	}
+	p := d.Panels[i]
+	if p.Type != models.PanelExpr && p.Type != models.PanelTable {
+		return "", fmt.Errorf("panel %d of dashboard %s is a %s panel", i, d.Name, p.Type)
+	}
+	values := make(map[string]string)
+	for _, dv := range d.Variables {
+		v := value(dv.Name)
+		for _, s := range strings.Split(v, "|") {
+			if v != "" && s != "*" && !opentsdb.ValidTSDBString(s) {
+				return "", fmt.Errorf("invalid value %q of variable %s", v, dv.Name)
+			}
+		}
+		values[dv.Name] = v
+	}
+	return dashboardExprVarRE.ReplaceAllStringFunc(p.Expr, func(m string) string {
+		if v, ok := values[m[1:]]; ok {
+			return v
+		}
+		return m
+	}), nil
+}
+
+// SetDashboard saves the dashboard in the request body as the dashboard
+// named in the URL.
+func SetDashboard(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var d models.Dashboard
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		return nil, err
+	}
+	if d.User != "" && !userCanOverwriteUsername(r) {
+		http.Error(w, "Not Authorized to set User", 400)
+		return nil, nil
+	} else if d.User == "" {
+		d.User = getUsername(r)
+	}
+	d.Name = mux.Vars(r)["name"]
+	d.Modified = time.Now().UTC()
+	if err := validateDashboard(&d); err != nil {
+		return nil, err
+	}
+	// The expressions of panels are run by anyone who can view the
+	// dashboard, so only users who can run them may write new ones.
+	if !userCanRunTests(r) {
+		old, err := schedule.DataAccess.Dashboards().GetDashboard(d.Name)
+		if err != nil {
+			return nil, err
+		}
+		if hasNewPanelExprs(&d, old) {
+			http.Error(w, "Not Authorized to save panel expressions", http.StatusForbidden)
+			return nil, nil
+		}
+	}
+	if err := schedule.DataAccess.Dashboards().SetDashboard(&d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// hasNewPanelExprs returns if d has expr or table panels with expressions
+// that are not in the expr or table panels of old, which may be nil.
+func hasNewPanelExprs(d, old *models.Dashboard) bool {
+	exprs := make(map[string]bool)
+	if old != nil {
+		for _, p := range old.Panels {
+			if p.Type == models.PanelExpr || p.Type == models.PanelTable {
+				exprs[p.Expr] = true
+			}
+		}
+	}
+	for _, p := range d.Panels {
+		if (p.Type == models.PanelExpr || p.Type == models.PanelTable) && !exprs[p.Expr] {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteDashboard deletes the dashboard named in the URL.
+func DeleteDashboard(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return nil, schedule.DataAccess.Dashboards().DeleteDashboard(mux.Vars(r)["name"])
+}
@@ -0,0 +1,108 @@
+package web
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"bosun.org/models"
+)
+
+func TestValidateDashboard(t *testing.T) {
+	tests := []struct {
+		d   models.Dashboard
+		err string
+	}{
+		{models.Dashboard{
+			Name:      "web-01",
+			Variables: []*models.DashboardVariable{{Name: "host", TagKey: "host", Metric: "os.cpu"}},
+			Panels: []*models.DashboardPanel{
+				{Type: models.PanelExpr, Expr: `avg(q("avg:os.cpu{host=$host}", "1h", ""))`, Width: 6},
+				{Type: models.PanelQuery, Query: `{"start":"1h-ago","queries":[{"metric":"os.cpu","aggregator":"sum","tags":{"host":"$host"}}]}`},
+				{Type: models.PanelIncidents},
+				{Type: models.PanelHost, Host: "$host"},
+			},
+		}, ""},
+		{models.Dashboard{Name: "a/b"}, "invalid dashboard name"},
+		{models.Dashboard{Name: "a", Variables: []*models.DashboardVariable{{Name: "h-1", TagKey: "host"}}}, "invalid variable name"},
+		{models.Dashboard{Name: "a", Variables: []*models.DashboardVariable{{Name: "h", TagKey: "host"}, {Name: "h", TagKey: "dc"}}}, "duplicate variable h"},
+		{models.Dashboard{Name: "a", Variables: []*models.DashboardVariable{{Name: "h"}}}, "TagKey required"},
+		{models.Dashboard{Name: "a", Panels: []*models.DashboardPanel{{Type: "pie"}}}, `unknown type "pie"`},
+		{models.Dashboard{Name: "a", Panels: []*models.DashboardPanel{{Type: models.PanelTable}}}, "Expr required for table panels"},
+		{models.Dashboard{Name: "a", Panels: []*models.DashboardPanel{{Type: models.PanelQuery, Query: "{"}}}, "bad Query"},
+		{models.Dashboard{Name: "a", Panels: []*models.DashboardPanel{{Type: models.PanelHost, Host: "h", Width: 13}}}, "Width"},
+	}
+	for _, test := range tests {
+		err := validateDashboard(&test.d)
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.d.Name, err)
+		case test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)):
+			t.Errorf("%s: expected error %q, got %v", test.d.Name, test.err, err)
+		}
+	}
+}
+
+func TestDashboardPanelExpr(t *testing.T) {
+	d := &models.Dashboard{
+		Name:      "web",
+		Variables: []*models.DashboardVariable{{Name: "host", TagKey: "host"}},
+		Panels: []*models.DashboardPanel{
+			{Type: models.PanelExpr, Expr: "$w = \"1h\"\navg(q(\"avg:os.cpu{host=$host}\", $w, \"\"))"},
+			{Type: models.PanelHost, Host: "$host"},
+		},
+	}
+	tests := []struct {
+		panel int
+		host  string
+		expr  string
+		err   string
+	}{
+		{0, "ny-web01", "$w = \"1h\"\navg(q(\"avg:os.cpu{host=ny-web01}\", $w, \"\"))", ""},
+		{0, "ny-web01|ny-web02", "$w = \"1h\"\navg(q(\"avg:os.cpu{host=ny-web01|ny-web02}\", $w, \"\"))", ""},
+		{0, "*", "$w = \"1h\"\navg(q(\"avg:os.cpu{host=*}\", $w, \"\"))", ""},
+		{0, `a}", "1h", "")) + q("avg:secret{host=*}`, "", "invalid value"},
+		{1, "ny-web01", "", "is a host panel"},
+		{2, "ny-web01", "", "has no panel 2"},
+	}
+	for _, test := range tests {
+		vars := url.Values{"host": {test.host}}
+		e, err := dashboardPanelExpr(d, test.panel, vars.Get)
+		switch {
+		case test.err == "" && (err != nil || e != test.expr):
+			t.Errorf("%d %s: expected %q, got %q, %v", test.panel, test.host, test.expr, e, err)
+		case test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)):
+			t.Errorf("%d %s: expected error %q, got %v", test.panel, test.host, test.err, err)
+		}
+	}
+}
+
+func TestHasNewPanelExprs(t *testing.T) {
+	old := &models.Dashboard{
+		Name: "web",
+		Panels: []*models.DashboardPanel{
+			{Type: models.PanelExpr, Expr: "avg(q(\"avg:os.cpu\", \"1h\", \"\"))"},
+			{Type: models.PanelHost, Host: "web01"},
+		},
+	}
+	tests := []struct {
+		panels []*models.DashboardPanel
+		old    *models.Dashboard
+		new    bool
+	}{
+		// Moving panels and changing other panels keeps the expressions.
+		{[]*models.DashboardPanel{
+			{Type: models.PanelHost, Host: "web02"},
+			{Type: models.PanelTable, Expr: old.Panels[0].Expr, Width: 6},
+		}, old, false},
+		{[]*models.DashboardPanel{{Type: models.PanelExpr, Expr: "1"}}, old, true},
+		{[]*models.DashboardPanel{{Type: models.PanelTable, Expr: old.Panels[0].Expr}}, nil, true},
+		{[]*models.DashboardPanel{{Type: models.PanelIncidents}}, nil, false},
+	}
+	for i, test := range tests {
+		d := &models.Dashboard{Name: "web", Panels: test.panels}
+		if got := hasNewPanelExprs(d, test.old); got != test.new {
+			t.Errorf("%d: expected %v, got %v", i, test.new, got)
+		}
+	}
+}
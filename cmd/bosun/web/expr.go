@@ -42,7 +42,55 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 	if err != nil {
 		return nil, err
 	}
-	rawLines := strings.Split(strings.TrimSpace(string(text)), "\n")
+	return executeExpr(t, r, string(text))
+}
+
+// executeExpr executes the expression text, which may declare variables like
+// expandExpr, at the time of the request r.
+func executeExpr(t miniprofiler.Timer, r *http.Request, text string) (interface{}, error) {
+	expression, err := expandExpr(text, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	e, err := expr.New(expression, schedule.RuleConf.GetFuncs(schedule.SystemConf.EnabledBackends()))
+	if err != nil {
+		return nil, err
+	}
+	now, err := getTime(r)
+	if err != nil {
+		return nil, err
+	}
+	res, queries, err := e.Execute(exprBackends(), exprProviders(), t, now, 0, false, "Web: expression execution")
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range res.Results {
+		if r.Computations == nil {
+			r.Computations = make(models.Computations, 0)
+		}
+	}
+	ret := struct {
+		Type    string
+		Results []*expr.Result
+		Queries map[string]opentsdb.Request
+	}{
+		e.Tree.Root.Return().String(),
+		res.Results,
+		make(map[string]opentsdb.Request),
+	}
+	for _, q := range queries {
+		if e, err := url.QueryUnescape(q.String()); err == nil {
+			ret.Queries[e] = q
+		}
+	}
+	return ret, nil
+}
+
+// expandExpr returns the expression on the last line of text, expanded with
+// vars and the variables declared on the lines before it, like
+// `$foo = something`. Comments and empty lines are ignored.
+func expandExpr(text string, vars map[string]string) (string, error) {
+	rawLines := strings.Split(strings.TrimSpace(text), "\n")
 	var lines []string
 	for _, line := range rawLines {
 		// remove comments and empty lines before processing so comments can be after the final line
@@ -52,7 +100,6 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		lines = append(lines, line)
 	}
 	var expression string
-	vars := map[string]string{}
 	varRegex := regexp.MustCompile(`(\$\w+)\s*=(.*)`)
 	for i, line := range lines {
 		line = strings.TrimSpace(line)
@@ -65,23 +112,21 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		} else { // must be a variable declatation
 			matches := varRegex.FindStringSubmatch(line)
 			if len(matches) == 0 {
-				return nil, fmt.Errorf("Expect all lines before final expression to be variable declarations of form `$foo = something`")
+				return "", fmt.Errorf("Expect all lines before final expression to be variable declarations of form `$foo = something`")
 			}
 			name := strings.TrimSpace(matches[1])
 			value := strings.TrimSpace(matches[2])
 			vars[name] = schedule.RuleConf.Expand(value, vars, false)
 		}
 	}
-	e, err := expr.New(expression, schedule.RuleConf.GetFuncs(schedule.SystemConf.EnabledBackends()))
-	if err != nil {
-		return nil, err
-	}
-	now, err := getTime(r)
-	if err != nil {
-		return nil, err
-	}
+	return expression, nil
+}
+
+// exprBackends returns the backends to execute expressions of the web UI
+// with.
+func exprBackends() *expr.Backends {
 	// it may not strictly be necessary to recreate the contexts each time, but we do to be safe
-	backends := &expr.Backends{
+	return &expr.Backends{
 		TSDBContext:       schedule.SystemConf.GetTSDBContext(),
 		GraphiteContext:   schedule.SystemConf.GetGraphiteContext(),
 		InfluxConfig:      schedule.SystemConf.GetInfluxContext(),
@@ -90,37 +135,16 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		PromConfig:        schedule.SystemConf.GetPromContext(),
 		CloudWatchContext: schedule.SystemConf.GetCloudWatchContext(),
 	}
-	providers := &expr.BosunProviders{
+}
+
+func exprProviders() *expr.BosunProviders {
+	return &expr.BosunProviders{
 		Cache:     cacheObj,
 		Search:    schedule.Search,
 		Squelched: nil,
 		History:   nil,
 		Annotate:  AnnotateBackend,
 	}
-	res, queries, err := e.Execute(backends, providers, t, now, 0, false, "Web: expression execution")
-	if err != nil {
-		return nil, err
-	}
-	for _, r := range res.Results {
-		if r.Computations == nil {
-			r.Computations = make(models.Computations, 0)
-		}
-	}
-	ret := struct {
-		Type    string
-		Results []*expr.Result
-		Queries map[string]opentsdb.Request
-	}{
-		e.Tree.Root.Return().String(),
-		res.Results,
-		make(map[string]opentsdb.Request),
-	}
-	for _, q := range queries {
-		if e, err := url.QueryUnescape(q.String()); err == nil {
-			ret.Queries[e] = q
-		}
-	}
-	return ret, nil
 }
 
 func getTime(r *http.Request) (now time.Time, err error) {
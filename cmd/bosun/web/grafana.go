@@ -0,0 +1,372 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"bosun.org/annotate"
+	"bosun.org/annotate/backend"
+	"bosun.org/cmd/bosun/expr"
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+// The handlers of /api/grafana implement the API of the Grafana JSON
+// datasource (https://grafana.com/grafana/plugins/simpod-json-datasource), so
+// bosun expressions can be graphed in Grafana.
+
+type grafanaRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+type grafanaQueryRequest struct {
+	Range         grafanaRange `json:"range"`
+	IntervalMs    int64        `json:"intervalMs"`
+	MaxDataPoints int          `json:"maxDataPoints"`
+	Targets       []struct {
+		Target string `json:"target"`
+		RefID  string `json:"refId"`
+		Type   string `json:"type"`
+		Hide   bool   `json:"hide"`
+	} `json:"targets"`
+}
+
+// grafanaSeries is a time series, its datapoints are [value, milliseconds]
+// pairs.
+type grafanaSeries struct {
+	Target     string           `json:"target"`
+	Datapoints [][2]interface{} `json:"datapoints"`
+}
+
+type grafanaColumn struct {
+	Text string `json:"text"`
+	Type string `json:"type"`
+}
+
+type grafanaTable struct {
+	Type    string          `json:"type"`
+	Columns []grafanaColumn `json:"columns"`
+	Rows    [][]interface{} `json:"rows"`
+}
+
+// GrafanaTest is requested by Grafana when the datasource is saved.
+func GrafanaTest(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return "ok", nil
+}
+
+// GrafanaQuery executes the expressions of the targets of the request. The
+// expressions are executed at the end of the time range of the request, in
+// which $range is the duration of the time range and $interval the
+// interval of the datapoints requested, like "3600s".
+func GrafanaQuery(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v interface{}, err error) {
+	defer func() {
+		if pan := recover(); pan != nil {
+			v = nil
+			err = fmt.Errorf("%v", pan)
+		}
+	}()
+	var req grafanaQueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	if !req.Range.From.Before(req.Range.To) {
+		return nil, fmt.Errorf("bad time range: %v to %v", req.Range.From, req.Range.To)
+	}
+	seconds := func(d time.Duration) string {
+		s := int64(d / time.Second)
+		if s < 1 {
+			s = 1
+		}
+		return fmt.Sprintf(`"%ds"`, s)
+	}
+	ret := []interface{}{}
+	for _, target := range req.Targets {
+		if target.Hide || strings.TrimSpace(target.Target) == "" {
+			continue
+		}
+		vars := map[string]string{
+			"$range":    seconds(req.Range.To.Sub(req.Range.From)),
+			"$interval": seconds(time.Duration(req.IntervalMs) * time.Millisecond),
+		}
+		expression, err := expandExpr(target.Target, vars)
+		if err != nil {
+			return nil, err
+		}
+		e, err := expr.New(expression, schedule.RuleConf.GetFuncs(schedule.SystemConf.EnabledBackends()))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", target.RefID, err)
+		}
+		res, _, err := e.Execute(exprBackends(), exprProviders(), t, req.Range.To.UTC(), req.MaxDataPoints, false, "Web: grafana query")
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", target.RefID, err)
+		}
+		if target.Type == "table" {
+			table, err := grafanaTableOf(res.Results)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", target.RefID, err)
+			}
+			ret = append(ret, table)
+			continue
+		}
+		series, err := grafanaSeriesOf(res.Results, target.Target, req.Range.To)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", target.RefID, err)
+		}
+		for _, s := range series {
+			ret = append(ret, s)
+		}
+	}
+	return ret, nil
+}
+
+// grafanaValue returns f, or nil for NaN and infinities, which can not be
+// encoded as JSON.
+func grafanaValue(f float64) interface{} {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil
+	}
+	return f
+}
+
+func grafanaTime(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// seriesTimes returns the times of the datapoints of s in order.
+func seriesTimes(s expr.Series) []time.Time {
+	times := make([]time.Time, 0, len(s))
+	for t := range s {
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times
+}
+
+// grafanaSeriesOf returns the time series of results, named by their group,
+// or by text if they have no group. Numbers are a single datapoint at now.
+func grafanaSeriesOf(results expr.ResultSlice, text string, now time.Time) ([]*grafanaSeries, error) {
+	var series []*grafanaSeries
+	for _, r := range results {
+		s := &grafanaSeries{
+			Target:     r.Group.String(),
+			Datapoints: [][2]interface{}{},
+		}
+		if len(r.Group) == 0 {
+			s.Target = text
+		}
+		switch v := r.Value.(type) {
+		case expr.Series:
+			for _, t := range seriesTimes(v) {
+				s.Datapoints = append(s.Datapoints, [2]interface{}{grafanaValue(v[t]), grafanaTime(t)})
+			}
+		case expr.Number:
+			s.Datapoints = append(s.Datapoints, [2]interface{}{grafanaValue(float64(v)), grafanaTime(now)})
+		case expr.Scalar:
+			s.Datapoints = append(s.Datapoints, [2]interface{}{grafanaValue(float64(v)), grafanaTime(now)})
+		default:
+			return nil, fmt.Errorf("unsupported result type %v", r.Type())
+		}
+		series = append(series, s)
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].Target < series[j].Target })
+	return series, nil
+}
+
+// grafanaTableOf returns results as a table with a column for each tag key
+// and a Value column. Series have a row for each datapoint, with a Time
+// column.
+func grafanaTableOf(results expr.ResultSlice) (*grafanaTable, error) {
+	keys := make(map[string]bool)
+	hasTime := false
+	for _, r := range results {
+		for k := range r.Group {
+			keys[k] = true
+		}
+		switch r.Value.(type) {
+		case expr.Series:
+			hasTime = true
+		case expr.Number, expr.Scalar:
+		default:
+			return nil, fmt.Errorf("unsupported result type %v", r.Type())
+		}
+	}
+	tagKeys := make([]string, 0, len(keys))
+	for k := range keys {
+		tagKeys = append(tagKeys, k)
+	}
+	sort.Strings(tagKeys)
+	table := &grafanaTable{
+		Type: "table",
+		Rows: [][]interface{}{},
+	}
+	if hasTime {
+		table.Columns = append(table.Columns, grafanaColumn{Text: "Time", Type: "time"})
+	}
+	for _, k := range tagKeys {
+		table.Columns = append(table.Columns, grafanaColumn{Text: k, Type: "string"})
+	}
+	table.Columns = append(table.Columns, grafanaColumn{Text: "Value", Type: "number"})
+	row := func(r *expr.Result, t interface{}, value float64) {
+		var cells []interface{}
+		if hasTime {
+			cells = append(cells, t)
+		}
+		for _, k := range tagKeys {
+			cells = append(cells, r.Group[k])
+		}
+		table.Rows = append(table.Rows, append(cells, grafanaValue(value)))
+	}
+	for _, r := range results {
+		switch v := r.Value.(type) {
+		case expr.Series:
+			for _, t := range seriesTimes(v) {
+				row(r, grafanaTime(t), v[t])
+			}
+		case expr.Number:
+			row(r, nil, float64(v))
+		case expr.Scalar:
+			row(r, nil, float64(v))
+		}
+	}
+	return table, nil
+}
+
+var grafanaSearchRE = regexp.MustCompile(`^(tagk|tagv)\(([^,()]+)(?:,\s*([^,()]+))?\)$`)
+
+// GrafanaSearch returns the metrics containing the target of the request.
+// For template variables, the target may also be tagk(metric) for the tag
+// keys of a metric, and tagv(tagk) or tagv(metric, tagk) for tag values.
+func GrafanaSearch(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var req struct {
+		Target string `json:"target"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	target := strings.TrimSpace(req.Target)
+	var names []string
+	var err error
+	if m := grafanaSearchRE.FindStringSubmatch(target); m != nil {
+		switch {
+		case m[1] == "tagk" && m[3] == "":
+			names, err = schedule.Search.TagKeysByMetric(m[2])
+		case m[1] == "tagv" && m[3] == "":
+			names, err = schedule.Search.TagValuesByTagKey(m[2], 0)
+		case m[1] == "tagv":
+			names, err = schedule.Search.TagValuesByMetricTagKey(m[2], m[3], 0)
+		default:
+			return nil, fmt.Errorf("bad search: %s", target)
+		}
+	} else {
+		var metrics []string
+		metrics, err = schedule.Search.UniqueMetrics(0)
+		for _, m := range metrics {
+			if strings.Contains(m, target) {
+				names = append(names, m)
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if names == nil {
+		names = []string{}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+type grafanaAnnotation struct {
+	Annotation json.RawMessage `json:"annotation"`
+	Time       int64           `json:"time"`
+	TimeEnd    int64           `json:"timeEnd"`
+	IsRegion   bool            `json:"isRegion"`
+	Title      string          `json:"title"`
+	Text       string          `json:"text"`
+	Tags       []string        `json:"tags"`
+}
+
+// GrafanaAnnotations returns the annotations in the time range of the
+// request. The query of the annotation of the request filters them, like
+// Category=deploy,Host=ny-web01.
+func GrafanaAnnotations(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if !schedule.SystemConf.AnnotateEnabled() {
+		return nil, fmt.Errorf("annotations are not enabled")
+	}
+	var req struct {
+		Range      grafanaRange    `json:"range"`
+		Annotation json.RawMessage `json:"annotation"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	var query struct {
+		Query string `json:"query"`
+	}
+	if len(req.Annotation) > 0 {
+		if err := json.Unmarshal(req.Annotation, &query); err != nil {
+			return nil, err
+		}
+	}
+	filters, err := grafanaAnnotationFilters(query.Query)
+	if err != nil {
+		return nil, err
+	}
+	a, err := AnnotateBackend.GetAnnotations(&req.Range.From, &req.Range.To, filters...)
+	if err != nil {
+		return nil, err
+	}
+	ret := []grafanaAnnotation{}
+	for _, a := range a {
+		ga := grafanaAnnotation{
+			Annotation: req.Annotation,
+			Time:       grafanaTime(a.StartDate.Time),
+			TimeEnd:    grafanaTime(a.EndDate.Time),
+			IsRegion:   a.EndDate.After(a.StartDate.Time),
+			Title:      a.Category,
+			Text:       html.EscapeString(a.Message),
+			Tags:       []string{},
+		}
+		if a.Url != "" {
+			u := html.EscapeString(a.Url)
+			ga.Text += fmt.Sprintf(` <a href="%s">%s</a>`, u, u)
+		}
+		for _, tag := range []string{a.Host, a.Source, a.Owner} {
+			if tag != "" {
+				ga.Tags = append(ga.Tags, tag)
+			}
+		}
+		ret = append(ret, ga)
+	}
+	return ret, nil
+}
+
+// grafanaAnnotationFilters parses a comma-separated list of field=value
+// pairs into annotation filters.
+func grafanaAnnotationFilters(q string) ([]backend.FieldFilter, error) {
+	var filters []backend.FieldFilter
+	if strings.TrimSpace(q) == "" {
+		return filters, nil
+	}
+	for _, pair := range strings.Split(q, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("bad annotation filter %q: expected field=value", pair)
+		}
+		field := strings.TrimSpace(kv[0])
+		switch field {
+		case annotate.Source, annotate.Host, annotate.CreationUser, annotate.Owner, annotate.Category, annotate.Message:
+		default:
+			return nil, fmt.Errorf("unknown annotation field %s", field)
+		}
+		filters = append(filters, backend.FieldFilter{Field: field, Value: strings.TrimSpace(kv[1])})
+	}
+	return filters, nil
+}
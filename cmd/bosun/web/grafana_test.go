@@ -0,0 +1,67 @@
+package web
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/expr"
+	"bosun.org/opentsdb"
+)
+
+func TestGrafanaSeries(t *testing.T) {
+	now := time.Unix(100, 0)
+	results := expr.ResultSlice{
+		{Group: opentsdb.TagSet{"host": "b"}, Value: expr.Number(math.NaN())},
+		{Group: opentsdb.TagSet{"host": "a"}, Value: expr.Series{time.Unix(2, 0): 2, time.Unix(1, 0): 1}},
+	}
+	series, err := grafanaSeriesOf(results, "q", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(series)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const expected = `[{"target":"{host=a}","datapoints":[[1,1000],[2,2000]]},{"target":"{host=b}","datapoints":[[null,100000]]}]`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+	series, err = grafanaSeriesOf(expr.ResultSlice{{Value: expr.Scalar(1)}}, "q", now)
+	if err != nil || len(series) != 1 || series[0].Target != "q" {
+		t.Errorf("bad series of scalar: %v, %v", series, err)
+	}
+}
+
+func TestGrafanaTable(t *testing.T) {
+	results := expr.ResultSlice{
+		{Group: opentsdb.TagSet{"host": "a", "disk": "c"}, Value: expr.Number(1)},
+		{Group: opentsdb.TagSet{"host": "b"}, Value: expr.Number(2)},
+	}
+	table, err := grafanaTableOf(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const expected = `{"type":"table","columns":[{"text":"disk","type":"string"},{"text":"host","type":"string"},{"text":"Value","type":"number"}],"rows":[["c","a",1],["","b",2]]}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestGrafanaAnnotationFilters(t *testing.T) {
+	filters, err := grafanaAnnotationFilters("Category=deploy, Host = ny-web01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filters) != 2 || filters[0].Field != "Category" || filters[0].Value != "deploy" || filters[1].Field != "Host" || filters[1].Value != "ny-web01" {
+		t.Errorf("bad filters: %v", filters)
+	}
+	if _, err := grafanaAnnotationFilters("Id=1"); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -3,6 +3,7 @@ package web
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"bosun.org/cmd/bosun/sched"
 
@@ -42,3 +43,22 @@ func ListOpenIncidents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Requ
 	}
 	return summaries, nil
 }
+
+func ListOpenProblems(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.GetOpenProblems()
+}
+
+func GetProblem(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	id, err := strconv.ParseInt(r.FormValue("id"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse id: %v", err)
+	}
+	p, err := schedule.GetProblem(id)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, fmt.Errorf("no problem with id %d", id)
+	}
+	return p, nil
+}
@@ -21,6 +21,7 @@ const (
 	canSilence
 	canManageTokens
 	canOverwriteUsername
+	canEditDashboards
 )
 
 const (
@@ -43,6 +44,7 @@ var roleDefs = &roleMetadata{
 		{canSilence, "Silence", "Can add and manage silences"},
 		{canManageTokens, "Manage Tokens", "Can manage authorization tokens"},
 		{canOverwriteUsername, "Set Username", "Allows external services to set username in api requests"},
+		{canEditDashboards, "Edit Dashboards", "Can create, change and delete saved dashboards"},
 	},
 	Roles: []bitDesc{
 		{roleReader, "Reader", "Read access to dashboard and alert data"},
@@ -13,6 +13,21 @@ func TestWriterRole(t *testing.T) {
 	if roleWriter&canCreateAnnotations != canCreateAnnotations {
 		t.Error("Writer should be able to create annotations")
 	}
+	if roleWriter&canEditDashboards != canEditDashboards {
+		t.Error("Writer should be able to edit dashboards")
+	}
+}
+
+// TestReaderRole checks that readers, who can view dashboards, can not run
+// expressions, so dashboards execute the expressions of their panels with
+// DashboardExpr instead of Expr.
+func TestReaderRole(t *testing.T) {
+	if roleReader&canViewDash != canViewDash {
+		t.Error("Reader should be able to view dashboards")
+	}
+	if roleReader&canRunTests != 0 {
+		t.Error("Reader should not be able to run expressions")
+	}
 }
 
 func TestRoleParse(t *testing.T) {
@@ -31,6 +31,7 @@ func SaveConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	if err != nil {
 		return nil, err
 	}
+	schedule.AnnotateConfigSave(data.User, data.Message, data.Diff)
 	fmt.Fprint(w, "save successful")
 	return nil, nil
 }
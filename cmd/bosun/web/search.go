@@ -6,6 +6,8 @@ import (
 	"strconv"
 	"time"
 
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/search"
 	"bosun.org/opentsdb"
 	"github.com/MiniProfiler/go/miniprofiler"
 	"github.com/gorilla/mux"
@@ -136,3 +138,34 @@ func TagValuesByTagKey(t miniprofiler.Timer, w http.ResponseWriter, r *http.Requ
 	}
 	return schedule.Search.TagValuesByTagKey(tagk, since)
 }
+
+type cardinalityResponse struct {
+	Limits  conf.CardinalityConf
+	Metrics []search.MetricCardinality
+	// Total is the number of indexed metrics, which may be more than the
+	// number of metrics returned.
+	Total int
+}
+
+// Cardinality returns the metrics with the most series and the configured
+// cardinality limits. The optional limit parameter is the number of metrics
+// to return, defaulting to 100.
+func Cardinality(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	n := 100
+	if v := r.FormValue("limit"); v != "" {
+		var err error
+		if n, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("could not parse limit: %v", err)
+		}
+	}
+	metrics := schedule.Search.Cardinality()
+	res := cardinalityResponse{
+		Limits:  schedule.SystemConf.GetCardinalityConf(),
+		Metrics: metrics,
+		Total:   len(metrics),
+	}
+	if n > 0 && n < len(metrics) {
+		res.Metrics = metrics[:n]
+	}
+	return res, nil
+}
@@ -15733,40 +15733,40 @@ ynVAe1gblV/Il1Vj8q9kxl3Nf5rdW5qKUSdkaRqV3v8JAAD//7n7cuC9JgAA
 
 	"/js/ace/mode-bosun.js": {
 		local:   "web/static/js/ace/mode-bosun.js",
-		size:    5252,
+		size:    5262,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7RY3VPcOBJ/Zv4Kr447xowx93oQQuVzd2vJbiok93DjSaKxe2wtsmQkGZilub/9qmWP
-scGTj9vaKrCkdn/8utXdGounEGewEgqmjKdwWOoMDpfa1upTIfJCirxwn0wtwbJozgxc1sIAixjcVNo4
-yyJW6qyWRCJxKZaHWlftyitzcOMe6VpEwapWqRNaTVulUdDqjIJGZRjcTlhtIbDOiNSx48nkiptA6yo4
-CVqhKYvjzmh47Bnew437aWPvHZkb8I8DCuPHYq3B5xSNRwo7+ARzskOMudRLLuklSwtIL16TUVDpGp3N
-lj9p6zA3vCqEA7+QOreO2+KV5NaJlGgWC+eqM2EdKCy0dYqXgAYkX7dEW7rKS9PkgwXjOWjxllt7rU2G
-UHIhXxtdonXcwWshASuhcv94WRtOuFHpcwlQ4VLq9AKyt7X7+a1FLqW+7la1ulD6Wr0vDNhCywydKOGZ
-yl5yR6hspZWFM1EKhxa4SYtzoVLoxKCsJHHayxpkWqAttHEf3p39Ak1I/g3GEhToB4ArpQn2ICoZrHgt
-3btavboCs0YDmbA+DEKtZH3Tm3YxaZZdVJrl+7PzzUyUoGuHklS9FIbyy2+jUM8kGPcLrEnOb2fJU6PR
-bfxJjXB4zY3CDCpQme08pDe/aidWIm2izILZZIcR74DcRghr9bsWCrLfLlDkShv40L6QOseS35zpvMsi
-1uHrq+rD9BuPlU80cFgZoRymWjlQ7v26AlRw4/wekuNLna27LSKhbpHD/dzrPK+Xv0N6TzS1+k098/lv
-MTe6rjaL1rE3Qv1I5HPxBzzKInY82VlpE0zJG+4FA70K5uxHcCxib7Wl4bnO1ixir3r22SK8nezsbAnA
-7CRg2Khjs2Y8/gp3i+w72d/U0om+TOeMW1fQuPIsvWBRwF5IbYEmr7XJyTs/S6Gjv61N7ie/aufHl1Tr
-kLUM7AVXKchm1Xj/He7PCA8BvJvQX5M8m03sJ45t4utTolcH55A+SLH5g+KItmRjNGJoEVOuTxlSo/7U
-2Ei1Wr2uVepRcNKMUuuLumqHczACbAdp0z47kQ3hOVdZ11w7duoxHeuSWNKCqxww1bVymInVCi9RX4FB
-W4iVIy2d8LIW0gl1j25pkV/lmL5ohDED6ktXjZrM6GqptfSTvHmCHxqSbBaKI1Q6LXAlpAODK2Gsw5U2
-kHLrpEFqeihBofRdVQoF0lAnwBIywRWWYHLAUihUV1iBSUE5avAGmoPAB6xxB63vxlYbh9YZ4Bdo6xId
-Op1hrXzddu5uDqPOX2np9EBpfbA6vrZXd2xguZQIlqILDS+CzbiQawSbO/8ABCtUJlKwCFb6h/MPeqMN
-AjVQQxIGcripELz1TcuDm8psLM67nImG+RDd73c02L1o6Fw08KGXlZPJjiuEjXdNe8ZTuTHruHEsOArm
-VHy+AnecvgB1FLCLJrVZ5Kke+lHAPk5ZMOt+DcwCFrYM1H6PApZqZesSzoQCRvS76LHmK24EX0qIhbKO
-esADG/PdxVDpRmCbxvk92IDZiqe+23RSfWLFDahY+sGxxUPnmjJV/eOsrdnhMRlOk8TOwun8gB/88ezg
-P/88+Ff8aTHz5HA2nd8u2riMou3QjMFukmQr6IeYyeJ+OAXlzDpsF/F+A+RP4Whpsa7AcKdND9s4hPZ3
-wgbEicfx/1qHy5pLu8USpeDjDk7J2NneapfRL26VD1Nuj+0NEu7ysuX6diUBJe7egqDtTU+PpqdHSZIk
-cYjT06P5xz1Ct+dfEnkRhvunnkYi32xgb/554dV9XuyH88+LvbsoODwMrFC5hID66XZFgW+uwVHgTA1f
-ULu7FwUUBfJn+bUwZDqNU12WVEtDnYcfE7v/t3j/cEyMmoTjysWqLsGI9GH9zw4Wp3MqqcUM2sl+kixH
-A/U4TQe6kmSGSXKASbJP/81wSP80/B2fPMGnT/Ef+AMmCWKSfMT/4hN8ik9O8OQpnpzgDyf45CmejNoe
-1OXDXEiS+fR28QU5s01uEd6Nys2ZrSv6jIw3H2hfbQ6UcfcHjK+Q+XQRDrSn3MLPyoKywokraDLE7/hk
-Z8crHHT10cOizzCEEO/vDpt5c+Z0GdUY6ApuVPt9J++rPkx2k9vkenaHyW5yPUuWh83rqrbF40Mj+P4u
-0Efairffau+HWjxL48jyi46MV3VXe5979oMOwLe3h3i2N9y3LgZfDGvvHKZ2siEPa7I55z4lSbaYEeDp
-Yh73afNwEZ6yMfyj8d9i+1q4IlganoIdmL89HVq7O92aVK3zd8eTu+PJROsqFqoAI5ydjtx2RCNXKuHx
-ZNLe18TjFyQj1OPJHcltv3P6M3dM/fX4BdZffen0Rmfw+KqJhTG9OP7CXVJPYhx5GI9G06tsrQ7uo5rf
-sd+4JQ8zgBRGnUO0YdMR5bsi819Fw/2jHY5TLqXXEldGO00fof10aQE3QaGE+F8AAAD//yz3Dm2EFAAA
+H4sIAAAAAAAC/7xYX1PjOBJ/Tj6FV8ddYmKcez0yDDV/d7eW2aUG5h4u9jCK3bG1yJKRZCBLc5/9SrJj
+bHCYmduqrQJLavefX7e6W7FoAmEKayZgSmgC80KmMF9JXYmLnGU5Z1luLlTFQZNgSRRcVUwBCQjcllIZ
+TQJSyLTilmTFOVvNpSxJ8KDMwK15oisOvHUlEsOkmDZKA6/RGXi1St+7G5NKg6eNYokhi/H4mipPytI7
+8hqhKQnD1qi/cAzncGt+2tr7aM31+IcB+eFTscbgaxuNJwpb+BbmeGQZMy5XlNuXJMkhuXxvjYJINmh0
+uvpJaoOZomXODLgFl5k2VOfvONWGJZamMTemPGHagMBcaiNoAaiA001D1IUpnbSdfNKgHIddnFKtb6RK
+EQrK+HslC9SGGnjPOGDJROYebytFLW4U8owDlLjiMrmE9LQyP59qpJzLm3ZViUshb8R5rkDnkqdoWAGv
+RPqWGotKl1JoOGEFM6iBqiQ/YyKBVgyKkltOfVUBT3LUuVTm08eTX6AOyb9BaQsFugGgQkgLuxeVFNa0
+4uZjJd5dg9qggpRpFwYm1ry67UzbmNTLNir18vzkbDtjBcjKILeq3jJFFs02MvGKgzK/wMbKue0saKIk
+mq0/iWIGb6gSmEIJItWth/bNr9KwNUvqKBNvNh4Ry9sjNxHCSvwumYD0t0tkmZAKPjUvuMywoLcnMmuz
+iLT4uqq6MN3GY+kSDQyWigmDiRQGhDnflIACbg2axvGVTDftFlmhdpHBw9zpPKtWv0PyQFSV+E28cvmv
+MVOyKreLxrEPTPxoyWfsD3iaRYqaOmvIYjxaS+VNrV/UqfDk2luSH8GQgJxKbYfXMt2QgLzrICGxfzce
+jXaEYnbkEazVkVk9Lr7C3WD8TvYPFTesK9M6YzYl1K68Si5J4JE3XGqwk/dSZWCaWQIt/bRSmZv8Ko0b
+39qqh7RhIG+oSIDXq9r773B/ZvFYgPdj+1en0XY7uymk6/i65OhUxBkkj5Jt+ahMgh15GQwYikOb9VOC
+tmVf1DYSKdbvK5E4FNRqRi7lZVU2wxkoBrqFtG2krciW8JqKtG2zLbvtNi3ryrIkORUZYCIrYTBl6zVe
+obwGhTpna2O1tMKrinHDxAO6lUZ6nWHyphbGFGyHuq7VpEqWKym5m2T1E9xQk3i9EBShlEmOa8YNKFwz
+pQ2upYKEasMV2vaHHARy1185E8CV7QlYQMqowAJUBlgwgeIaS1AJCGNbvYL6SHABq91B7fqylsqgNgro
+JeqqQINGplgJV8Gtu9tjqfWXa3uOINcuWC1f07VbNtCUcwRtows1L4JOKeMbBJ0Z9wAEzUTKEtAImruH
+cQ/7RioE20qVlVCQwW2J4Kxvmx/clmprcdnmTNDPh+Bhv4Pe7gV954KeD52sHI9HJmc63FPNaW/LjWhD
+lSHeobe0xWdJo5GRlyAOPXJZpzYJHNVBP/TI5ynxZu3vgplH/IbBNuJDjyRS6KqAEyaAWPp98FTzNVWM
+rjiETGhje8AjG8u9uK90K7BL4/IBrEd0SRPXbVqpLrGkCkTI3WBI/Ni5ukxF92BrarZ/YPrTKNIzf7o8
+oAd/vDr4zz8P/hVexDNH9mfT5V3cxGUQbYtmCHadJDtBP8ZsLe77UxBGbfxmEe7XQP4UjoYWyhIUNVJ1
+sA1DaH4xbEEcORz/r3W4qijXOyzZFHzawW0ytrZ32iX2t7fI+ik3IZNewl1dNVzfrsSziTuJLbTJ9Phw
+enwYRVEU+jg9Plx+nlh0E/fSkmPf3z92NCvyzQYmyy+xU/cl3veXX+LJfeDN555mIuPg2X66W5Hnmqt3
+6BlVwTNq9yaBZ6Ng/Vl9LQypTMJEFoWtpb7O+edI7/8t3J8PidkmYagwoagKUCx5XP+zg/h4aUsqnkEz
+2Y+i1WCgnqZpT1cUzTCKDjCK9u1/Pcztvx3+ji9e4MuX+A/8AaMIMYo+43/xBb7EF0d49BKPjvCHI3zx
+Eo8Gbffq8nEuRNFyehc/I6d2ycX+/aDckuiqtB+U4fZT7avNwWbcwwHjKmQ5jf2e9oRq+FloEJoZdg11
+hrgdH49GTmGvqw8eFl2GPoRwf6/fzOszp82o2kBbcIPaHzp5V/U82ovuopvZPUZ70c0sWs3r12Wl86eH
+hvf9XaCLtBFvvtrO+1ocS+3I6llHhqu6rb0vHfteC+Db20M4m/T3rY3Bs2HtnMO2nWzJ/Zqsz7mLKErj
+mQU8jZdhl7b0Y/+YDOEfjP8O2zfM5N5K0QR0z/zdcd/a/fHOpGqcv1+M7xfjsZRlyEQOihk9Hbj3CAYu
+V/zFeNzc3ITDVyUD1MX43srtvn36M7dNJHik7C+/fvogU3h66UT80L5YPHOr1JEYRu6Hg9F0KhurvZup
++nfsN27J4wywCoPWIbth0wHleyx1X0X9/bM7HCaUc6clLJU00n6EdtOlAVwHxSbE/wYAk2gVeI4UAAA=
 `,
 	},
 
@@ -21572,490 +21572,529 @@ rW84lvhBpLIYXuBt0AANun2s92afrt6bj7Slx5SZFYhY/78AAAD//zo639oEkAAA
 
 	"/js/bosun.js": {
 		local:   "web/static/js/bosun.js",
-		size:    153080,
+		size:    168447,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/+y9fXfbNrIw/vfNp0C42ZCqZcpOm27XjtJfmqSb3Nu03djp3j6KH19IhCTWFMEAkGw1
-8Xf/HQxAEiQBkrLd3Tz3bM9pLJKDwdtgMJg3jEYj9ISROWEknRGUYbEceyu6IqkIo1BwD42e3msB2o/W
-DIuYpvtzyla4UugtyRjhJBUc4RThtVgiQS9Iem+DGTqVv9AYBfN1OpMIUDBAH+8hhFDxBmCK1/I/sYx5
-+ILwGYszABkjzzuufn5LE4LG6KD2+h0nzAC/hn8ZEWumKzq+dx0MBsf3RqMVETjCAiM8pWuBMOJxukgI
-YhIzZSgjbBVzHlPVle9i8YYI3NEZDVV8qDRAfyybgJMEquOjsjKO5pShKeVrVa/s6Asy5x0V52D2mvOv
-ZdXohBC0ohFJ+ChOZ3EkaWFB0csNSQUKZjj1BZoSROB5SRhBUzLDa07Qf56gNScciSUWA2jja41AFW5v
-aAU2iElj4n/ByVpObUzUz9oMv7zKmPoqf9U+nggs1lx9Vr9rAKfxSuOWv+rEk5INTtZYkEjBGC8s9FTp
-ST6ycjiepSkVWFNu21iUgAEeogUR5mBIUsPo0yf08brWzteyeVj++fSpuTLeEM7xggBI/tsGdyIwEy+w
-UJDlkw32ZRoVkPlvG9xzRqA7ehni6gtbiXcsAUD519pKumYz3UT1U0LB8thfx3XoV5QLgIUfNnw/Xaa6
-aerXp0/o/oII9PChHH94FwzsfcOCLCjbqn7lDwakIo5yTsOMUUHFNiMhJ0LS27vT52iMmgQh/5OEk9JL
-NEaK5waDcC1mwSBULDcQ8Yp8Dz8HLROZ0kvn1BXfND21tXSXZv5hDdTrrGynucjeEr5OupiNAgqYk8mw
-Nh7DDBZTZaeAtrLkZ32W+0wt9UZriuUif3QvZztPwzaWdrrN9LdtVv/2guAoiVP1PX9oEH06I0lCIk31
-+qkG9f06SeZxAVY+WsZODULnRlTZVyQrJz33FYANYt4YZOCa8NdGnuob/GzSp/r4Mq2XfJYQJv6LbNX3
-/MkGZEAcO7Y73rrdcdt2BzuP3O5ScomeMYa3gbH24jkKCiBzOGDcKEOBHOPzWIpRQ3SOdTUAfizfP0Hn
-OExIuhBL+by3V0eSMwTZ/nM8OY/PjhvfjYaG2ZovA9nWqhBABoNquet7zV9qLGGunT1WJI8XXHVF/moM
-h0bhHo+pHo+ZnjMFfyw/PEHns3JApu4BkWN5PpucT10DorGWI1Lwhr5DcbKe/kZmOd2qh9pI/EhI9Gx2
-oUD0Q307zEA+17/a5SHelIdKPrFmTC++tR79yqsa+D8o4xVg40UN9AfMxbNpKjeWxCzRfN9SMBf7mm9r
-hX5mZBPTNX8ddS0rA9JNS5GmJV25UeZYfnyCzklJT5GbnmKJ6JxMziMXQRmoFVHFUV9K+pFciZw5qt8m
-267wVUNQeM1/JmkUp4vnCeVuecHOZ8w10I/VwKrKN1kbr5HTogDKje3+GK3TiMzjlERSwrufQxgb1qdP
-Gm+5uw1s06C3L8FMFu0aVw08xwknNpGmMqimHAEv/sboOuvY7krAgC8ae50c2o2cFL7Qv10HJb6wH5Tq
-C5ovWhf0SZyQdAZ8QmLUT/VzC3/JGGUKRj8cu7iaRGPlavl+Krtm2U+NPTmHsOzJC6q/L2h1ZevxIHUS
-KEaNaL5QlTeKYseuVfZ8GScRA27rZCtykDVYv/3aKLDbjj3r2rFztOUOZVDcrGuXqkh8ZUGT0uFFl1ZD
-AQVNAi+3NudgLnKgfkNZgO82kHzRNZIarXUg+WIH0ecipZcJiRawylq6bUL2E3OqZXYTddQAtMs6Je4b
-jkKFnhRR2Lkm7802ecAXzUNCgUQ2sYTT7weWk9ez4vgq4Yw39TMSjpM4XQBD4hq68q4hfM3kxhoBl8wL
-VF8et601Q9dn0evidLFOMOvQ/mqofUbXgvSE5TiNRfx7F/iUUsEFw1kH3G8f1oRtO4DkJs/4jDLSqc6W
-58wcRJIOaJKeZXK7zcdkRaN1QgI//+QP0QTG2E8Xb+VI+EP1CADPaSoYTRLCeP5+tZgxgsN0cSI7aH8b
-CkoTERdf08WJHrj8zToO8YyU32dJnE0pZpE/vHc2OL6XNy+c0XQeL4KJ/wDm6WdGN3FEmD9E/oOEzkBz
-Unm5FCIzXpTLpYpgiBrFh6hS2Fw+DdhwKVbJ4zc0IkGVc5AUTxMSHYEsNbxXFbI+rGNGvsOcHCnpqWQE
-xuKTE3e5hL20bPx6iFidSVU7FMoyCs7Ypsuf8Nkf+cP63h+LhBwh/wXmy3wGKt/JKkuwIO9YcoT8DDMR
-44SPohwcRqJWZlaQjYn4uWCJb+2yblssyIo7G/hafe3TOEDU2TBA2NkocpUxZ5teXmWMgGWjX8Mkss52
-SaTdzQIm6W6Y/tyrUQDb3SwJ1tmuBcPZ0tmsv6mvfVoFiDobBQg7G7WkXDjbBNr0X2Jy2a9dEldnsyRO
-aFWdAyQURz+lJwSz2bKNCeiGc3XWcLb9JP/ep+UaWWfjNdLOUVWs2dm25/BZ21b7tVBh7GygwnwX46sO
-x84uPJv1b7s+Z3e1XaHsptiYC8q27pbBOfFVDtWLcBVwN+0quM4mZmvRspkIjF6mom/bsnX3mvp5LTrb
-hAtrinvkDJBe81oakjrntgDt3ur0Adu92xUAvTa83LbQuedpwM4GgpsDb1kZM8K58jpwbTNHyHsCaPaT
-mIunT0bGg9dd9yiVLNlR/4+gVS7b0NKElFzuA8anT0blb3sDaiIVFUvCLmNeF/IYiWJGZuKUHiF/ZB/H
-iiwZxqkgbEYyIXdaOCYagumHumSnzzvNM6kvZUjChX9kyIaKa9qOsHr6gacSLI8RE/+/99/EaZwxOo8T
-wvwzNEa+lFX9Y2tx3RSFpQlyXT3ZHjeG4roizbN1KkX5XJoGgZ1RKk5mNCNVaT2HGaISoiKSF2/DBzQN
-9Png+RKnC3KyBtKoIARfjyGaKT3fEGVap2yRqnO8QGxonJcJH6g61PtjVym+pEwkcXohBfhSR9oYlOKA
-ZhyyXAe1yjkMTYoxNd6H5ToP/O/gI2yQaOI/4Hp49SAV5yT48aExCz5ei+UJYZu4EC2MiQFkQ71U9Jlp
-iB58MCdqiJ6VKCqzxv+AGVM4uaBZICl5cGxfkAoM59rjsqJNHWM8R8F9Pdm6+hatebpOEpeGJ8dWRRZK
-MYVEpyUzR+Oxwc59tIc2aA/5ip+31P0Rqf6o86a5AJvtsDb3ujFAcRqLyvBwIkScLpzjjjfkpTr1ojHK
-gcOT8vWxrZjeV21Fn1U/WYt/WMdEmIX+Ll9YQTeEcWVaKYB/Ua+s4DQjqeDRtGxYFU/4Bv9GGbo/Rgfo
-4cPGxzjVH63IyRVeZQkpz41mq17WP1pRqN3RMmyn5gf7mK/FEo3NtVkFMz6Er9NYBOWUrMVSYx6WNb7j
-hKV4RYxXb2lCuPH8c+n7N2ijut84TdsXpSbf/zz56ceQCxani3i+DTZDIOgh8hHyW2uYCop71UDSGY3I
-u7evn9NVRlOSikCWDTaDVvyq2E1r2LTiZuTD+ZzR1fmqgn9lMySyQsGLs+VbJS8ENRW0hPug4f6+Jmwb
-wE5Vg/oQrohg8QyN0ar6hYUf1oTFRMsyH2oFdTdZW5cynJLkeYI5rzIbMMABs5/HVzbGrL6g8XiMNjSO
-0MEAfUT5S+QB3n3vuMb8+GUsZsscv42jzjAnyJuxWMQznHhHeS806j3kRXKnYt6xo+g6vUjpZWorGadz
-6ix3iVkapwtbufyTq6gy9dtKcrWhOkuC0menTkZkjteJsBVRXzynmaMx+RucrImc+I/Nb5xoC2dJFBdk
-O0RQxkYQG+VkNDbs4bb5jUhCBKm2YHJBtmdteyZJOLHgaiJBY9XA/mOwsPbTwTHaWn1d1R3z2ZJI0fH7
-OBGmw13BSuaM8GWl3jmA2pgJbH8fwojMCaszkWpFEmGtxspuH6+wIDY+lMkqpBApR0Se4bN4hMFo9K1C
-OJbikIVlqq/KSXUwaMxRqJeAcdKKsMAD93TmHdKM0TSnQcljV0ERrwhOo0iZyiSs3VZWkGLICKfJpjEe
-15ZuwEo1OkEYs5J3yMhvZCbgex1r9TmbePM4xUmy9c4CQ3q2c/EozBhdxZVzTPlzNEIn8e8E0TkSS4IS
-uqAoTlFwGUdiiXAaoSWJF0sxyCHgaFLAyTcp3kwxq9Lw72iMHj2uEjZl8QKN0V8ODqrvE4lfHmP/9NUU
-P4r+6lc/R5hdwNfD+eNHf/269nUFMpT/py8ff02mjY/KCZf/jkZQe/XrdMFwBO1EXwBo9fMsZrMEmNyk
-MqyTw8cHQwT/yKadVTUXk8etX+EDgECvrYWtn89qTGIjhzL6MuQkkUTj/0nOiF8lvxBnGUmjwOebReOT
-ECzw1dz6Q8R/t34HKlCfy/r5ZqGrfZYkgc/ITITTRgVyFQWTSdkVNIF5eKQH5qwGT1IhWZS9A7IOew9m
-UgKRZ96po4tGFzpGQDbOCsOu/KGiFvvnbevneZwklXOx3N2KxTk5PDuusw1dcNtS6gBKOeckw2IZRjFe
-0TSyT0xOiDtNg0RrH+Wo0dbGPiFXkv8Gyc0g0gtOHpHhxeHjg8YaLGypcoke2L9ztDdGPkoAyWWB7rIF
-an9nMP3z//hW9spNbUXL/K+InTy4YPQCtDaXy1gQvwVoP6flw5xjdaxKK0Zj/r+8CRH0WIstPcm7ED5u
-X47+4cHBn/22AW2r5cq9dHI6als+ivVbB07vCrsMmB2bHrKr1kVuNNZaetvKWDpKM3+Ivn4UFrR0A+b1
-yMm8bkLWjyxkLTmAYDjlsaz/hTZHSjHicU2M0CLqc7qGML+DpuZQATi9gSGuzUCyt2dx4q1UMkaHVlGO
-PrOLy9bjRNEYo5hNn2lW7dYoth2GpLiwXM/nCSnIuMYBeyyDxlKoUMcQxQaBxMdWibicz8CGW89x0Jx2
-C/StltHtl9KuS6U23iqeja5FUEz+0ELuVsddQ/CvkDROEhv94CSp6VzgjTZxWFTeFjz1FYIaalLL6bux
-bJ5C9a2I9/d7LBwQKpYU/K0fBP6fCsONP5DyUWOg5OeaW1ZDJV47x+oy/mCHw6jsLxwh48hl0wO0odZ7
-IA1st985DFNNT38LTbn72WwMJ+IEVn5M07c4XZAATjDQMu1qO7BXeD3oNCs2daOFBU3waCoP2So4U4qI
-v/7666+jN29GL17sv3p1tFodce4f38sTAihVVQFdLV6AJXSGExL4pPQjYCTBIt4QOThHZgzIWqwZOUJe
-nKI/c688cWWYiyPk/Znv4wU13nP5MjIhV/BmZb5pvlrCm6X5pvkqgjeR+ab56g28Sc03zVdbeLM13+Sv
-tGOunJWCQtg6Ie9YEuCLIZozupKjlBMNnN0zkn7HMDi144swTiNy9dM88D56em7hxJxQTmxQ1yYU6IZ+
-xCos9CLk6ykXTFJbUYcBnDsCmLBxugjK9uyhw6FRs1F2DdHbaiHL/n3ro71iNHxohks1VbRxYBZ5KEfG
-qc3So5ZHHA+qRfOOnC8YXWcuJDnUoBKQs2bJ8b3rcrKUMf9/03SNRkhy3qPRCMzi2pHsWzVHS5wxerUN
-OWEbwsKIXqZCDnS6hQmRy3/86ODw6/2Dv+wfHjzMx2P86PDPXz47+LJBDxr5nVADVN6TIjzJ2fbfvNl/
-8cIbNFFBm/uiAs7oDTrohBHYUOlFTAJl54M9RzL2LTfphVxlMSP6KKs2sC2vR9ZHZXzRi5pwKz/l0fkB
-PCz0wwDtKWzoC/ToK/QF+vog/+fw4ODANMnpRqAx8o7zh7GH9hR2Qd+dPj9R5DQwgwtqKn4DSyX1QURn
-a9gbZjAeaIwIn+FMDYxspQd16ZfaWLFXoNuTjQI//ZFXGWRGcGQMsTmq8vnl3601GasQSxGg2riQZ0ks
-Av84t4gWkTEQFHSMYvQEzcoYoFoIUB5DNcMTM/DnchknBAWzcLbE7JkIDgYgEPqoJuFDUWPxygXbFAEk
-lcwKnqG6qhAeDGx6knWqR8FErYpp5EY1A0v0iPJ7MEaeMMyJZegtZO95Q7R/OKgUNzJqfDTrMSbUV06h
-+1TC+dXiPC/urLpaeohUU4Dq6w05WdLL0vuQtzapBNvnS3rZbFYd2ZZwR/vqqIZoK89zRRNHI9hdjnLm
-zAWeXdANYfOEXoYzuhrh0eHjR1//5S+Pvxp98/VXj778uvQVU+adwGdkQxiveofV+ld+AM9/k5b10Vf5
-VMVcRZUpKIepbXJ27A56VZEFPIlnJBiEumkFPzkGoQg2siJfSC6SKsZ9mouk/8d3BC8d7MMI5GE8rW5e
-RaRAzcur8O2qOdop3y+LL5f24ar43K2rcaJwWgK/anlcyiFD9SqoaVy0n5Psu6H1FGxbG/ISDgs6DRSy
-kFxlzHpknWGw4TeswPbqrqtEkAOVPjsNZ5zGca7eTV9Hg4A/iBOL1Y7WcgTXG6PufaTzD5ldMeyMJSA8
-2QGJSqyhu2zxgSNvlK+Kci/0bRCnSwK1+bMloytihfkB/NgqR2ISxYIyh6+Y+ih3NPhRHSf1LpzT2ZrX
-NV/6m2R1apCDgZQX3nHyD4YziMOquf61lLKEbakDm1geIR/PyGhFIwJeeNUBGzaDRI9AlglTelnTRV3b
-2/JgmtDZxclMLuE4XaAxep3O4zQW2zZnGeUvuYnJpWQFJBVq4K08ceeDPAhw+cRUJurYCssgQ5LuD1cD
-K8f4B9h7gwHaR4f2kjOarFepvXCckoDRy0EulDQQFGW0liFc0Q05pbLQUGNusXtbPEEFnhqLCU9hLTHI
-fsT9qqBiLCsHZbN1mqr5NGB3cYVQqqOM8lx3JBFUDhmFuB+1HBoMljKwlX0oWo4JBpsZFI63d+FZwfJ8
-VqARUhmmuNOdQvuW5eB/V4/H7cjPhcoGpbwvKgmhGpo1lThqjHwOmH2XeivX/G0W56YygEBsGvAH2AgK
-F+E95Evgb1N6CUP8BotlOE8oZUHJJdAoF5BaqoQa0BgJ+nyJmQjMcevUl9n7mq5XUymFOvqay0hzyl7i
-2bJSY6tttr7IU3XW9z86IgqslakYdLMegRebIRJ4cdFWYd5VWanmHOip3apS/w/auTdG/rClofbBtaGR
-LZUEABMvG+9Gej1wfytadd3SqijUgyz/9Neqms6wmHWuxmvngit4ne/fyH+JshZeQXQaFVLNoLJDG0z3
-pvatzwwdaNs8LD6K7XaAhuAoGbI/rEh8nz7BmbS+DzSKSnZcFs2lPkvRjp1qB4k2YwObt5sKYlBRlm7b
-ZLffZD7FnqzpCJFVJrbecefm0Qy0sG4abfEYNrtpzmMZ4Ta/k5wVT2qOonUexpqmzOjKJW9Bbt4mTjtv
-/EUp3Qy/cpwMkeAuLgfLGhyzJ3uSeW9wcmbbNAYtW6TmpaCOcVRjMxjb+UbXtnAn28GO20An+7ez/R3Y
-vW18W9m7OmJrKra3/AUW+AgmeGj9/iNekSOopdmcZoVA2pM4ujoDQbgpZV3bXUKhXAt7vCDbiF5WTaUP
-IJzLZllWX0K+jOfgES0P6OrVBdk+h3PqGB1+2ca/SSPCAb4qLBmDvy+U6a/TpwJMi1bVDGRv6KOXMYK8
-PyfNTL6DKEE5P/hUROVKkFweLMxL6Dx6bow8ed72GiVTKuL5tmFh1l9XfPELTiDhn/17kQ/Wa6KOSucd
-y9eNxIsFeQM5m1q25kZLgvuVtkOC5KDWoPuyztYQnbx1rf3LG2n4IXW2tI64MRqyabLNGWa8wBzUwAYh
-5m/iJIk5mdE04sGgFqR2XcvWpubbEpEgye2CbA2iuDBT0DmVrgZG21LWKCclWGtgRlOQuSBbf2iVqMy4
-kkKrLuvzh1CtVdKzSDAld+PlPCwsaFtFx/V0FYs+E29QdGDvUp2cAotAeL9O8AZ1ryE1M5A25Aq3UdzN
-XMUMGadZXB5Jj0xu1NzLdDLooxpjaAL+F9nyI3NmmiA/wrI+qnKoey1boyFHF6tMMQCbsx4WeOKfShn9
-rEgcrrOb4ygK2ldlqxDdUAkV6UpA3XIrvUyxfNYsCfyRP7jDgxzYu/Xn416ePLe2hNRSb3yGu24clQzT
-dNYyiC2ODONEHNlprhEqVfTcVFjH0V2o7bB51wMkZSzvdAAZFFmU7rZznrc7dXU0rUA9x3FCIiQoWhCB
-jBZfxmKJ4ugIeeawoD3kDZVuQX2R9fQ5+bv3g7ZRGhy3lzAuTwjsu4Z7ulUw4OgnZVy+d4OpziPeJYZc
-3XrszmbS2oz80ohbtWSmkJTK35u25hXl4lYtWVIuOhph7ueVW1E6d3aXCaBBHbUrR4ww/vBvYP2C8PtO
-U0IlLVKjls+ZUzQGWicKcbqw5hssBPuWwLVELHetqzS4kHKRMBiRYjMAGbbrNOs9tDb67pWbzaMMDN7d
-kLQkxYmnMHpnrXuWQfev72T76kFUdTppJaoe89O2JIJyRAc9Sjm2hj+QdHWEfMceWpmmxnbah857Lc4/
-is57y5tw802ZEKXr+psSsnZLmZlUxbhhoJ5lB5sZVtZFYhWm8qnIFz8TtmomdQYANFaAtTTLORo0LjBa
-IACvBoHfNRijZWiMjKca3CwhOIX8L/W03feNQjZ1wozSi19wIntReqp5+kQtm+VZDra6kI0i6p3XoB1p
-uI9bpusV5mUmm2rCkp4XQsD8mOlw+l8NkbXcCpGFyrd6jHhLmqggC7+LBUcPa7M+sGQqarnyoeSOrYNl
-dPJ74DHlaE1j0XDPk+9kB0B1U+uD+lZrtU1OhnHShGxaVf4VM6KHut7Xws9INlPZZ9TsDXpPQFZ2v3UG
-5Cr8/IceeEX/QWctg87UoI/HzlHPXWFhxHsPeGlLbB3vvxHxVvNh+16VL6Ci8z2QvisZmJEIvOHc2uDR
-Dx+iUvf6QiXECdYD66Uf5g5RHZSKq2+FFw/Reoj+ejBocZOt4O43ftbeuoZwB9TlztWNtrG3tWIu97vW
-CwXPJWpN+GbelHl8hXC0ilN1KatYYoGWmCNyJRhWq29GGSM8o3AVkZTQVLCZcXsrDw2McIMpl0XRCsNV
-tWghDyT49y1kYik8r5FRSFvHOCKYx8kWoRW+ULVdkFTdg4oWDKcC6fxWZiM4EpSWTTgP6qt7EBI8M5Od
-ym+2xb3C/KIZDnoenNtZdQNv5gqlLPmxRAK/1a7nMg5DSz6NkSq3S2wikFNeCRoDJkvyz+r9j2YuPnWn
-ReEBz7U86Rn5OL1qak0pyvJ4lSUEzXI3PjnvfEkvEUZP8oWyH6fZWjzVkwyybb7gXssvpV61Q851lALx
-tSGb6nyD8o8ZkOHAET6IU30/0qSSgvSsMmKO0o3RK8Yj8NZmES+P6DQTIzuQDmuAz/gR8mZCR0WWWYb9
-JzC+SPKFsSfIlfAgmcXYm1O22tcIPITSxX4Uc+AwY28mlCpHs5xg4MnvcI1H+TFvWfltn8LF0XzsfUQL
-IgRhJ/BvngPUe+pDHMKtlepmnvVdFOr+A6Ecj+E3n+2mZB+ivDjoqm6ldM9D7ErVe/HG7jifB8pVC5y6
-Pe0FreLXzy7gKm79bAeGlNEbnEhOspcbDop3nz6hx20W8rxE8Uq7jDXzwSlHulcYcsLpUubLjoIqf2/U
-KJu///Spfr7Pdb1AXedysaAx8n+gGHY5na86DH1LZsCEzASJznF+S5p2SoBHRyTECsdJCaoeHbNTCbE1
-Zqn63jFbgoAADOY+uFkkuLHH+U4xGHJyT7cZOaUnS3DM98wrqTwLWSmnDrgU8atHN4gNIVcZTqMX8Xze
-1N3kc7vmgq7y/MaORI9VQnlFEjnm3umSoNy5U9ECSEVTQlI0U6AhOpUi1YrglKMtXSPMCIpTpFJXIjoH
-SeeSxUIi4XRFaErAgONzjYOH6JSiTUwuIQWdfgkxyfDCP8Ebgl7EOKGLNfFBfJI1XcZJgjghCKN1Gs9j
-EqEons9liwiiabJFl3ibG6NYHOVZ75S2DXKTolhKTaoqDIrBOOUCSxatgdk6IXlEhqx4RrOtrJ0V7YxT
-QVEsQvSr7j0XsmEgFwqhtHlc9uAyFku6FiiiINMtYz5EU7lRLUkKHVqtOVyFvyFsi2aYkfk6QSkFISIf
-RYJwurUMoWdZoYoe6Qs6azqxebBQvSPkSdbP82jtkLLFCBJ3QtIS/icA2zfeeFWjvpevyG5UOWQDRULp
-xTrrRqDg9oXcoRtIwJUgVptQNyoTuoFqhWeMduMAMO650pnouC8jjs7i7zpdx0kEF4h8z+jq5VXG7AmD
-wA24l+8H+HeSy2dGqL8nCK9nhFWXvV6hcT0+SB6G0kitqQ9rohwIml6MOgDZ5LgTTVNnZSSx0Q4Q9PcP
-JZutFioIqLWc7YRg7Sbak/2yeNNGV/UkUE03mXwDfJ++T4v8Bz7aq1a1h3z08X1qjQf6D15cLPrxI1wC
-rK89vb4+km8AC+g9rq8RTeUr8K8FI+f1tQvrlEZbNEb/8yR7qtxKa6hc5Z5kT0/xgh85v8Nieur6/B8f
-PzLJX9CDiyF6sEFHY6Sa667xP/7jiWBPn4jo6cePDy6ur5+MRJQ/bvLHkWBtdZI0aunSSLX5fxwA13Ly
-/Ca1EzPPO4S41mJbUZHDNIVtsiygY/i996k3CFc4Mw5liZEYKgkFi1fBoJkcClBO4N/cJXofHZ5Jop0x
-MD5IunVA1WIXzW4o2N9onMrGAfOq1QwUvTfWWTF2pGUjBYitoKuYr3It5M10Q143Jsoij+6NoRNWuHZJ
-DwBFr7jL0WiGU18gDsGg+Ya/TkWcIDwXhOUHTykxrLMICyn6vJAHOrn3u7MYS3SnNNCscVgZw15RLkW7
-zT5a7TZyyE4VA2sOY5PMmZzW0f99z79QSVE+5bP9ydwcP6lN9xNsdoP3fC+YvL98v/8+fP/gbG/wnn/x
-/uNosTq26JDEbGnZcvSEfay7+VU2EEs0RmOzcMNocaIFoiIrtMApQcAGoLc/6CWYykJyRWZBOQkDV7SJ
-9viGkpPDs+O2AA0F9MgBlMQcbuWHtkq0Z/YYkvsS0KVh00hswS/lQAByYDdc9IkxkXD6At42k0o9l0Mf
-nyud8GeJ+dIVOaslrqU+MPv+4FZuUJWjcdNDrTcnsol6bhfh2vna8EWsilo6yUpbPEbtoG7FU0myZ/F+
-/cy4qLVvrRHn93Z2cKz6dcc0fZvHvnnqdvk5EbNleTS2uTBafNQYARLp48fTTlP140YtS0BrYohze2aI
-IvPAuS3nwL80bwRNA2+arJnXlmbBzNKAsyzZ9kib2Hv92iwM1+754NtU4CtX8AmekVO6WCTkVbxY5knz
-3Y2FKBBAaOtGW2oNSyeKljl8projChx9+5zSfFjuMtFcpDLQEPGAzLRPTfX2W7IgV9pv7C1ZvLzKAu//
-vn/Pv5DrHbbzPeS9f8/35LPOUbXw7GQsz9aBgXZomc4pnl1cYhZxfWlqcwguGc5st1ojfaXMCYGksxvi
-xrCkCfkHZZETgkFPVS2tVjZJm0InNtAMunM7gm2wXThwT+BrrXivTGQzOKbIw7Yg4mVC5M/vtq8jFcO9
-74OeYKCRvk4F/SUmlw4vYL6kl0EZusQncXTWTmtK/Vu5t82SEyaH8xOlcK9q2lGvK1taM2MWJ63WnIj2
-3dSasaQta6bsECSvdHpAr1kytMhVt/IvzRid6bQJrpthZMM0SJ5o4UTO4sGZPeHCnbl0ghcO/Dr7Q1wq
-UXmVUpCT007exQsi3lZsTu1b0P3mNYM3C0kruyzi2YW921bBf6TNEedSurdkM9+ReOy2t0LQ8x3h6IU4
-LFuvr3BoySNjhBUZtbQFxBeh/zAt98dot+JtlkF3tumuXALtwfjXPQc3X4Hy9/G9XVruEFeuHVTQN4ip
-gwa0sL9QlyhazGFLV+ySXWAMdliQFrMlJ8K6/9nEF8msq2GQdVN8fi1KzeJu2WYEtWLSJvYaHmVLt51u
-ZQ1hzCGaNVCBroKWL24R4KpNoBK//B0Iaqtffrp5JRU3gLprQLO2EvwJenTDax5kt0Zj4C6Qa/fYei0c
-JLfCUx7AD0bXaRSoomWbB5bxiNATx7UXTcPQdVtAvFzVrQIREb3i7P9Ntn8A2ZquKLV5s5BEDuygjO4q
-LT4zLfRZ1KdSsaER+vqg/XJX4whRuywDxFb7xch1LRj87cj2VKibbOql0Qg9E4KsMjDPg830fwx7yZzS
-/0FxiiiLCJAhJwKtM/RhHc8u0G/rVYamRFwSkpaJ1nEaqap2PYhCofwECg+2I6hp22oK4aaNy3XL8+zi
-P9er7BSzBbHngLLltjbtWY301s00F9DJUBAuAmUPi88Grm27qO43NEYx2kOHx+i3RpW/2as0JvJ5QjlB
-U4ZnF0QgLBAXmAlE54BJO7eQFDxIYHjDVskNjCrvr0crsxu/ubtxO8GrYJtyVeUmnZym3vMvxu/5FxWj
-zWiljBVFu45bewN4e0qbTRopLDYgJAEuq9VjV+HyurfaoGhT58aTYSaMxVHrTL5AkC0ACormRtv745tu
-93WFN6CdHJwNVdsmh2euuicHZyoTUG4gM0wG9YP9x3v9p0/xSyPksIpsB+0NEbkP2t+0T185G+DNZ3dF
-VwY2AAjhKRh9DMIvBtcjy1AAQEsHG26FdjNcRz9+NMyHhatf2ZnUsQeltmKpU4Esl+e5MtJKNnIUvI/2
-BiNnGqMedyEZUegF4+fCv3OVTH56zdPZtJ65bPYVEKu8jrN3vxuRWmpxVHA9RI/sZ/kmF3KkVmyv2H5S
-LCg+PyArYtdU0KJZqOd2Wg3k0g9WZu69thHKNfZUUEj8vGpymd5s+A4UZ5YB89URPP+SLo5AarcENrdr
-uyS197G7lbq7s+P2/NJNZYoG0ZfF23A0kmXlx5pyIZqO8b3SkuYnoCqKU3d6UktmU1ptQ+k837N4tf7S
-nb5X8eKw4A+RvhimfowY9EaWHykauIpUT71RgY962avCZb3fmFR2G2NsGk7svdAZ2qkSl6kR7IlAa9Qa
-OPT7wa4WiM/+vG7jNrrRgraJYyqms+OgD02W6Dox1bQIDx92axGKZtbSmd1er1DRZ1nUAIDAEZpnHu0P
-e10aWSgAlCbjiXFrVAf+RzdIbbyDnu76MzaowezZrqAriwvqvH6OdhQtBsSFweC8tvLACztGAGBc1eex
-L2MzV1oZEWPtbq+b96xs9p9rlTSMxabJ0Z1qRlLI+TLmgrJtXgJUXK/Uu5b00G3WoOYmsFTbR1myj5zb
-YWT9LA2mpZTWnhto95xMv19Wo8w3jturNiEjWYJnJBgFk+HH62BwNhgthsh/cPh+/ejgYOq3VpNQHMkN
-Th4BfoZopcrdPqlg2yHa2Oy0mzCiKcmj+OQ+swmd499Dh1wkKGpq/KpVWccabp68QGMETW5mqO11yWXF
-1bnzsstK1X0vvawGgtzs8ss73kist1X22zwMaWGj3DK6tpKb3Gr6T2Knm7AMq1HMVD1aHKNDHSrzgM9I
-KNiai2f8lVglild+R6PtXTKvTdsVGbswrfo6ap4sHTypjA+o82iHJaTHQOYXk+w4ksWO9FJu+xqzK09v
-BSiPyGmW7qEtJVVEDRxtjYRetLbwOzUItebZ6MjZtu/axtGFrlf0bsUIcqHibgUOn0Ohlr2xhnVycZYP
-nCo6uTjrEpNTKmytOVe9MlWkzQQhcGdG6lIdbnCiRsUl1MiqJx6AgGY6la0vSvW9kUkW+JnFqXDnky4r
-U4BFZR8RvDhCJZLrPvWeQ62vTk9/bozJMmttwTIL3xCxpJDlr2zIMtvNSdmhjoarWmhdPoXd88fWeVa3
-K7TPtuCOyW7Ti3vQRcv1JLI9EzUJliE/D4SD3LBou6ykk+bMunPaC8DcKuSMDLwOGnTbtm5EE5XmVGmj
-0SobjSDXZf/9aAc3p13yyB8bJFTesYTRGP3nyU8/hkqSiudbRUEvICOtFCyHyEfIt1dYnA4Uz4SnDsUr
-QP5DPfK2Gz/pZSpFbZVhpdNIOE3oVNvgv0voNJg0RZ2zIfoIjuFHCPLPjLIEx+nxbIkZJ2K8FvP9b7zG
-0HK8Ic94IPEPkadCziXSjqsu4vm8R8sdBqCRLO7bnNA9hdQ7sohyTWdxT98R4NUvCeiUtHY+UmvNFlDU
-p0/I+5GiF/F8bjHmjEboLeFEFD57EBEWQ14HRlDMUUpBUaaS8nx750da3VTv+yKrq6QzqNJI0LrLyVPS
-SI/ZvlNfXQNPaTs7wZs4XRyjnxOCOUH/wHE9wYCL4iSeu6A4mPQjc6j/pWRZHR49Ryd4Q6Jj9JZo/36v
-6wo97SC7TiOVKvDu1SyVdt6M/hLMeSf5WapzkE1LgkjlfbCvebrXpWC31+mai+56NRF4PeJXdQmV9caz
-DqGGVI008h/LTcXcSXunf7MXCmDh9Ut8CInhAF4eT+Tf2rdOi6lKCRhhEa7griePk9RC6edlTSZLYOuE
-jEzxb6QdEgq0vZYme2EX7iAZ6QsslLzgEqUaXXhZZtAuS9/SG6BRic64fR+p7CDoOY2IUad6e/xH2tvb
-+t2PMajG2MmwkkBQ5airpg60F2vJHOibhFLsINMY6I0fGT2MsDhC3hOVmed62EgwaK/all/Qnwm/ml/w
-HUuOkD/iAot4NsowEzFOeIWIw6VYJSrtX2tKvxeYL6cUs6hvVr/uvH23yc+XFLqnPP+bJd2YVnbZsvXF
-iSBGwiP1XMs9XoF1KKgKRGb+cfXSs1/L0nSHAGjDGUKhbBram9enFmOcf3JcMU7mjPBlUO1QKJYk7Wed
-MEbb77yJo3GtrkkLhLE7q+cdCIrg0EzEbKl057xwt9nhVt4bXTvZecdkNRGyJgrLNHve0BWQdQtqQbtd
-UWlL7ylZWhQzAlc3Br7gWmPr28LaNac0ErlWmVDBfXTaQsV4SjE4idOLIzMIVTENkpDVEGEhWCM5tzbC
-8pP85tMWI5fRQnnWpXOAGY/HyKegd60T7XEzyf+1yeor4/KcrrK1utqi5+BA249qjZwZaI6QP64jruZK
-ildEAtVeLwmO5Lbhj/2yB8POSTGrvv3MgIN8cem0K7lMzQ/HKGCJii0+/owZliW9hxEWZOw5DEq5/cj7
-9ddff91/82b/xQtvMEB7yHsosXSXe/XqaLXy2u/Z05YHQXFv6rPUCTdobxo1OSiwqGa+KqKUyhoNd5rc
-qBYVQEPkr4ybPP28SvCLUj8r7i8b5ftS83vRHYHslXqwfM4n/CzXhl3fc4FFk+hsuZwsz1aryeqsKHRd
-6dRpvCLVDpWEEmwGpkuRUu1flp8bX1dcj0ZKL5Vv0cr4ihfU2GuUk1FqvAEvbYXhac27SBeVf32z2zWx
-WuOLU+RbBqd0mAFvsXenz1EAsf5pivYqE1zMiWoOULJswh7yB35lBKvXRlbGMcNCECYbNAL/7CD6tP2U
-flp+Wn3ig2AfL+jg29FxZdh1ERWDsRkYw2IhiTrBqfQkclVNDs8GQ7SaPDorvAx8SP38piDC6sDUMB0A
-lViYrye4pBevF9O96Rbz4BK8mwEiVBUOu5Z7K902DfiQr6kkfrsDTd6Alym4WrjOiAYP0LXrbpANThpI
-BtVlYUVmBEMaNAmEaC+V58LzwOAHEcfWGD63wUs1M6U/xKnz4nY5caGsKgCb/S1PvZCXKEHjMheHEuB0
-Oo7Ax76jtyQJ9Qw2k9cZMEtG5Jz48hh0NBpdXl7ChobTSO5k8vA4uqQsiWYJnV2MZjTdECZIBNvxtzGn
-Y78d9d64ZCi+3PbevHnx4vTVq9WqpeF5Sf9hdjg+cNRQv2a/3I114yvLYYguWl34K5VKdhdcoD30aFDc
-ir/pbwIqyEAOUkCSwS7XLnRJdpq5vEvjq386g5GV7sxkci/VHXjN6t+85t+85t+85nPgNSdxOvvnSjJQ
-492JMuUaWUHYxI/0Mhh05o1tGxNKExFnf9SY5KRG9KqTfycHZ4NQ1xt8RCCryo9HyJtSIejK26kLvuCn
-eOr/QR0Afi7PnrrltSxucjq0asvUsW+c9geyEeFMsOS/yNa1qlxh39c2izbcthBzxCniy3gu9kkKeTVx
-iqYEzfB6sYSEBGydIqxuL7hckhTBoMmCM5wkJILIEBv+4s6DrG4mNXtUUdShhw+RfAntuZN+8stYzJaV
-qlxIZ5gT9Ncj2XI8dTOtjQj15VovyByvExG0RL1LGtjI7QCHcIV1O6TKFQDQKuAjpumJfOculiNGY+XZ
-qh2SARMoVN5DHvvim/rQiq9ada05LyExgGroXj1ip88UFSN9+KUcaqC51qQBzRbdbzTpj0uH8JlNNxRb
-T62z3V4qwWB/5etpKH++zh3d36feoH0mK5k4ZFmVjAMwqbjghw/RaILei7ORSlPB19NJfDZQKTpaJ6a9
-zRAcIevRXZWVD1GM9qEZg9usilSuikv+By4NwJ9nDrlDWcQXnBEe/46nCem3dTHCBYtn4gj5zwzNsV3L
-jZNkimcXR8h/CHHT8e/Eqquu7YcZ5UJK6D32Rdj5ii40UvvKrzQNfIAgXNCs0kuyEUO0jp2ZX5S/j+6F
-iy9UoYLBHc/PqezYCWVCmWLLa9MMA5Z+aVHUfazf97Cj3IF2S23wACSrQajuwqFMEBa4uakE+CHm4gjZ
-jpJFxwc3dxN1WMVQfoPOIuYiXMRiuZ7CSWmVbNPZchRFXx38ZfrXL0n06Jtvoq/++te//OUb6/TgtaCQ
-VPoOJsexsmzzVtjZtTx721nTaCYHZ/YU2f3H1kHD8Yr8EKd2FgOiLY+m38MZU56Uv4RDoD50whHE+/Ov
-oz+vRn+O9v/837m1vaYHx4JUL9quKpjB7jTQEdtBRROtErqwRZxWrqMSNDtChwflTLB4sRTVV+qgcIS+
-NN4lZC6O0KPHB5arPG9/ruNLZb6ue5nnvolJgrNaGvd4iFxReTW8k/gMjdH96pvjFt7YjAWUQresTP6o
-4mnnnw1MZSRhJ0M9dp9//UoEqz/U6f1rOIuxUl8DDe7aF+63fN/tRAHaoVRoq3b0ZagfihY4rs7QYC3X
-LOzWjhyf5MgGw8BDNG1HjrA8CYUJneGEPKerDDMSTOW7HiyknK1yDPQvu0YT5DADqnbp0ca49GijhWZr
-pRCWRLZ9MV2QrRvPFLMl0ZnyH0OiQNVKp7RmFgBj3ypOg+LlEH31eNCnEL4yCx0+djSPbxav8oKVhqEv
-DKR7mgGGgmblg+JudrxFa8oK9k0k+32Q8M3iH3EEFwCDqHYpH1zq7EsNWRQqqpAct3wCNm1HcXUiydTY
-Yrh8VkquEG4UCyYHQ1XTmaMZV8+uYr1Y+WYR4quYB66EzxJ7oCp1gFAWgxZYjZLvUrWRVSa2gXuKdXvg
-9FAot+w14iwjaRT4fLNwJaqW20/gwyj4w2K8W4EVOShoRQ4d1XdULhhOuRQA/CFSD4lkzH5JnDDpe8gf
-+nXq9Qe2cYTJ6lc53MssK75CcnqRSPbl3xu1+ADaVywze9sUiYQRXeE4DSbWaqIvgVGoNWzKUZHBqzRQ
-FOr0DCbczIArRaaZzo0v+Ru6HgyddeOrHnXjq93qzs1F7updyzAhCwJn5F3pPoo3PWdfJPuqFt9lYItX
-5LxoiPpx48pBm65GWMrhehDlz1O7o1SRROBumyD3RTmK9hWkh9oPr8K2JQH0D/eRunijPC8HwEsdXZst
-lVaktm4toZc1M5Le0YfNpBSxU1sr6yoS+CaBH04xc3UOmh9hgRVaJWfk89VSBLSTQQtA3kt5cGqru0qm
-9sUoiVcynih32XflozcwXjmxKf4UlCs3UkQ56INWSt2xKW10lig2k/5F8s2q2v4OhbKtX4UBG+23dNuh
-kXC3kqaBv6JrTlZ0Q0I50sXT+VXvctv+PTQ5g1rYRZqTGzZ/lsSzi2oDhui3tjaoy3zRGPlwqzX4u8k9
-ECjzN7dy1Dw3+ipJnSx61nlNROXAGXXDtxxku6wAHafWaHATA0HljrAWDJyAS0f/dKPFWRON0YPA+5O6
-jnjQfuGGOml2odXRKZwmcjgWgZ9SueCjISId+PsYU9rHS6kc/aVYJUM0pdHWHxRJirOAhHQ+50QEAykY
-tk3I4OaR6PbtI8FTkjh3R9g85D7ruiKkZacodgm5pjskUnIl9nE6W1ImxRkQZO518P+DVohIgvj74WOy
-akcVSU7lh486Abd1hhIb204guUX4eGBuHs4dRzE5h2h63HPeOMlaJ03JZ7eZtpbNvfeQHPYckdpuenjL
-2c/32MuW02D7PtecgqKjBljQ5oJ2hcb5cSkGV6ZAnjtk4UAsYz6wi655GMV5i4RcElGpjQ6uBt3qx3t2
-I2TFtgOO9yvxLo0hbcnEl+vjQvlFD5H/N/nPqfznZ/nPS//McP9P5ysR8CFarRMxRHw9n8dXQ0QzUaiI
-5W80Vn8+fSp0w+A0n18e+X1CsQi44dgd8x/xj0EKGT91qAxXgTIqF4Zv0aZzU3Eukcg61c0ZJUWkuZJK
-3aiRGnXeTwcNlNAh9C3yDyCgSz8fIf/AtzT20yd0P+bfx2ksSJAOGuj8fcPJH5uXfJjtwOjpuHqRCAzW
-ejWFGD8oM08oZcofX25seIBGqHiSk2HSBkYjXSyjl4GaKgOLwmwWgDsMNEVM1OeGilwPxRjVAYthapBb
-0XXZDRwK+n18RaLgcaXvT9Ah2X9cmV4NrRMTN+wjKVmgMUrRE3QgZ2rfl/PjV2wbEmQPBXtsYLTOcOVX
-8WuBL8m5zdZs2EQsBJgvhsODg4MhksvoY87a62bUvMLpVhB+FzU++mqI/O9klQgoW91CiTrrj8XdVT/t
-Xb1hgSMJ5iKe+UOkdEuGpbJqSvtDrZVtxso8zWosYpwUCmvr60+fkGGv5GKbkFDvhFZFgrL9t6YLMHWt
-FryOlhzviANyR5mfQVJ9lasGvezKkojj2q4RJqmAu4fjNFsLKcikC+INdV9t4aW5NVhByK2+y5x7z23P
-/Q4zZfC+jNOIXsotS5Lp93nEqjH3CmIoOVgzvNpheS2sr48OqpSlLbD117kVtvZaG2IPDlrSXlhMrEeO
-vHuWEEwEYZiqUWYYZs295eauF3ULzp4iw2WxEg4fH/wT7DMtBpluQ4uyscgTMmYuE8a2AU9ZFKc4ccY2
-0OyPsHn0N2NUJGL/8ODgz36reUbQrNMgYssV9YfZQ/4wW5YnaOa55nnXCrd9KpRd95y25dwTQK5hlwNA
-QdJSDKr5imsW1oW/e3fRLOfSbea8zjn4EFmunJf/XYbTWB0jwZlu2GNT61IqXbcdy1Q9QVuOwvtlLr1u
-lesuGQh7W4cLVV5e4InjgoCbt8VcZOPc1FV23GkTC1O8IqXF/Ztjp7LpluZtPWDFTlG2raz98LG93K33
-CsOG2cOKLv/bGtBvpTD7HU4jDuVUa86GKDx0FZZcpMognAPyh/HPKv6GsbyrQLHTaAOHQ2dBM/fO5JgH
-GBwRzy54oGgKrq60A0cMXwa9QtzqLlKbVpZQv/xpA+f2TU6K4ztfnzmf2qkrqvf/Cta2rRj8jbVadUNq
-shLJPwatCzB3IjgY7sKmfsmdpc5aKNfQlC60+TdkoLRzceXR6L/BhcK9GJT04y28LhOfBwZPb4g85ZXR
-VqDVuHybqrf9qgYG1QJUDiQkYm3DB2dIBaZ1+bIdJI28ltDaKYaktNU588IpZt5AKbINwrajkSh6m6w9
-eTbcYRihIV3QW89Frmr1BGpF9LFBe4p7esPKviO3nGBwQ9NyzYSsV9DAvr83mcDNfan/xnC2/Kecvg/t
-p+9Dx+n7S+vx+5s/9vSN05QaeZTaz+fNjwuSEoYFZY7vU7bmS4jPkQBTiMdxgb1MIwAiaWQBIZDC7DsJ
-eIT8/88CscJXjlas4tTxJaVshZP4d9I5PO0AeSpeBxRf0stntZFuU3bkuaeOkP8kijcIFv7YY/TSe/pk
-FMWbp9Y7R2qwaEaT/WSxf/ioZylVQSdqjfbr3m3pV0B92lXrM0QPZnSVxYkzeRZYKV8mZNVQeISzZZxE
-jKSBw+yVO6l1lj5sd7N7lkK2SBynYB2xRVOb2B61Y2u0pllJZ89Kz40aBbfZDnGa7li3dVyu3b7pr6Or
-5t1z1fYWS9bd0gLRo76V/7+oI9w6dYT5ITJ3bnfSdps/9601Xt2u2X+YRsuXe6fTtxKOdkXkwGFxZEYj
-9Ohg0FJK27RLYcCxSuPU4jOVB78r6io2ztYAeB8zgv0jp4QF19iXY8cIbvN6mjKCLxznWBVO3bcm+RT0
-Xtmwu5eF4dE1x1cdKtI08KG8P1RoSdQFCeJEsU9/pwu1acWLDeP/aWX4Pzc6ABISkTmvORzLV36HUWGW
-xNnPWCzbmxzLWQRY/9YeQt26oxYH6jbEGYXLNfchRQH4wOMk6fKWj7P9DKuZX7Mk+JN8c8cRGH9c5MVN
-2rTVbbIPuxwLnq/CdutOA6TnkCgOcodSUb/mXt+zqid7kK1SYvg0w7NYbDsdzbpd0bpx1NdIH85l8I72
-jszWjCunSr1i/BYunvtNbyXVnNLFInFZn64SOpPcUUvs1ZCNug6lOJTY4okSOsvbOk8oluOgZYpj9xZ3
-qrON7Vh9AZc1oUBtBXoDryuOpzwbOEJdfsghtRjv4Ho0gZzhTUNyLlVaZ8r/E/nqEB/O/KHj85d/+QuZ
-fuP8/FWE519h5+e/fvMVwV86P8/nf5kfHDg/468ff/3IXff8L98cTufuuuE/v39oFZaHoX+P4i1HEXxS
-5SHuoOX71v2dJlFL6SXdqFsNbrB/QdkOVt2UBFKako5CUcyzBG9L6Ja2/ywrQGP1YMqkR7OYzRLS3hfJ
-ex+3oX+r7vhoYu+WruZxksguXC5j0d4HzTCblbT55udsmaZiX9vw/cNH2ZWrJkjIccOZVqlJbjbTFqcA
-ia1ogzxDOQ/Fcqv7P4RR9y3XhU7M2BadtjgT3/3i4bYmTZD5Gb58lqbF3tLtTtGQsWr3mzQ0rAq3SiXp
-+x2ZtXBhui+xu0uMRiov4BSUIHlNRYQIZHVq7snqLg357/6MphFJOYlazDEtVXhRvPE6esQgazsgqBar
-t4vRyzZcjF62l9cSySNvoLz4veeMwPi949UrWm6K+fAgR41DE3c76n9R53+6TO++14D0s+zuO5bcWWfz
-9YP99nxzGscVKPmXjMy9IcLhO5YU4wW/aSrriWcXXu/gTeUPlEb0MqQZSRWmIfLOpwlOL7wbhLL9a2fn
-ORZkQdn2zlehxvtZdvoV5eKuOyxxfpadzW+0vOP+arSu+Ew0GvVxmM83+mKXPw/FklEhEmI42xSGmdfR
-VZu+JMUryEGkY6ur4YMsJt15A/o45PyonX3sPYc29M8o4M75YJMa8160Vn0Vi7aa292CQNkRNyL51JHJ
-5d6U0JkiC/9UXYikLk6B3PZX8aClKimkX8XhQkVNBwM0gvAhd4FVnL6IIcWp8qDL1YitJeSMDeUPODy3
-gv43wP3aMsAtaSSqd3FH3QH48hg5jTmZiSDS91q3MRGItouu0NMxUuAdWcaKclBRpQjab8sFe93ablIx
-00JsZ7ugmYmi+kkcXZ219zATXf0huXo5FpAcNRMTk0WcdUS3E0WsaiWjPeQXFKvjwjIxOTwbdGBRHSuD
-XmWpg7P89oc+ZbdonDtM7dgDVT7KwyH5ByaCIsJRNmpfKzmGkGa/M9bfKLvNy25l2R55CGQ7nuTrsk9K
-gnIJZyI67gP+3wB71Qv2V4Dd9oKF+R+jOiHA9PdCkCvjQLWpCWpw05QJ1zvn/sh13rJ2pxtctW3OfQtU
-JV0bUZt1T05TYdaT89Dmnl0qmgqti9Lt5IPaVu6UXImOOHE9ud1j169a4Nb8rfbJgK4+RbkL96O2Mt+B
-i4Iq9Ct6avgD3LiDpSUmb9K3aP8xOkKP+yX8ydv0Ldr/Bh2hw+5i1XQVZa2QuAIdIV9537UMXgq5/8ve
-hfJFmwwyncJ9cRGRssF339GroI0i3pJZrwGbTkPJGw97DdR0Gm57AZdJkaZhYdR81Nt7dToNc1nmUZtU
-Brd/SKbuDrO5ysmyjQ87dNduFqS0i3l+MaVd7BztQ3+IrrrBHvUC2x66rYom2KOuqA24juJKkFScqCTx
-7RIapCEtwdvlFgX4Mo3Q/UqpznxFcDMO2ld35uZIjvuUCcoiL+L5PL+JcPe9p7AyKvbpEuiubXFxjw86
-AvCe00Tnzg/8iTpbGc7Bw7oP61lXKGANvqIblt9a9cMt35HyutWH303M42mcxGIrT8DwlLSfoneONXFV
-toyjiKSuurrV1tf/DqFs07r1DaH8nKIc7yAGcbcwwCsjku+qLZIPorG0fqCly4XRNd/rDncMON0tnC8i
-c25NjvRPDc3rthar0786UKvflLnCzCYHZ1J+hxlvw/asFzp9kxikoWTihWS34TqNpaDlruQzjzUstBIq
-qupKeYR6AxX7BQ/hLAFP5rZlvIufFmoGYQRlskPj7eB/cdDkDj70qNOPvkUQbaQkC7okuCwPCi/zkB13
-8yhQ5XTAbRVcm6ag1GwHeZd3ogJ39CkIUXFX51f4qgyJBzQv2jTbsi+tZqoCRb05HdlGoWyYrfkykJWg
-EbQMNGWSIRzcUQJIpL3Jw23QHp04yWfjrEUhApiugvbYQlPj58QEpzcV/IvGaOIeXpUivIcNIs8lXp9O
-M5n3TG8X8n/dxmFr1f3ikfNU4ndS9VmLSCH3zTai0vuqHljnOrzuEZGtcbRM33YFc3fXM3RYDFNb1eYq
-vrMZKpZAdwv03cIBtGQfxmKARujxQcvswV3BLbOnce4kBMIk7I+hrCP9hGzhXhuEbFzhptTWQACUFT5t
-F1KKhjmVKu0nb7igWFWGr9CTPpXhq5tUdu2msJI3yZ4MoYqWpVmKdT9CzkNNlJIs2hqvq5EsswiFW8U7
-yxmu2vFVr9oLujcaga9uniFi28o7SsGoCMuSYq+KvmprrtrHDnRMWnAwGOx6XOp3HwDqdScA6p26oah1
-e5e1bvsljGjLvIxsOSMgGXR3voPC7KEcNOSpINj/68GgX6aE/c7gBdNlSRYISitBZxIELwKV0SFZtbUG
-tHrn8nz3wZ3TpHq10rs0hgTGg/A3GqeBd4y8Oz00aQ/E15G6Mg2NRoZWEL2O0P5T9b0Lw8s0kqfXEo3C
-uv8U6S9dMvJb8M056Hk16oG+F7XmClrcKgW3oXYZ5OcoiDvPv4Z7zuto0qhwEp+FryGV/sFxHxx6NBT/
-bWI7OAs1xHGfrPIiTtfkNtnhi0Flevjhx5NxPiN76BBedY9mPqKAyCzfp2CvAWb08rgvpnyYGb20D3S8
-w0AjlX0C+jNu89ioKvOd0bj9p8cY1EqPnth7VCqR/leM+Z0M4fUN/WtwxbaB07TiyVZ+7XKCNTzaKvYW
-l2Pb66j9+G8yuy4HN2TmIOKbxRH2+gF33bFjmPs2MY+TeKriFvvftlHuVnUNXR9erAarsAfdhgPmuLS5
-p00J0sfb2Z2VSa21CBbWFyjIxYsvUHjweKDMzj3rKLI1VVD0KVkIXSUVeb0KcsHoBXH2LQ+JC2T3evdD
-Id3XYa/eEIX9ejGPk+SOmyJRGg05CA936QEYK7wh6lfoyutIlmU1CsAdjMo0YCq7+lSYN28H7/riyvxT
-cHjo1Z5ubzmSRhV8eje4IbYZTma5aVCPnKzAuJlK9aDDF2w0Qj+SDWGIkTQiDE3pFeHoMhZLlBDOkVji
-FH2DsviKJBxhRpBYki38iOL5PJ6tE4EERRDD0MnzykY/Qd/swOu+uQMeV9R9cyZH08ADxTtsPRWawmna
-h+nfvyXXv804KM1F2qey+o6tZIBuYaQSMBcMbtPavrdd9ZmzZoDN5z5XdR8J/08rGuHkZEkvCfMHoWDx
-YkFYnj/ghjE/FWkKfPY7XPPdCrwPa6LvaIYUF9X7rjrcte4o7GHHHESobx4i+Z/uXi+ZsyJKZu7cLxZx
-Uu2o/f1o++Itojc6pqIoVnVNvZVXb8/zTGeSpv9907DLGit6fqvQGo2ly4kxcnYdcmMpu06v6z1Nb+10
-nSS31sUSzIlyAMXMH9zCWVx7FAXKxlcITaUtbzBodR83r4cDDbfOu9OhRgaoXsl/e9702iuVDarcvgYZ
-Hvruhip/QTOHwzSh7o2n12WncMPQLVvhykaAdrlx1byHbqfZRqFyfPW7nfwLluL/aT6f+/2iAvbLnEh+
-ePjocZ96ljgj+0qYj9MFpBVjMc9eRgt31F5PO3Y/X5KudMbFcajNqbkEsPohl59fWM2sBVHpNHqtlwY6
-9HE6xAwyX4ScrtmMvJS/W7y6Q76M5+K/yPZuXZvK3qKx6pGmOxebN4ZWdgILMl8J7RzuvgKxdB5vlDls
-L/NCWdLnK/FizUCezE/xZflQHhdrrw/OBoPBbWitqkszsiijhw/RTZzhFaIysXNf53ujnBrAHk70fU44
-17s439XSQPbxw9vBWHZDur2rxXX/s1ldlsNxSi5ReUbsW7DUKJVqoXJlaLXQXKVlFfGKqAytfZGXNsnm
-WrwJ6oJQ0WiETpcxRwldcITz+53hllBEGKNsiKZrgXDCKbqk7IKjMEQ0isJ7f8xR1+70vJqv5Iz6v/76
-66+jN29GL17sv3p1tFodce637Bg554s6ogxyNV5tMGWtO1wK28jo/5ymgtEkIYyHs+J34L+U4/pcsEQl
-94cpkZv7g6UQGfxI6EyZZOQDo2tRPcDk9yxCgSEqwIdIAZvdfVDeXx6ni8ZF6YAiXBAR+COcxSOY87qf
-RcjXsxnhvOYyWh9VXZVCgcZoUpM9zlUpObwvq7HthLEhhMPbJoowFurQWrgYxQpwsl7ZLdfwcUkvU+cd
-8eey+hAmhdsaJw8oznskddV7Y+Vi85yuXYwPvn8fMw65CYqlDCRX/dbmQfoDdpbPP/UMW6nMlvKmJawe
-sWoWrMngquQOJIHGyINRRnMiZktJjeq+Bw/twS+zqok3j1OcJFvvrBot1CRolXes0lQNA1ymEs8FhAY7
-Vb2levsSNPuZ0QwvGtz/uoFeUIGTH+KU8NZ8YprJVMdbe3e0YFcHFBJ1V5BnvjioL7dKlY51Z6NsEIAZ
-C2dLMrtwSxJib6+TO9boSY+FsHZc9mNBxHNVa2eXL8jWzmX+4G7Leov14orR7zUMEpNzJCCwpDIIEryx
-wIB/Z5TXGPgQkDfPmD1ZOaCGvSRkRC61oMEYmqj7MAQ3U3gu+ytXtOLENrbQ4EiWFU8we5YkrcSjQnYm
-Hk4S76wb3YleiH0JsiTh+qCpimFi2mpl64T8EKdVziVZ/BBZKFfWvGayx95oRtN5vPgWJ4SJMVxCrCn0
-uFFkzuiqIlN2b0Sylr0x8h7mZaGK/CGXmjwppO2/ebP/4oXXhkBWYEewXB6tVvWyKuu9o8WOra+oTxWE
-2tTPXRoraNHUWmFrQ/XaXrPk2CoajkYj9ISROWEknREwsYy9g32QGEPBPTR6ek929hQvTohk65Zo2eKN
-AireX5u3jatvx/euITpNo/ylG+EvTnS/mMjeYkF+ynK3ojacBqQdtQFg1qDuuepAroCCSnSAWMY8hCPM
-GM3Rw4dorp4+fUIeXgtq+MYoULy4MEDlkwStg83z9mhA/WwDXTC6zr7blrD5i0+fzDyplVFQPWkOwBuc
-9RqDNzizD2/x2cT99zVh2w68ABOobp6ss4wyMUQfGiONFwtGFsoZHX2Q/f1gvvv0Cfl8vfJrQ7QigsWz
-soR+ltB1UKZWvQaEp+o4ViBLojQK5C8/fYIDfoXizP0fitz/EEaExRss4k1D9B+N0BTPLtCMrrK1IKiE
-BE6GPtxrqDuKptl2Q/hoIBkjf4HXC+K7bo5DZphHvdfhTJ5ACOtZk4burqsXNtkOJ6rylwVhE5meO+Od
-pAy5eo0arquzHxmTHnErKUVcqNNTAQbPNlCBFwY+eNLUk3PW6pKfqnVmlClf6YLGSqyUTf/WLGy8c5eG
-XXGJIZv/MjZoeTRCz2m2RdBscAEC1StHgiLgRWi6RXONn1NEkwjuMOOg5aksicr6r9PVuUpTVwyYqabY
-DNGFS87eoPF4jDyvXTPTVz8013q74HvXdUfzkntvbF9Lhm1XEszzTcJi9pYTUM715OJMsv3j1gPAaIR+
-oDgqZgA4B8OXYNXdIpxGSB2UlmSF4lRO2hTellQR1hGCHm+FLwjXMwlIqVgShjK8IGpqURCHJJSIEbnK
-1JdBg2Wdh0vMgw9D5OsW+tZoKD37H/TgVma/eQ1lvRIFkQ99mya4NsK6oJwRGGu9cd6dHhnqK4e6b4X1
-Wb52MSpO9CWe3Dwi5J9e2N8WnDD/qpDCJh1mjAoqhRwDt/PAYkgz9RO0k6GYC72YieZ8DyXHaax6Y7b1
-MpRCkR7UbebYF7HIG6mO29a7zIwxHthbW87jZ9lcfTawTOML9wwWW7/evx4+zLe3gXVnpZcpx6sMUrub
-5faQv+/Lg416d7zLbm3i9Bubcku3zG3e3r0y24BLvnljBlmaQmK3VFiT/8y1lrud1cSRhgIBc4I8idU7
-sstFujH2vcTiPaUw6r7dCKlroHYu8AbG3RrE2ijCCCcC7kO2B187ewoSbkc/HfdJ1HBWqE0feIDozMMO
-XKX8lnxYE951ojZBm0yTa0Oyf7jcxwtaFxlLz8mcp6rmmUiNxZCxddq+CM4l2gYvtgUQmvW3xQ7mJrpc
-K2qWm8RnfeQ38BGVVZ/XCrvvkpTdpXO0ab1E0uOCxenCO2qNor+/6cwNQhIiCPoQTy7Obpa9zundqNo5
-pTQhOP38G0qnv5GZ6GjnTwAUXpAtDzaDvtmU/sD2u3XntsVuri1zzS/U+zkjfKne/EIYVzb+Ngagoeyq
-FP0xr6fVzAsN293M6z+QO7NyqJPn3eUJYZt4tpsFeIhyLEMkcVgswqWGBviVx9cQj76KU/iDryC6arOQ
-fyKykX9+j1cF1CoHjFcS9qyhxY54vQYJfte1GFKsUu0NkRcnsSAMJ+eUweNlnEQzzCL5UP2UUnEeN19V
-3zCyIFeZ/FUgOqsqjXRbNoo4wjf4N8rQ0zF6JOWy+sc41R8dttLKcbuxe183jAVYkHNaCDflKKgtdlhK
-FUMtsjQHcYbTZ2tBVch7ww7ZSI8ZLIg4qeUoG0iG4cm2egOLKbMO32pLMYa0R8BGE3nQaEfbSbDKbWzY
-QBDxBk5LHSeYzZZoXC7DUL0yRUsJ+Bsaa+DwN25e9wR+b+rD9Ouv6r2UxbCgUxOkhSJ6ejNBrHwhEv2G
-vkX/efLTj2GGGSfBbwN0BGWr3LVWU5xGKr+ZLPM6FUE5AEvMl8EAEvQdNA3neLGpHzzzCadMkOhcnsoc
-EKAhOc9qH+tije5ZLpyYvPODJdVZFfckPtNDpzTgtpU5RB8qvhHNnmhRMW8Jr3od5kZQ8DDMYYjpY5hP
-51pQUGzq2dfP98fIB8L0m/ZDtemVRYoXY+TLpdEsUuTZKwsZr5rFDIKF9E3W0VRpjPZKOBvRmpjwlQsT
-nEn2SjgbJsgtpX1Wz1dgNKki87h3JP+pJg/zVvLtqv52Kd8u628j+Taqv72Uby/rb1P59k397Va+3Xou
-XhLztyRBYzT6v8H7aG8QvL8cyIPGg5Eh/Rd2NZKc0mdTHqwcLifary13a+PrqWB4JgJYr98nFItgNTk8
-Gwwr4zZZTR6dnRVecFZWU7Th2ZSf0rckCXjTTvIjFUiK9DMBHCJOF4jOQfMIugeFP0TfU4bIFWgShui3
-NRfIe3Rw+JWHLuMkQVOCNjiJI6vHi2EH5sP8SUcfaTfIcM7o6kfayLBq8URp9u7kEmdwkwy37VH3rfuR
-feybg9nce0DrgcaKBkJyRWaNzNmy2lVLrQZJtNWkoY3Ja9lP+Hq6isUzc1dx792NPahygZ7cyNZiGf6N
-CPmY4lUjvKnh0GIkqBg20d/Kw2U0ish0vVjYdLmGEGJ05nUEco5nCHbGV7g1T3Jmt12DEwFQgaN0L5fY
-Hhs9dPm40/XGkiUityRwQlZgLlrhC5Lvr0hvJUN0uSSMIIyyNV+iiBKe+qK7oXIfa76Ux6YZFs0xuYHT
-ETz38DqCv7u4FqnDrnsZFH5+koAnngL3zhpUPPLRHrJR1q19bi0Tap2A8/AyFku6tgw4H6LzcB6n0T/k
-9Fq/f0SvoyNrB9D1YAd3UetEtU8SZJZsTMwJ6Jgkm+Z9WFMulxW83Xw/sA6fEtLqBUgatRLMsyg6xdM+
-Tcrl6KoU2vARbQqqytDQLqgOBu1epuK1rr1sZtzRzth5FMJJtsRTcFvy8HQWkfliGf92kaxSmn1gXKw3
-l1fb372QZ0ksAs88VDUZriuSpe6zbqwtZeQeASv1b+f9qJpCJSauoqhwu023u1n5LZZ30rKZQqbUzXfR
-uleUiztp2ZJy0d2ohpzxNyJO8eK/vtu+yT2DDIqUlOegSjhNTgAiP7YpX7WGbJXjrZ/2oKh2QGrKW/fV
-h9apAP3JRAGeOU0u3eqH+izJczBsGCSd0Yi8e/v6OV1lNJWipW7WrWYMVPmuEbHHamq3GJsfTP5f7sx1
-rmE/Xtvlq/uGi4xLYPpQcaxxuMG4RSVdTWkDdtdTccLZuSKbTSYqL0hszeMIJ1e9YKxmlBp/bPXIaRvk
-SXTWJ19S4SpTjkJ7PgjDP0J2oxdwl8eNgwgmUR+Xj+uuITGcOz6HMak16MY9dOc5Vh5srU42eKHrVrCT
-6GzXZMr3dbl+1fj+TlkzTKaiELgJR+0lv/AgGiK1d/RZw3Ul4oemSxtOILKiza1pg5PO/l+QrezABie9
-Y4UHNj6rGaz8Yz3JvZFnN75mBMlNGcUc4eQSbzloYeYx40KWDV0bm6mNLXdY02AIRHW8Q3l4Z+xMeIim
-baOJQf24BNmkM1YY7e+UAb7wop3uVMnhblevqGR+YUJnOCFy+8aMBNMeUXp3edz134EsLc/zEH2nhSGu
-rijtOAzbJJMVEVjuVyON6Fv1d/wHCyulqUpCoYcP1Sb71pqK1Sme6Tc3Gu5+uoVemgVjS6+e+azW98qx
-0q6WtUwSi2dySrxveZzOVBSLSy38aIi8CG+5lycwvI1GQpNF4xhwF2qBnYjZHPvSyyffHy7y/aHjjCkP
-GS5B/AKyC5l6HevJ4la0D1QOrNPNbc2DkPJCtp3APhOeYtG2L4iwOV4pLpobKt1GSQOuUPKYuh07pNLt
-lCqd41Zvqer8mpJB5vJ0v5+F7gPk7q7uH3pYJrNB69FNiQ0t4kTb2Q66tMs5pOFw1jNKoBot8PBhO1TZ
-fkX5m50l514SYs2srPRvHwbHfaJ9WZ1gGxqQC7KNVKYCw9PHGq0OO4cOWdeZVMAkoV5dkO1zuCF5jA6/
-bFnIiobcHsoNbWEeNNYeB8tUEGyxlm+6pD44eLNpK6ucChzUurr9ygM8rHR1BB57e7TdB44LsgUf9TYR
-eTUReHF2x1cfKte+epfVCpPV9UsysavSQvvtN6q1xZd0MY3VRAXtdB7vu1K8Xt/rcIBsNNcMHVFtON4Z
-x6I/CtdwVWw97jE1LT5uqI8QKnGUR0JdD3a4Sq8jG4JyhG5YguveVP7066/8IZoKigPwVVL+wvF8G7BG
-8rFmaeU4YxiRlSPNt2idRmQepyRCR7lPTScybQctseU+Nt9qXxl0VOLtxFb42pT4SvebHhiBG8apcSFz
-cZ1V6YszQN8anjmhoCcwfAG4eq2TxIIS3G6cKPGViRJfdaFs9nsVp/5QtrxzhFb4SkLiuiLHmaTC2LxK
-4dG+KynmXtvZ1UHM7ljj8j4qXLXqFOY/VD/hdPwg8P8EOSj9QX4HtCQ8/9gqEStjyBsicGAXIz+Dg3l5
-5MPGIfuODx86NVmY0EWgU4YsiBBxukB5l0EJr608cNBVfdv1EBKk9O06TeO0sevmTtXhDKczkgSmj7nF
-U+e+E5Hpracg5HlKA/tdTkKSmHSQTF2HakyFxfBiM1LYCd9pr4BLfKpFJvFZJXZ7rOO0nSeCvPl7Y70y
-5BMQaNxHaqquCktj7PNui1tudxP98NolftYrVZDVjXeFs5tExprxzHlyC1dUcxGsaMYpOkMUqyVUfoyx
-zojRJ4l+XovyA/hpHnhfeAP0FO33uhlrXmTkKEKxx8j7wkPflp9KD3t0ZDru3yb9viN9gbN5ZojA8Z1e
-yNSqIJ730QffcNf3H67i1LUBWEWC+o60k0TgP1zhq67qKuKCrbrCFyReYdEUDo1To86HV0dgciqSRpDY
-w3CtND4NXCfMmo+pUcThberUzVUa+jDK+Z3IHUP9MhGR3wcLsE65G9XQQFpMf7CLjwFsYt9KNFLAds2b
-VepW4zGA9Opa2Nkz2DtQ5x5IbXcgXjDC14lOgozDE2C8ffw2uxLiOnzkOGXiu6265/xZn/vOigywxz2V
-SbriS8xyAcBv17epEejoh4HuR4reQhFbllS7kgO6+w+FIpcF2u4srlW6p2enwKAu+0TeLgNSRtECrr+v
-HVOdK22vMnZKroTDn1Xv9FXcXfEgrir2xsh7IEXL3NFtEp+hPeTJutEe+iB/v3fdvVZc01ltSz7K++03
-TNqagjeLwNacgXcbs3JTMPU7PYltIJCZrhIWhaf8HUtsJgwJtw75esoFCw6GaF0IGf63vrq+4VvfVkwy
-wpxtlfFRXfzI2R+VSW/9T/U77jXmLSlRndZ3QbOgXSmntRYuujPPN3Ii9fGnlpFVbiWCreH+lCF6DBe6
-7XyVgP1YVr6TlYBuZlBPs/wsy8IoZmQGuVF8wX+m2TqzXkehefVHQzWgAlSOkP/SLyN1YHCOaoOyZskR
-8sd+2cyygCCrLMGCHCH/yXQtBE0RXBAz9qYiRVOR7ms5wQOetr8Uq2SswhTViyzBM8iZPfamVAi68p6S
-1ZRET0YK3VOjdUmcXhwZvdOxwCQhqyHCQjSd3ORK1M0aoweBr377qkxtsnSG8EssZssAsMlFYY7mmiVO
-Y5fj2+52LqE4uv8kTrO1gITkY0++9BBNnyfx7GLs6dQ4cJHH4NhDjOCIpsl27OW/PJX2auw9TMQxRktG
-5uOHH9ZUHEt+ASkeka9ePFyIYwkVrxaIs5kFLMzSxThLF1X4EZa/vKcW7qSGOcxoRjeEBY7UBzQVJBVH
-0OOdzgBFNPy1cyk8SwgTr2IuKNv2WxE5Jb8Dah9lmIkYJ3wEOUWXClMoyddv1O4KhNf1/7Mynu8Ukqtz
-tn6seWWUJ5xnjOFtHqF4QbaDroQaJWjFymlbFbLyycbuyWbnh5bDLCApKz1rCRmXHc4wwyte8+KClLQt
-17f7+MJ1LtjA3VFKzvMfmieP+jmDCyzWHA4auhF7yHuIk2R86N3I08TUCFrinRQdqNy950C+Nl/k+vTV
-b63bDBF2ZrG7D4OPL+7AFLgJ4dIMXpsFuTWjZdhM+a7eOe5qq3cqR252bNki9ipJdQ8doidlw+wacfO/
-ZfgyjXRT82ITwHMGrd3ZN69fVcaoDG4j99aIRc5rI4Y4/0/ztKNyeBjZEMaJ5cKz63Y1Tj0HS60Zg45T
-WH58lcDnmkFDFHcFyw0SfNYl/B8peoN14v5najl9T9dp5M742e3q1R3/1XTk6r5g4xXl4rPbbYzgmDLI
-Xj410yMostYgorJwClevqQGBp5CEWDJY7jVTpHCwjViTKZReevUkgLM1YyQV797+UOnXunp8y9Ek9XTl
-K5djSN1/K7Bw7aqPkyn1153B5eqvf8mDTrRD71Fl5K/rpudWT57cc6cjgq4e5tdQ0TTtmQJP/SFyxBuq
-2bVfkmB38RzJzpkeiPL57tw3JXmZBHJdk6Jyd7vWhAPFfWIGmQ9szplV6I2NkjIsBGHyNDNSeRKiT9tP
-6aflp9UnDgkTRsfW0HpdTmmAN/bZzhW7eQOK7CY6V8Jq8ugsZARObIEPPOSNP+jrj6tsswsivhV4cTGW
-8/RQ4MWmQ18O83nLCcWaD5xrA0xD1ok78xnAxMZK9EBPkcWv8nrgJpPCPl7Yww8PDvwa38nW5118AmDq
-Pp5VPqlAjHx8lUbWmMvQ0m21AI6QT3k4y9b+sOmSW5g8j8pE2k0wyY6OpBBHuajxIpeS6cy93A1l/W+c
-Oo06NUU9jIahpb8RIYHAa+je3cTSJmwYCCYHZ/nFTv7PhM1IKtA7TiK7IWiWrV26/zqdrciqk4YApp2G
-FEhlg+ggnJxoPMrDFVmpG4JqGWD60EPFuer2zVhzEt22FXdDiNCXz48QDwtC9CQBeg6fitU5TCgaS0EY
-8hCF6iK9dJ0kwzppv5CP1dNcxWA0OTw7brrRlZVJrlVtZMsOnBJxPt0KwjsJ34BsJ38T8A4YqSTGlIgQ
-UHpNPslAfSrYmti/6Zyzkmg1s9XQqMxCe4QOTa1sJ7EPUTzHM3KEvC+8IdKaM5rC8z+RPRsj/XmsDVDA
-rjLL7VrgPCTH7CQniD4KFd0YU9bg+k0cXdmz+gG3e6EkFuOpvqIyY0lNomxycDZEUTY5PENfoG/Ojp1e
-yRrlKV7wsJh4cEmha9GSvucOmrV/eNbXRAzTaYx3uMT8p8v0Z0YzwsS20gsAG7hVOgWSyf9P3tX1to0z
-6/v3V7DCIooRV24X2Ju4aZGm3W6xRU+wTYEDpEGhSIwt2JYEU3Y2Z5P/fsAhKZLihyjHKbp496IbS5wR
-P4fD4cwzBtUVHWn29CqkWh7jjP87bPtizx22mmbFYU38nAZ7GQsME64EN6vaLU1v+sXoTa/8vNmj4MwL
-skhuSELqNMPfbWpFj5yjDOxibby/eln0jJ2r9XTS9uZfImZvyBPLWH5yb3RJdqlKNemacGVNJQ9n8XVV
-O3wvFEErQF1OnPPZJbkEf1bqkeJJMNuYap5HAouh8Ilf2iSn9BUMLrsUfsnrJ72kjaEM6CA+sl/cXwkQ
-2TtXUZP3bi67yvrW8mkV9v125I9lVuS4bHYB8SaA2B0vi3KxI4A3yfAYKfQ733EWubQVF3kn7qHI+yDl
-VhvSILKhBx1U8B6hPFPCsxXBbR5ucNdf1h0s0RXkLG/lo7JOMxbfudtAN+bcRNXNDAw/m9s0vyJGJx7T
-HJ3aOIEbkT/xnd2mGE/YB9+AHwO4bN7VkH7lYIHv4MEC3/nMzDPcvM+L5kuxxGVmpgd1IMAq0yf5YHBo
-W8AetbFH/PdHP77dskrhBm5ZlPg8LbGedHVruV6EUN4kr0r8iWdvPjhAz7aJ03cqYAtt56klhEn7lDWh
-SNclb5mS5nNVfi0XZXVbnl6zKKyP+d8K4Od1lVvxX7YJ2cA1nlQIxXJJvrA3lg0U2EkK+stWSm/JrvBi
-YIRdWOonZu/UkVp2vVnir+vlYboQjs+HW2YFdsO3gteQuTofBwGi9DFTekI6FifNekOaU/JHs1oyZekt
-HcQ9evlt/cCi4b57/UPthyklPNe9Bck/q5bLtCZ6xplibGZlUVkxLPRnnUdTF2C/KRTkwhHEnltsg5zJ
-kfAIdX536nG8EVdBYhqPbI4D4tLDBoLtPo+I5UQmEIdP3hQ5i2p6FLZru+faQurUPchc1/aIikw4uTPf
-9qyTBlUpOnQJCdUBWi8+0PWPmLq3b76tsR9U3rjkVBt+AUA13VKfUtII2W0VUrw8Oc14GjKo6Ef+297D
-bE9s5Q776Sv6Me8U/pjbe8vY1ve3a7uC/rSB6gv5o7TK5MLcr+fKft7DyRdw9IIMBWwMYioEtOcbtsHG
-AduqKh2cu/OJLXLQIvqouMOjKZpM0Pu/a0izOceoBkHFsem54wOi9bGyDMta9B9PLaY/xEUmJNG4//jT
-4BXxnH0cpxkt15AdkOpnA5ZiromPQZay4kXNK9Jw3C3u/v2olttxdZ+m3fAtb6sHJbP/JCVaT/otpWQn
-BZfyRsmh98F/LmqFpJSOnTSaz0QZKotkKceBSrVy6Y61N9V6BsDXnEfyO3vwBsUH7F3ciXIULCec4g2Y
-kwGijeVThswTnBtEl43QkZ5N5ACXuYPgfZmbxcEZEAhEMdhRjXJNOiNQzHLsFZQX6YzFb3Y/w3vCqGpe
-aJ8W2yFXqOzD69fnenU5hx6nZ5BTvqwldjut64TwqRgpe3I0Vkl4urmLdNYzsy/SmT2p3EU6UxPXvTvv
-YfTu3M7n3XlQXrrzjd+g1e8PaThBSgA6uOng+GEdj8K85lcqtJ7tLXydLEzHMVPrFkkYavhAXl9N/217
-zKPBC0W6A0jH0gvCZfH5RDoMXN+lgjKowVndkwW6v0fbxJn6EvCjtgnDaEu2Qw27jjrm9aAqHhz4qghd
-RNS0Zorv2zZZjFFD8ut3aYPbFEM8+Pp/YxcoEhwDwMrtdmkX92raHBy7cYSLFSZNuqqPUUPcxbbML0JJ
-+USbPvbCEx/Dv3tCdzIjG1lCIUBugX5JErvHF1+ybpQTV/CpkUOohmyasOBDrE9haC3uoFil4uApwJZs
-Y3i27SOudFCULvyf/UpWmJB0Nsi2BNCzs17RQ49pyqU4XfHK3+qdomlGorRshcJfW0/LgVnrDneRzgaY
-iU7zHLbZIQ3J67Ydeb2HZjj3ROveCNKHsTUFUJrnhy9/G6OY4KwqcxK7Eo7pWynrvbwe0HG+JB0BGLoL
-1Ud9D7C4oMB3g/Wg8OMjsnqVGp+BZUjqh0ZCrjquSGEeeLNDGGuiCRDUPwsU8D6NFvwguMOV7e5XtY+9
-pO1kpuJXtb6EobyILV+oiFuQpcQT8xIUzn4ysyj9aQ1YInrEEpk6NP82IGlmFqHHP6XmeWF+Sh6hWSH2
-2xK0BDuXLGdsZSpoioD+6XSPQ0tXei9+OY+9UHFaFAWM1lt8U60x/3F60+D1GOEyl3+JAstiVTTWEClo
-f1c1h8CIagN3AC+mNiAzS9hqma6wS2bC92m3MK6vT+w12i10nygncm63cAVnCtNyW/x9mTsLgzeR7Gaw
-14ggFPZoP0C/cvTYJ14p47mfL4g5AXZx9KqdI3vjLnsIo9dy4j2e/xo3l3RiWTC2nbDXTS/gYp/mwC1G
-kxneT4Yyzo9YDsitQ3112xta3OHmO+DRPjtGMbtgiu3HL8HnWJcsZXU7RizCQPnzxWgIls+AKn6ts2pV
-lLOBlezW74lreZ6SZqcaqv/S2v762+iHJmIJTosA68KOCUsPldYDC9+GVdMNur9HcTziGRfjcTxy3t3D
-1m5rgtQqIaT2pBNnSjjzySUa318dTQQaxL1hDnkwYpB48iIRkWiHn9iynLpTKwIiN3RZQgio0D7WlCtz
-wuBSJjPFZW6J8uMKwXFXQzCLgv50rClXLmdoaDPrpXFsscZQ9ehY1ZzGtlPHDMtCN+1tgwCLhklu8d4G
-Xem4o0z9xwNYIAKWdFOoHtlZ3nUUVzrvpJaq/Go1LPmI6aHyN1M55W8xjx1qofBjxgzhma0O0/tlnpYz
-HJKONS8IPcycVeVNsV6ZeCpdqJi7HouvBQm7a6ASGxzBTTxmjXn8udj1UhgkWZD8YVmVeBQfM/c49DDk
-aIyJuHgjT55tJlhwKpXzj7YRlA8TV0Kwt/OYDmAvQjkuc0nKp3wQoVgQklpdIkEsYAEpyPZiPQURw2qT
-xO3iCyKmS1PSioUaRMovRccdCRZKzgWXpBfHQgeDAKB41RcJFn6vqPAscesScZbjp+MQ0HxaNB7b2sg8
-kWX12VawF9Gz11WrekPOcOMfjCVO17rHYm6Nxrktyry6Fa0/jM+AsIFU9vx+PR7t5mS8uzyH2ks3wDH6
-5+Fn6twf6y0Z5Mjud9gId2238LFbHB3Aedniw7oCGMnLjsGQg2DqNkLFKMjfjzSYyQ7KHnKBTIIemS0A
-aNJU3iBnLqEv1Rqamm82x/lmiR1caAXTMs8ZXqUCaIl0UEvkgwPMFlAZBgWo0wzHp2y7A3I1ZosvHLyK
-6pTcZ3UBeQU+Y5wTdJotyup2ifMZQGZa7r0YFfj1nS1TuIrjuJZ1uqYnevnKRXzdVKlBRh+6CJirjEHC
-HruIbO7anR6xem07A5QNz22f47Zm9dIpDw44LzbnLour5GxeLPM1LpUklX7EYitcrBdSn3fd9bpK8ywl
-zWFUlf9T4zIyvcD1SYtehEO/OXt6WWQLS/I1ZwClOUiHtKQnf7eZso13O1+xSTbH2eJj/jc98cpsP55e
-A9MwJVJWCx8yB+urhJefepmK2wgGdVGUtGljV3VHfl7s0kKAZuzKSbtnY7cjqECvTih77yWbEe3jGU/1
-v6wqm6LsS07+EDCj5SKSY9U7CkOySLimEbR16iHZ1LklvYJ3nTBoQW2h8LY4w18tN6Rqx4TclD6iMx/2
-235G4j0eGJva2bIiWNnW3KnLWxLmPzqAJi3vlNKWaLd9DwdlMetKncKTqA4U9VnSM1vCl99Dz0zRe8Td
-f6JuMxGjQHUN0K7jsB3LMsKe/vdXXauIGiwRXBVj5uxUlwE752qzbIrAkNpOaK3rDqa1LIoQHU8bDAhm
-ZT6qWjo86cuM9IzptYFzdPfckTDM8Kk2dDhg+2IUX+Cgf8YiVcxwm5C9re1X/6ro3+DoGLLLgU5rdumU
-7kgylkLxHGs7TrHMQ3oMCv6kPcbqFtJjA5JtKno0P4IQTNkfRmyFPqffj8ZQDQe5NDrVaTMXhFFvaW6i
-iui6j8YQ4x5Mw7o3Got+Vm4y7Ek5vRJJAiH3b9IyzDmecDpbrpH/IkkDGQ9OFHACSxjIz7faQ2r9ZCuO
-m3g262XvLH0YYIcCuSUc2dzRd7bYO6vRyZvegdmP92bRAdvhHTuFQKHLqCEf1nV05TyBa/JZp2Kxam7a
-kgE4iss+dZidBxl+aajSQIe7CHZTcezoIbLWowDtR4Uace5i3pkZBkECv9lX4VFqiYh2CF7wmK7SHOdv
-Wdi4Q2PjpudqNlsOOUSROXjkKPasEFsWuP7JWvUfLlkX8xZES4bDkCSJJxek1mi/cuDIicHTa9jm7sgr
-8gZcCXeHisf2g1ex7cNX0AnTgBOH1mUGVsC1CRPQc0XcdzcSdg7SB5IlDObD2UpB6IRjSN2mxVEHbwbh
-CskvVXkYM2OmJrxxbxqOkxMmRfvnLltTj0yCAZz+75b474U0PWbrq5oQP/F0eFLUbYuzPjm8HP/zcDi6
-Gk1mdBN8+W3z64sX14N0QjYjLqpNNodTMb/Tsrx0uqDa9T9Ga6ZYccWhwRLUc7oIOB3Xh91DxT7OQ1XM
-+lyaj9yhKx35yYCtOFyItFWrjwNSRKpUgMwhNlELu0vPJ+zVffB2y18MtwhUe4Yq8ibuBCaL/5gThUtv
-NKC+Ri42BzfravVOJHjtYQUBPXTgxdVpJPPARiP/Ny5E9tfB34AksdHI4Y/NTjMOs4xvAcB1ZOj855ZG
-OAYv0BGKIDR8Gzj12yPN0AkoJgTLn8u3ge/AynvQUbz1RkMnoWtn7JYZ7X5M+Fx1QraHZjx0nQvKSpwI
-ApLNZYsnqUOaLUKrAEbYJ6lERjkHVyMtM7x8wspI/qFV+l14W+2/NsxxK7Qi55v17Gl6paacB/RHhp9u
-hG5a9maFQkFTLqoFLj8VpJGxbn0QEybFIQ9bSzd67jb6ge/gHXUCTlJS9gCUCFBRoUX/33lHWdHD+aaZ
-628uoxwvcYOjK++57jvL2ykO+dE7SmQ9ZX2XdZG8DyMWTUrbSt7MUzJ3IZUwckZ3kdJJOrJ4XzVzXPa4
-KHRrbEszDUW6zoXcOaH3+NL5gBJOyWqPoLVtNGVQ0HZboUGD8cl14lXGAg6w6ihEo24vrjGx2j+/w5sE
-DqmtEqFYUyqnwtBUC1AfvxJFeQd1WX3jApWvFsk5Xq8KQkSC93Yqqy9+r9bA7q9qiT2s6GueMEThQ59q
-DND9PTqMAJ5H/75QWo5QhJTHUaD2w77Juh6doLZHp0Mn7uOmJov0ZdXYYWrWeL2isko3p5kTYDL54/Ts
-z2Mhkqk4RQzNHS6yeXbdRKimz0mzTms0Twm6TnOU1gUUo580PBupGIte5cWWZ2v+FnFu3yLUpNeQgvzk
-W/T85bfo9bdSUGoE6Xpd3X6LXr+a5MXWVYhzfc5z/dLim+XryAxmoX2y0+y0XekDsxBwPyroobD18p6W
-qGpcQl+RZl2Vs9eRvRgoSVBu4i44h9T1r5bFa7oygPMRqtERpz6i1PSlzyOX85hslrzj2b9WAGo094vH
-FhQK/rVsockvRckxZy9b83tEB0cAQl1peFIWFgauVNZuUnEjisci24KMZz+2MRt3Cp2SYxRlDc+80NFI
-6MItMqmYtF/jeskQheQzvoXqBOsjJsGPVEeYhBTgCvRvdWuGEvOUvC0a3dZ1XZhRcHxk4R06UAUwl/TP
-tMDs7jQjuKHFjM+METgEGnsy11xqufRHLMxbktN3zsgf5mbIb1OY5tN3zUj5Jac9zhbDUi1oHKHCyVvW
-f6yLnxnB7MiLJtTt1RlujMFzDJy1R9c432RY6VOyWY2RmrmHbFboCB3WohlvUM2acIxejKZdt9OHDt4Z
-viF8XiYf2AQgxgSsNaWEkqgCv1N4TVmIYsBPlVzmYlNw9LI19vms9Sw/OdP5e9H3RnukqnFGv2hokooS
-CeESmhY5Vr4WpFH2qDfsNWt6fsGFQastSdtyNJmNUaRqXxbFyKMIgZINgyPVIImTOe0fHXZ2cSvqfDpa
-jjhGC3u/atvMYmUzi/XNzOTg2cuikpeObFuZyWrAThZ1trDP+BZ2sAh2sP8PAAD//xSmxRD4VQIA
+H4sIAAAAAAAC/+z9fXvbNrIwDv9951NMuNmQqmXKTptu14qSJ036krNNNydJu6e349uHEiGJNUUqJCTb
+m/i7P9cMQBIkAZCynW72/I6vq41IDgaDtwEwr6PRCB5lbM4ylswYrAO+nDirdMUS7oc+zx0YPb5jAdoP
+N1nAozTZn6fZKqgVes3WGctZwnMIEgg2fAk8PWPJnW2QwVv8BRPw5ptkhgjAG8CHOwAA5RuCKV/jH19G
+uf+c5bMsWhPIBBxnXP/8Oo0ZTOCg8fqXnGUK+BX9P2N8k8mKxneuvMFgfGc0WjEehAEPIJimGw4B5FGy
+iBlkiDnNYM2yVZTnUSqa8m3EXzIedDRGQpUfagTIjxUJQRxTdfmoqiyHeZrBNM03ol5s6HM2zzsqLsD0
+NRdfq6rhDWOwSkMW56MomUUhzoVFCt9tWcLBmwWJy2HKgNHzkmUMpmwWbHIG//EGNjnLgS8DPiAaX0gE
+orCd0BqsF7HWwP8axBsGE4iY+NkY4e8u1pn4ir8aH9/wgG9y8Vn8bgC8jVYSN/5qTp6EbYN4E3AWChjl
+hWY+1VpS9Cx2x9MkSXkgZ66tLypALxjCgnG1MwKYQAAfP8KHqwadL5C8AP/5+LG9Ml6yPA8WjECK3zq4
+NzzI+POAC8jqSQf7XRKWkMVvHdyzjFFz5DIM6i90JX7JYgLEf7VUpptsJkkUPxGKlsf+JmpC/5jmnGDp
+hw7f388TSZr49fEj3F0wDvfvY//TO2+gb1vA2SLNLkW7igcFUkyOakz9dZbylF+umZ8zjvPtl7fPYALt
+CYF/OHGS9BwmIHiuN/A3fOYNfMFyPR6t2Pf0c2AZyCQ9Nw5d+e1q3EnpLmR+MgLlOqvoVBfZa5Zv4i5m
+I4C8zMhkMhuPyRQWU2enhLa25Gd9lvtMLPUWNeVywR/dy1nP0wIdS3t7uZbfLtfNb89ZEMZRIr4XD61J
+n8xYHLNQznr51ID6fhPH86gEqx41fSc6oXMjqu0ryMpZz32FYL0ob3UycU36Vzc9xTf62Z6f4uN3SbPk
+05hl/G/sUnwvnnRACsTYsN3l1u0u1213tPPkMIGEncPTLAsuPWXtRXPwSiC1O/APDxke9vFphMeoIZwG
+shoCH+P7R3Aa+DFLFnyJz3t7TSQFQ0D6T4Pj0+hk3PquEOqvN/nSQ1rrhwA2GNTLXd1p/xJ9SWNtbLGY
+8sEiF03BX63ukCjM/TGV/TGTYybgx/jhEZzOqg6ZmjsE+/J0dnw6NXWIxFr1SMkb+nbFm830dzYr5q14
+aPTEz4yFT2dnAkQ+NLfDNZ3P5S/7eShvn4cqPrHJMrn4NrL3a68a4P9Is7wGrLxogP4U5PzpNMGNJVZL
+tN9bChbHvvbbRqFXGdtG6SZ/EXYtKwXSPJdCOZdk5UqZMX58BKesmk+heT5FiOiUHZ+GpgmloBaTKgr7
+zqSf2QUvmKP4rbLtGl9VDgov8lcsCaNk8SxOc/N5Qc9n1DXQj9XQqio2WR2vwWERANXGdncCmyRk8yhh
+IZ7w7hYQyob18aPEW+1uA90wyO2LZyqLNvWrBJ4Hcc50R5pap6rnCHrxQ5Zu1h3bXQXo5YvWXoddu2Uw
+gXwhf5suSvlCf1FqLuh8YV3Qb6KYJTMWSozyqXlvyb/LsjQTMPJhbOJq+aJ4MOyn+UL8NO/J+cK4Jy9S
++X2R1le27A/WnAJlrzHJF+rnjbLY2LTKni2jOMxYIotr2Uq+KMH67ddKgd127FnXjl2grXYoZcbNunap
+2omvKqjOdHrRJdUQQF57gldbm7EzFwVQv64swXfryHzR1ZMSrbYj88UOR5+zJD2PWbigVWZptgrZ75hT
+L7PbUSdfdJ91KtzX7IXafBKTQp1LcicqOINtRqmg3rrNOCvuse460K+1/KfkmGu/x9FnbTn5vIkSknys
+xS/N8ebZktEaWFdPmhuX2mT9XpP33mxyL1+0r1YlEhzYCk6+bx7QJT12RliC9WWEZYHd1u+6a/lKrNXE
+bcygvusXD5tPS1FHvqi9ad6ngyguqsgldO1d66A+w0NYSDtqUaD+cmzjy4pcWKMDCJLFJg6yDk2BhNrP
+0g1nPWHzIIl49M8u8Gma8pxnwboD7vf3G5ZddgDhgTDLZ2nGOlUfKJMoQHCukNTx6RqnTNEnqzTcxMxz
+i0/uEI7vAAC4yeI19oQ7FI8E8CxNeJbGMcvy4v1qMctY4CeLN9hA/Vufp2nMo/JrsngjO654s4n8YMaq
+77M4Wk/TIAvd4Z2TwfhOQZ4/S5N5tPCO3Xs0Tq+ydBuFLHOH4N6L0xlJ2Wovl5yvlRcVk6gjGEKr+BBq
+hdUF2IL1l3wVP3yZhsyrL1OWBNOYhUd07h7eqR/I32+ijH0b5OxInLSrpacsShy48yWduyriN0PImhyh
+3iAfywg45UhX/aTP7sgdNrDwiMfsCNznQb4sRqD2na3WccDZL1l8BO46yHgUxPkoLMCpJxplZuW0URE/
+41nsapssaYs4W+VGAl+Ir32II0SdhBHCTqLYxToz0oQiLkZasH6EIbJOuhBpN1nEJM2Eyc+9iCLYbrIQ
+rJOuANm9kayn4msfqghRJ1GEsJOocrLm3dM/33H+5/0XQN6v92Zp3tGD8IxAencjYuzZlWne3Z3rLJ3G
+tqX6qgToQ2KBrpPCAm1fArvo+1eRNwuyMEqCOOKXRhKfqTB9yFSQdlKqIO8kdpEF66WRzB/E1z4EEqJO
+0ghhJ1FL2xIhVeqvETvvR9eyz+L4sVgXzS09ToPw78kbFmSzpW1Xl4TnQtBkpP1N8b0P5RJZJ/ESafe8
+pLOWeUrSZ2lY03NSUpHu+Uhgt9G/QjJq5p6z/rQLVN1sk8C6Z2yU8zS77ODrPxZQvSauAO6euwKum2tu
+uGV75AF8l/C+tK033Wvq1aZ7qwlKVbq55xSQXuNawnePbQnafXaV0lXz8bUE6HWCldDdh1gJ2Ekg2bjl
+lpUxY3kuTM5M+/YROI8IzX4c5fzxo5Hy4HTXPUrYubH+n0mlWNFgISFh5/uE8fGjUfVbT0DjjpTyJcvO
+o7x5a8tYGGVsxt+mR+CO9P1Yuxz6UcJZNmNrjkdnErUoN833zauaFGDUX+Kfi5dClnP3SLnsCa6pEwDJ
+4SeeygKUCxy7/7X/MkqidZbOo5hl7glMwMXLpzvWFpekCCxtkKvam6txqyuuatfzbJPg3by4HtMNPEtT
+/maWrln9+l3ADKGCqN2xy7f+vTTx5IX/2TJIFuzNhqZGDSEZ+g1hJpQ8Q1hLhaLmmlzgpckGk6KMf0/U
+Id6PTaXyZZrxOErOYKIqyFqdUkpcFKmJSfJSE6zAcdmnynu/Wuee+y19pA0Sjt17uexe2Uml4IN+vG+N
+gotWrm9Yto3Ko4UyMIRsKJeKFIIM4d57daCG8LRCURu1/BOMmMCZ83Tt4UwejPULUoAFheqwqmjbxIgi
+2rtysGX1FpVpsoljk5C0wFZH5uMxhYVvK2YOk4nCzl3Ygy3sgSv4uaXuDyDaIwRI6gJs06El96rVQVES
+8Vr35IzzKFkY+z3Ysu+EGAsmUAD7b6rXY10xua/qij6tf9IWf7+JGFcL/Se+0IJuWZYLvXoJ/Kt4pQVP
+1yzheTitCKvj8V8Gv6cZ6t4PUOfe/Bgl8qMWObsIVuuYVYIglarvmh+1KMTuqOm2t+oHfZ9v+BIm6tqs
+gykf/BdJxL1qSDZ8KTEPqxrRnC8JVkx5hZbYufL8qjL8Hthm3e95mtgXpZy+//Hm7z/7Oc+iZBHNL73t
+kCb0EFwA11rDlKdBrxpYMktD9svrF8/S1TpNWMI9LOttB1b8oth1a9hacWfs/ek8S1enqxr+lc6KJCv1
+VMF6+VqcF7zBuAX3XsL9JyoVPNqpGlDv/RXjWTSDCazqXzIfVRERk2eZ94OxrpmZrUnrIGHxszjI8zqz
+IU0hMft5dKFjzOILTCYT2KZRCAcD+ADFS3AI774zbjC//Dzis2WBX8dRZ0HOwJllEY9mQewcFa2QqPfA
+CXGnypyxoegmQT1woisZJfPUWO48yJIoWejKFZ9MRYWdl65kLjZUY0mS4u7UyJDNg03MdUXEF8eoKWwN
+PlrZMRz4D+1vOZMK6mpSnLHLIVAZ3YSgDzQfSmMo3fiGLGac1Sk4PmOXJ7Y9k8U50+BqI4GJILB/Hyy0
+7TRwDBvVV3VlUD5bMjw6fh/FXLW2LlnJPGP5slbvnEB1zIS2v/d+iLrDJhOpV4QIGzXWdvtoFXCm40Nr
+mIhDJPYI3uHXkRBD508EwgkehzQsU3wVHgqDQWuMfLkElJsWukMNzMNZNEgyRtUqgEqOTQV5tGJBEoZC
+942weuV38Rf6GcvTeNvqjytNM2ilKo1gWaad3n7G0JqMvjex1p/Xx84c5bnxpXPiKadnPRcP0ShyFdXu
+MdVPNHCP/skgnQNfMojTRQpRAt55FPIlBEkISxYtlnxQQNDVpITDN0mwnQZZfQ7/Eybw4GF9YqdZtIAJ
+/OXgoP4+RvwwAfdPX02DB+Ff3frnMMjO6Ovh/OGDv37d+LqiM5T7py8ffs2mrY/CAyP/J4yo9vrX6SIL
+QqITviDQ+udZlM1iYnLHtW49Pnx4MAT6H5J2UpdcHD+0fqUPBEKt1hbWfj5pMIktdmX4pZ+zGCeN+ycc
+Ebc+/fxgvWZJ6Ln5dtH6xHnmuWJs3SHk/9R+p1kgPlf159uFrPZpHHtuxmbcn7YqwFXkHR9XTYFjGocH
+smNOGvAs4cii9A3AOvQtmOEJBO+804Xb1YSOHkDitDDZhTsUs0X/+dL6Ge14a/di3N3KxXl8eDKGK23B
+S0upAyplHBO0EPHDKFilSagfmGIi7jQMiFbfy2GL1tY+keM6fQm4GYRyweEVmV4cPjxorcGi3Dku0QP9
+9xz2JuBCTEjOS3TnFqj9ncHkz//ratlrrkorLOO/YvrpkfMsPSOpzfky4sy1AO0Xc/mw4Fgdq1KLURn/
+L68zCXqsRUtLiib4D+3L0T08OPiza+tQWy0X5qVTzCPb8hGsX9tx4lO+S4fpsckuu7AucoVYbelLK2Pp
+KI12Ll8/8Mu5dA3m9cDIvK4zrR9opjVyAJ4FSR5h/c+lOhKPEQ8bxwh5RH2WbhJe97yvn2GNriD4pyLZ
+29N4cNQqmcCh9iiXPtUfl7XXiZIYpZhOnqlWbZYo2i5DeFxYbubzmJXTuA7eZxm0lkJtdgwhUiZINNae
+iKvx9HS45Rh77WHXQN9oGd18Ke26VBr9LZyZ0w33ysEfaqa71mtDOfjXpnQQx7r5E8RxQ+ZCb6SKQyPy
+1uBprhBoiUk1t+/WsnlM1VsR7+/3WDh0qEBlDUzgnuf+qVTcuAM8H7U6Cj837CxbIvHGPVaWcQc7XEaj
+ufjmR1oxBv4RWl/KPUAC6/V3BsVU281LM6fM7WwTkzP+hlZ+lCavUYTkHQwLyqSh+kBf4dWgU63Ylo2W
+GjSU1OMlW3jmwwTc33777bfRy5ej58/3f/zxaLU6ynN3fKeIBiNEVSV0vXgJhspHVL6xyo4gY3GAmhbs
+nCPVAXDDNxkqmqME/pw71Y1rHeT8CJw/5/vBIlXe5/gyVCFX9Galvmm/WtKbpfqm/SqkN6H6pv3qJb1J
+1DftV5f05lJ9U7wSA3AHR6WcIdkmRjWWF5wNAQXV2EvFpKG7+5ol32YBeXMEZ36UhOzi73PP+eAMxiUQ
+2fProK5UKJIN/RyImABnfr6Z5jzD2VbWoQAXhgAqbJQsvBIWLw9DpWal7CaLcT7RQsb2PXFhr+wNl8gw
+iaZKGgdqkfvYM6YiRa8V4SYG9aJFQ04XKJAyISmgBjVvzE0Wj+9cVYMllPn/k4ZrNALkvEejEanFpSHZ
+EzFGy2CdpReXfs6yLcv8MD1PUGDnJ5c0ILj8Jw8ODr/eP/jL/uHB/aI/Jg8O//zl04MvW/NBIr+V2UCV
+95wRDnK2/Zcv958/dwZtVERzX1TEGZ1BxzzJGG2o6VnEPKHnoz0HGftlrs4XdrGOMiavsmIDqwCgFMSV
+zqXPG4db/FSEZvHoYSEfBrAnsMEX8OAr+AK+Pij+d3hwcKCq5CQRMAFnXDxMHNgT2Hn6y9tnb8R0Gqje
+Qg0Rv4KlFvcmTGcb2htm1B8wAZbPgrXoGKTSobrkS6ms2CvR7SFR5HgzcmqdnLEgVLpY7VV8/u4/tTUp
+qzCASZM4P1/HEffccaERLZ3KyKdsDBE8glnlQNbwHyscaGfBseo2dr6MYgbezJ8tg+wp9w4GdCB0oXHC
+p6LK4sUF2z4C4CyZlTxDNFUgPBjo5CSbRPaCiloUk8iVagYadzBh96D0PMuCnGm6XjPtHWcI+4eDWnEl
+nNIHtR5lQF1hFLqfIpxbL54XxY1V10sPQZBCs75JyJtlel5ZH+ZWkiqw/XyZnrfJaiK7ZLmBviaqIVyy
+XCFxNKLd5ahgzjkPZmfplmXzOD33Z+lqFIwOHz74+i9/efjV6Juvv3rw5deVrZhQ76C8CG0r6tZhjfZV
+H8iVR53L8uorbKqiXHhjCiiDqu34ZGyOeEAl/TyOZswb+JK0kp+M6VBEG1kZLKo4kgrG/bY4kqI0UOuW
+d7BPPVD45VnNvErXn4aVV2nb1TC0E7ZfGlsuacNVs7nb1IME0G2J7KphUkH64pXXkLhIOydsuyL15Nll
+o8sruICnU08g89HtSXtlnQWkw2cDIxrX1ZWjSVAA3b9vNMZpXeeazXSlexfZgxixaPVoliu43Bhl60MZ
+fE5tiqJnrADpSQ/IRFQl2eRx2waOvRS2KsK80NVBvF0yqs2dLbN0xbQwP5EdW+1KzMKIp5nBVkx8hAmI
+H/V+Eu/8eTrb5N5A+w1Znehkb4DnhV9y9o8sWGNjmqZ/llIaP0xxYePLI3CDGRuhbyxZ4dU7bNgqsz2i
+s4yfpOcNWdSVnpZ70zidnb2Z4RIWTuIvknmURPzSZiwj7CW3ETtHVsASLjpeyxN3vsjTAa4YmNpAjbWw
+GYXHk+3JRcdiH/9Ee683gH041JecpfFmlegLRwnzsvR8UBxKWgjKMlLK4K/SLXubYqGhxGzRe2ssQXkw
+VRZTMKW1lFHou9ytH1SUZWWY2dkmScR4KrC7mEII0dE6zQvZESKoXTKKPze0XBoUljLQlb3Po1VXYQQZ
+lIa3t2FZkRXBDBHOF+EFc6M5hbQtK8D/UzyO7chPuQgFKKwvatEAW5K1twQ6ATcnzK5JvCUryLeLU1UY
+wMg3jfgDbQRFR5HFbr5dPEnSc+ril6hGncdpmnkVl4BRcUCyVEk1wAR4+mwZZNxT+61TXqZva7JZTVlm
+bGtxRpqn2XfBbFmr0aqbbS7yRNz13Q/u2AjXqkyE0lDr4cFiOwQeLM5sFRZNxUol54DHeq1K8w+LkMZ2
+aCFU37k6NEgpTgAaeCTejPRqML7Thc69slAV+rKT8Z/+UlVlfk2DrHM1XhkXXMnrXPda9ktpZuEVTMbQ
+YvXwWTvQoJo32bc+1XXAtnlobBTteoDWwREZsjusnfg+fqQ76WDcURTZcVW0OPVpinbsVDucaNfZQGft
+JpwYhJelWTcJnXaTxRA7WNMRsNWaXzrjzs2j7Wih3TRs/hg6vWnBYzOW6+xOClZ83DAUbfKwrK3KDC9M
+5y1ceRqcet74qxC6Vbi3QTwEnpu4HC1rMsw+3kPmvQ3iE92mMbBskZKXkjjGUI1OYaznG13bwq1sBztu
+A53s/+qOrVgPdq/rXyt7F1dsOYv1lKNj7REN8FD7HaXMR1RLm5x2hTS1j6Pw4gQmsma75agYclHOwh7P
+2CVK2Wss8h65c+k0y+KLny+jOVlE4wVdvDpjl8/onjqBwy9t/Ju1PBzoq8CCfmMs4c+F6q/TpoJUi1rR
+DIVj6SOXUZy8PyfJTLGDiINycfGpHZVrTnKFs3BeQYsPOCAO3redVskk5dH8sqVhll9X+eLXII5C4/cy
+GLjTRh1Wxjuar1vEG3D2kgL2WbbmFiXe3RrtFB3faxB0F+u0uugU1FnbVxCp2CF1UtpE3OoNJA1pXgdZ
+XmL2GmADP8hfRnEc5WyWJiGKiOtOaleNCHVivGU0EwNp8itMYK8Or734nrHLvLHnXd2pHRiUijWuEDjP
+JY4KaKwzdKpLexWMOh5SkFWBWT1C2ieoM4a2P7qjnOrQUorzsT53SNUOjD3xwdKBNf+ROlrrmXUzXUW8
+z4xTlpI3GNsgytmmOYneba40ZVltKCEArSnKUKGb6tezUVMOV+3ieBc+UtlgexOVKQiOGhypDfg3dpkf
+qSPTBvmZ+MlRnTXesezJygHesPCK491xFTQJ9+7jeqmTTnfken9L9masC+WIVI80jxGJO4Iw9Ow8x3pF
+aAm8ymAsWOnNpE7lGt1kMUaxG9ziNZW0+fLzuJed0o31PFXYsh7Hie5DxE0OD/IYL777dfmDBIkjwWeK
+PUE8f/wID775pg2cBmEfnoRw4r7fNl1r3CqbAoE2xyaKqis1PQ7GVks+GvV8ZBecFgp6d5RtkvwJ4Z0o
+agRR0W3IUzdJIR5tH3tHI3i7ZIBaep5DwlgIfMmAyqRxyHIO8yjLua+X7leoTXpO452lol+xFrFfHy03
+tOouMzTCiPvQqb8K1h5Srk7/rEsKWCh8Fa6WkePewN8k0YU3kC3wssGJTZqn/aS5bl0ZhRczNFptu4oV
+f6KDPfeZdE52m80smpL5BQgaCw+tyP4hfIstuCREN6pfhOezBZWE6IOqTMFhRVdCdaP8mZykLdgEgNY2
++8Q4ZMUm1z1qz8sYaEYKCpA+NNymjNX9hWI6AE9hzvhsKTY34gFH5LukEcJa5KttZl1Yhw+MF40a6zSy
+fq91sr3NnTXNP5Pd1bh1Hh60/VFyYbouQekJNZjoitxW1q9ZNhMmEgqTNljAeImwrhv4PP0+umAhmnrt
+gftn13YBvpWdvLVVY7OqnRqf+m3UszTnTxC8Q9dJGLFx9z/Bbn1KX0QY/XxQijergoFRG1doPdsuEnWQ
+y5+inMMETqkl3156Yi8M/G+D2RlLwtqO+H4I3LYpVrXuTeC9WfFa/L2X2kXgNpgfI/4aBxUUnPAE3vvP
+gtmS/RjxHEbKlyM4sGF7yYJEpjuqY6OXvRHJqf7epD2rdZvCq/dFRWO924I5REBQxLpXZoQR+DzNzlip
+oPsHPRmBc5lGgWAbmRQaoDzlQVzqyPHhD99acF3+IXuLZvO4pS2jHuXxMxTwRmG1LUShdruNQsUOLgr1
+AoAWay1brtpGReFt3GgCNacs5c0oX1DRIWjsuzTTznF2n9IdpJWo50EUsxB4CgvGQaH4POJLiNDTRu0W
+2ANnKGa5+NK+rl3tKAG09dJgbC+hJGn19HJC83CLuDOjvws75jvXGGpJDtkyt6+urcCZVjKK5LQ3omQm
+kFR2RtelBmM934iSJbJEOxGqBLeWfbnztGWyNmvNjkZqYyVinP8D40Wkt06rtVoE3lYtnzOnaHW0jElp
+9JaUwCKuVAXciPl52/uqwoWENb7CiASbIUjfbj7TbKGW6Nu3oxnf0Xbe7UxpnIrHjsDonFj3rOqt/+JW
+tq8ek6o5T6yTqsf42JaEV/XooEcpw9bwCaeu6IyuPbQ2TK3ttM8877U4P9U8733epAzbVezNrjTbFWT5
+UeZmr76omUybAV0DNZjnpozhmYnQnfgCo3a206ARAEwEYCNFV4EGJiVGDQThlSD0uwGjUAYTUJ4acLOY
+BQmFGm1mVrurFNIpkNFB7le6BClOUY7UoSJZjkaVKQvpZkSz8RK0I93f2DJcPwZ5FTS1Nm55z8SzND5q
+5NX+KWjXluyza1+48U4gN+tmEezbiOdwvzHqA01QXEtq2Yo7WjtLaeT3aVbrrWnEW55g+A4bQMr6RhvE
+twbVunMy9ZOcyKoxw79iRGRXN9ta/BGZwhRQjN6g9wCsq+ZbRwBX4eff9cQr+nd6Zun0THT6ZGLs9Uqx
+0DLPtnR4ZbZq7e8fGH8t+bB+ryoWUNn4Hkh/qRhYhXTT8qNs8ej796GytnkuYq96m4E2ubC6Q9Q7peZV
+WuPFQ9gM4a8HA4tHZg13v/7TttbUhTugrnaubrStvc2KudrvjMhp9iNqOfHVEJ0YpzcIV1FCWzfwZcBh
+GeTALngWiNU3S7OM5etUpC7lqYxrAuuKZ/kKxlmwyVmORWEV8CUWWKQwy4J/XkKQhFA6+YJSSBpi5sCC
+PIovAVbBmagNA5cLshZZkHCQoZRVInLgaVqRcOo1V/fAZ3XhOn7TLe5VkJ+1xeqn3qmeVbfwrk1Reyp+
+jEjot9j1TDJ3ouTjBES5XcLg4F9ZCUwIkybPRC28Qy3su8iHWjpb5+K95yipH5x6Fgc8yuYRurrCrNCi
+AE8BPb8hgEfFQtmPkvWGP5aDTGfbYsG9wC+VXLXjnGsoRcfX1tk0EKHt8R/V99+Aw78XJTIP+3Et28VJ
+rccMpVu9V/aH52zUIk4RPEjNwWNAOmwAPsU4QTMuA/BUCW3cR9S/gHxh4nB2wR2KmzhxMLbHvkTgACSL
+/TDKicNMnBkXohzJcryBg98pBWz1saCs+rafrknHPXE+wIJxzrI39P8i3YTz2L1z1fOSYxWqN1PM/Xtp
+Ym9F9dkQNCoJ+p58OtXkSwrwr9VNroxcy/+bMERt6BxX/ttggZ/UsUmGYHXgK/OY/I1dHsHZEMjZJT+C
+RGcuAy3d3JmqmzsTrjL5zto5keegVM/JXjGCU/eX0D/R0+epdFNm0b+10k1N+beLws29x4UPPP3OZ7sp
+4YZQFCdZ9o2UckW0p0o1V77Rx3AoYjbVC7w1B33gaR2/fDYB13HLZz0wZS/bBnGuMMTq3ceP8NDmrFGU
+KF9J78XxHb1P548BpSeQpdSXHQVFKqmwVbZ4//FjU/5Xmt/h7DrFzRQX0U9ytovXvu+3e0REIWDhqdBq
+lzWKR30vslUQxRWoeDSMTi3aW1Wm8d4wWpzRBZlssylrtXft4Ac7hQPBwUWDkLcphhCCCThPZ3iOj1m4
+YKGjmVbCv+gFDtlXD64RpoRdrIMkfB7N523ZbjG2m5ynqyLVliHnSH2i/Mhi7HPnrbCaTaq5QLemKWMJ
+zASoD2/xyoU2VzlcphsIMgZRAiKLCqRzugmdZxFmQ4I8XbE0YaTgdXOJI/fhbQoY2wP4khUvKTwevXAx
+kxY8j4I4XWyYS9crrOk8imPIGYMANkk0j1gIYTSf+2T8mybxJZwHl4WyOovCIgGD2CsoTQ5EOQJQVQEp
+DqIk50EyK/M5ZJuYFcFBsOJZur7E2rOSzijhKUTch99k63OOhNG9kXMh7ccUYSTgTzccwpTufMsoH8J0
+w7GahBq02uQcpgy2LLvEjYvNNzEkKScSZS8yCJJLTRc6mhUq5mP6PJ21/SkdWqjOETjI+vMicKCfZosR
+5ZCh+Ln5nwhsX3nj1G1NnWJFdqMqIFso4jQ926y7EQi4fY47fAsJOZdEYhPqRqVCt1CtglmWduMgsFZh
+3Fy7yyJUq2jG1mmfMRFwreLS96W7vATMHVNQYBk9SYlGpfEan26iOCQ7ru+zdIXRn/Rht7H4oJcjE1ad
+sPOnSsBMh7OcO22wKLyASTPKDsp5klCwg/cbJg2uWr7AMoyfulkcy+VwUsXjU+ggGcb+Ie4Q9ULl3LeW
+0x1btc2EPWyXxic9vGiGUm/7fBV797vkXVLQRQfeWlV74MKHd4k2qs7/yTdTKRP48MH/Kci5/4aShV1d
+HeEbwkIi3asrSBN8RV7qZL9xdWXCOk3DS5jAfz9aPxYODQ1UpnKP1o/fBov8yPid+MBj0+f/8+FDhqwR
+7p0N4d4WjiYgyDXX+H/+zyOePX7Ew8cfPtw7u7p6NOJh8bgtHkc8s9XJktDSpJGg+b8NAFfvkneJ257t
+TM2WSIHiGhHioMwElNAOXxWQkTCdd4kzoEtqdfiPlZtj7PMsWnmD9sWRUB7T/6XWAMNbncBEpIrDf2HP
+BFVHVWuGgP09jRIkDgCgqXikGY2hAsQ63m0uK4F0dQVNxVzYU8k0Q161BkpzlN6bUCO0cPZDKgHyXtHL
+UBaduBxyCqlWnFU2CY9iCOacZYVMDaIcNusw4Cz04TneRSHS+ECVucAQ3dvUk6xxWOvDXrFiSrrVNmpV
+0thlbwUDa3dje5pnDCYw+n/v8i9EaOGPxWh/VPf1j+K88JH26Y9IzEexc34sfEvf5Xve8bvzd/vv/Hf3
+TvYG7/Iv3n0YLVZjjcicz5bt18Ugfmj6sdY2FU2ck9YGYoaRpyMLRO3oY4ET5xoLAJ1dLN/lAcUCUZxB
+dCBy36WuhAlkeHthM68a/YEpWIwM2EAlj5tcBerxVQTQAwNQLJwFBLWI9kQfAuYuAprEdhLJ8clY+1lB
+Tnwu531CxCCcUFQnNjU1NEKx9rFjlfG6l0G+NDmDyKPeUgoZXHdwI9PSmjihbfXbmwXqzphmR/uGTEKx
+766f8WSMZFs4lYZwQ4unliOjPUqfG/vWts0aMPKOVSRrGX8ZHQEzYRShq5zvsLgQylbiBJ1ZuMbuN2M0
+RfpoGOxzqnnPaQT5tMZ1PdUHdhVvURnQxAb/6rCvaeI503iDVgS9rPruBet1fNkj60nv9QsarcOVeTzy
+y4QHF6bYMSiDSxeLmP0YLZZFzkszsRRLhRDqmmGLjKtpREmZwQ61Oy6HoW3wGUXp1aQillyk1tEUNwR0
+/qqVSuA1W7ALaYv7mi2+u1h7zv979y7/Atc7IoA9cN69y/fwWYaYXzj6aYyXek9BO9QM5zSYnZ0HWZgf
+iRFqd8F5FqyF1naozQj9hlHOqC0zY1imMftHmoVGiIxaKmqxWi7g3OQyLqlk0J3bEW2D9sOBeQBfSGVF
+bSDbIWbKNAoLxr+LGf789vJFKEIw7rskoBhIpC8Snv4asXODZwVaRZQ+poznx1F4Yp9rQmRekZczrgnp
+XMC5UiVX105Ar4zL1sQ2xZ89pYl+N9UGHLYlvcEGUcAFo1fJJouHmnPVjXTf6yydyainpsTOSJgEKV0r
+cRQPTvTxUm9NiUuWjfTr5JOYqUOVCd0rptNOHhsLxl/X9HT2Lag8nAZbZjDL7reBVE3m0exM32ztwX8k
+VTineLp39cE6dpg8oNVXlgc91xBNsjwOI/UyA6slDLTiqqnUYjOkKCN3IiBKXncrDhZtqjlZnG347Pc9
+81utMrhs2fjOLpQbjiuGkC29HUM75oA87C8YJ61fW4W4NPmD6g+M3g4LUqPqzRnX7n+64wsy63qcr6b5
+QpHVuGGloNlmeKrFxFMdHp5qsBADwRr8KKeYcJ4IF8fT6sUNwsRJtTHix98eT3X146frV1IznWiaU7Rr
+q8AfwYPr1UrNGk2Iu1CqrPbI4NKg2PTBNPfoR5ZuktATRSuaB5r+COGRIWttWyN1ZYtniavaeiBivFeY
+zP+dtp9g2qrmO41x00yJAtgwM7qr1NgZWeZnWZ/IpAAj+LqWCO5qfMd8hWjkuqVj64c7xmuHIgWjfzvi
+1ZTiJp14aTSCpxxl3hx4CqSs/W9FUTNP0/+GKIE0CxlNw5xx2Kzh/SaancHvm9UapoyfM5ZUeRKDJBRV
+7XoRpULFDZQedFdQVanWPoSryjXtHYQo/4/Nav02yBZMH8Jdl5pOVaS1stOpM09ppM9Zzj2hiItOBqZt
+u6zud5hAhOkkx/B7q8rf9/ZMCORAPovTnMEUc1AyDgGHnAcZh3ROmKRBEEvI6oa617ee3Eib8+5qtFKb
+8bu5GTc7eJVsE1dVoUsq5tS7/IsJqpZUzdBoJZQVJV1ja2sIb8/TZnuOlGohOiQRLq3WY9fD5VVvsUFJ
+U+fGsw5EVB99Y4oFAjqnUioqZxye1x/ciHWWAm9Ce3xwMhS0HR+emOrGxOYTpbsdRWXQvNh/uNN/+KiI
+6sZdR7aD9Ibxwm7vB2kHWY0GWUDq3XuEgo0AfHryRh88/4vB1UjTFQRgaWDLFFOvhutox8+KjrI0j7RE
+YlNDBjeLJUYBMi7PU6EdRjZy5L0L9wYjYxTyHqnMlcgeJePPlYBstyWSKW6vRVBo651Lp1+hY5XTcffu
+l9DcUouhgqshPNDf5dtcyJAZxV6x/qZYzngJISe7nAUWyUIzQvpqgEvfW6mpM2w9VEjsU55S3rZVm8v0
+ZsO3IDjTdJgrruDFl2Rh8X6wHRxxtvfRu1Wyu5OxPT2cMXzxuYi2qsPRiopYXGuqhag6E/TKKlTcgOoo
+3kar/ijElahCUDkc9Cxer79yQehVvLwsuEOQeZ2b14hBb2RhFaO1jqv40B8V2fVXrSrN/Pv1SW23Ufqm
+ZfjfC50inapwKS/7IpAStRYO+X6wqwbis7+v67iNJJqnXdH1Oy/6RDKi68RUpwq5dCfyksx6twxuLleo
+ybM0YgBCYHB3Vq/2h52ZwxBdKQAQkoxHStL3DvwPrpGZbAc53dVnrFCj0ZNJ/gf64jw1FeZpR9GyQ0wY
+FM6rK0+8sKMHCMZUfeEvpPrCVm/1za1xzo7K68B/rFZSURarKkd7RNnTZZTzNLusBZb9UbyzZHezaYPa
+m8BSbB9VyT7n3A4l62epMK1OafZ4a7vHufvneT1yx9YQenuLxl5xMGPeyDsefrjyBieD0QJ9Vw/fbR4c
+HEzdTsdb3ODwCvCKPLzUSlnCs8shbHV62q0fpgkrPB9xn9n6xv7vIUMuXZLbEr96Vdq+RqYanMEEiOR2
+nif8jl5r36L8DSYQnJUeKM4HnRBzhuI6HfSVDjpQfFOCMwzGl/PMOxhWdWoKKZLcsgweJMsyKGwcKpR8
+GsuMoHK/2XXzUE4LW5kHo2Mr6cVbC6g/lp1u/cqfRzBT8agxjPaljw66ePs82+T8af4jX8WCV36bhpe3
+yby2tgy3uzCt5jpq3ywNPKlE2+LRBk1Ij46UoLv2ZLkjfYfbvsRsSkRVAypcgdqle0hLWR1RC4eNSGqF
+lcJvRSc0yNPNIyNt39r60YSul8czqEqQM+GrzAP/GRWy7I0NrMdnJzBRix6fnXQdk5OU66iRoUhUEWk7
+6BKlvE1MosNtEIteMR1qsOpjh0BIMp0g9WWpvgnVscCrLEq4OWFaVZkALCv7APTiCCokV33qPaVaf3z7
+9lWrT5ZrKwXLNQYyWaYUObUiZLnezUgZ9OJoHEmsRrN7/mwdZ5Ec1T7aPDcMtk0u7lAT240jeo6TEw1J
+gixumG4Bt+Ua7pxzat3F3POQxoDjiAycjjkIRt3WteZEjZz63GhRpZsjYAih03PuBO1hh4kgSAsvtAHw
+H2/+/rMvTlLR/FLMoOcU5RsPlkNwAdzB2CqSFTyTnjoEryLdhnjMLak0MbkxHrVFVJpOJeE0TqdSB/9t
+nE694/ZR52QIH8gw/AgoptdoHQdRMsYcbjnjkw2f73/jtLoWjSqf5h7iH4IjvN0RaUem2mg+70G5QQE0
+wuKuzgjdEUidI81Rrm0s7shMm04z1WbnSWvnK7WUbCEUSk6dn1PAkCGONnXea5YzXtrs4dUXIoqFkTGI
+ckhSEpSJQEZPbv1KK0l1vi8jZeM8oyqVoNe73DxxjvQY7Vu11VXwVLqzN8GWksm9ilmQM/hHEDUjG5hm
+HOK5jRlHg36kdvW/dFrWu0eOEQZ7Ccfwmkn7fnM8+bqB7CYJRfjV2xez1Oi83vyLgzzvnH6a6gzTxjwr
+pfXBvuTpTs9EuI06TWPRXa+cBE4P/1VZQkQKcrRdKCEFkUo4NdxU1J20d0hNfSGPFl6/YLL45BM8TMSC
+bXzr1JgSXtzeMTM0zvycJZqZflrVpLIElIqM1OPfSBoklGh7Lc3suf5wF83FN3FeMB2lWk34rspKUJW+
+oTVAqxKZxeAuiLAk8CwNmVKneDv+lPp2W7v7MQZBjH4a1oKy0sA3wrHqi1misbrqRCl3kGlE8y0/UloY
+BvwInEeOIHLYCtqqr1oXs9Wdcbces/WXLD4Cd5TzgEezEZpeRUGc1yaxv+SrWIRStYZBfB7ky2kaZOFn
+EiS1lD0VMfM0Ido0WZnlp3kUc6ZEWhLPjXwONViDgKpEpOZ0EC8dfaqrtjkEQSvGEPSsUbRnrJmPtOzj
+4pOeyIzNM5YvvXqDfL5kST/thNLbbmd2oybIlToXWJbdWj3aNH9Vhr8+gVKqJPp4saptHvcoYrj2tEBf
+sNAzihE4gcMvdY2qB5eXk0IzzI4zNDlk3WC2gM408HZCopa8IP+3yQy/DbKmYGg0AlKVgNR75cCXDO5t
+gyzCeZVDOodcJAfiSxZlUGjIRWpuJSh92TrC5+Wm5La5jPBRadre3fPene+Rnq3qopXex1vBhJ8hSmqN
+e6I+HSPECRzBqs8qGI2wy18FWbDKZRWiMxotLkIzVl0UEJyKaI1YGGdZCV1FdaJXFP5R13klCZ6h/0Ss
+Z6WVaqdtOzvN3QbZvkFRVKbPN2mSti1Rk4ig5d537RGYSD+FSjGz2bCcvu6HBs8rbCWLANmEQG0zDxbb
+IfBgcWY6UCJq1bTSJLSl4FwTcIdun6NjAY41l52GxNjFcerk3QP3yrV2HE+fLYOMexnLdZ22yIL1UmOx
+2Oy0jNWmSWiKdCSFfk10OpShiPU9hIbQVp+SBQoFDYUYOt7jOeX2P+kZJrzUAMlRnFiSOphcMtojSL0n
+KNKjQkHnEdGtz3AvAgpWkzsUIf4GO8toBc2CIANv4unr9LxiTAFk6XnBXIgC8kUSjCneMPyEomkQMYWH
+GP5qtlQRRoK7xUHOqyIiZ76vm4hYvW4e1vhS34kmKpyAnEX6Ea/MpP9OWkGPShktoxEvtWZCxFx4p3g6
+yWWpGsvIldiDe9wQr17gFHTSv8eI/aTPzCqj6ptmiIyzfyQruNopnl3GkpBlZF/irYcQ6TjDthVyF/+o
+zBGs29P5bcRjdiT37rVPj4M22D+ikC+PYO3TD8rH8KBOusbYVITdvrFqvv6Yn0d8tgSk9XKt3fFmQc7A
+xY3XPTJ8pHiYmq+giWYQlue9kd2IrdxGR2KXJ/CIXiAxaFOibvMDo7Zox6gIUGYleytju4jGozWRSH5Q
+vBar3O3yENv6xf5SbEOERlosDK7j9WaRt1S1ZilZa0mWc6M6ryy9K8UuqEHUo51mLDgbG6bOe0z+33Pq
+UDc++T1PE2MgFrnuMHf+5YBmz/1gw9Mwn3x5cODe6hyphlUaOeJkwKVsiij46TqxsB3Ne3ZkCT9Cq64n
+4trX1affE9RgcMudWNKiqLb++B5cpjk3dN6WIiPDpGTqmCl7YHZjyqSO9AecHa/Z+w3LuWeAz3zhbzsB
+9zDcDxapa4J7vxHzawLHxj7AWmnmeyKyVQeHENlijsBNc3+23rhDK3TIsmgbiNBa7izdJCQ5sBbhAUkn
+AfvvqOhHM1MzjO7JuNec7mYODR18VucPh7fNH8rDtuAMXbuEAorhmaTZpvvs1S/wimUzlnD4JWehey0n
+6X81q9JqjrY9TmcmKaQ4EsCkfo8vzxb+K/GdmjhUT3ommcWzIl/FXAorSGIkRKH0Zh0smK9xif01qCcN
+1QkO2pK3QnyQUNC8lrDFqooUokC70UOrQkRbCfoSdq6J/9wuxcIIlVKN4JRtkhCu034krBzMy4HCEWpa
+MxV9m26yGWvbznwwHbpDcdxu86RfCxHTEV2U5IOcHPrDPYGqk6gphZZmO++4yWwnjLjOnlWvWe7uu3ol
+PLs0hK6hzqJQnl6tH60mmzPyNfdYn9RcLxLyRqWKpHh8fH3bCpOcXypMN9e7NQyhXzZ8ezwOGsP+7iVy
+rWgk57CrzrSpmtEjuc2MazvbQ2RslXbMW9J7nUdJmJ4Li5Zs5bnPRdC+cjxVr8ZCmPfEvWZcIZo1x44I
+DOicXGvuXHvitGbEh6vB+DMYsBK3TatnTenYsVSbDE/jCHMzIcCtGM8VNRrDEFRe+BtW2luKRIy6XQqs
++qDiryXuFUjlFjS0uVKp7L8kqkbk8ZaOiSe2k5x6tCjAC+3WcXkMURBt/edsHmxiSv8m6zk4aeZo0825
+ap2Kk9v42ry1mlF/DBdUJ0fb0WsHyjE0Fl3DqpPodVoIO8nf+3CGG6bGrNkyqEuz0Q/NjevqjmW3MzMF
+91ZXvE2y8EmzllYU9AvbcQ2tOhoKhVGGSeK2zHN5LiV8ri5YfCFOL2tsmPaUNj0ygaIw56lOyHGUnB0p
+eKX2ncVsNYSA86x1yZWuzfmbQnjRwe8khWhBns4JZoKC1pT0Fq5RkH1VpAm/GowN/YI7y4YLg/1+nUO0
+HzWInClojsCdNBHXgDFeDwI1Xi9ZEKIxljtxqxYMOwdFrfrmIxPNJZSPVJr0S43oFkoBTazp8iOJ5GEC
+zn0MzzRxDG6ahVem89tvv/22//Ll/vPnDslknPuIpbvcjz8erVbOwHq1kf58PA16zz5NnVje27ZqMszA
+spr5qoz9WdWoBKkoXFXDEmgI7iqK4yhnszRBRjgYl4Xm4zvFyJVBJbYiokQjmoRsCNZSdJab58f5SXFZ
+vbpjAguPw5Pl8nh5slodr07KQle1RqFTbb1B1UTxtgM1UIdwmDuvPre+rnLZG0l6LiJ2rJSvwSJVzpwi
+dEeivKHYZwLD44Y2WxaFSqx6dUezFUl8UQKupnOqMBSwBy788vYZeKSIShLYqw1wOSaCHJrJSMIeuAO3
+1oN0PddPjHXAOcuQoBFFPfPCj5cfk4/Lj6uP+cBDCfHgyWhc63ZZREQ23A6UbtFMieaEE0k/Ei5iXw1h
+dfzgpLQocikJ9ctyEl7dsWA6oFmiYb4Oz3G+OL2Y7nW3mHvnFDOMIHxRYefh2jpvm4AyC1I1+Q26dknA
+dwkFMLCd6eWsl7XLZrBtELeQDOrLQotMCTGszEmaiPpSRWo7h9xoKY63NjIuGN1IBZkJxk4z5sbHgfOx
+Kg//d1O5CLaUURL6IsOFkIXKJBeeG7iG1rLYlyPYzkWnwCwzhmPi4sn0aDQ6Pz+nDS1IQtzJ0CR7dJ5m
+cTiL09kZ+vVsWYaZnHA7fhLl6cS1o96bVAzFxW3v5cvnz9/++ONq5Q46S7r314eTA0MNzYtmtRtL4hv2
+bWfWwHi1Sl3YAw9tsx5UVm3b/o6V5TTATvJY3ONG1/9kJ5nLL0l08YczGKx0ZyZTxH7agdes/pfX/C+v
++V9e8znwmjdRMvtjTzJU4+0dZao1sqJghD9jKrHB+AZ9kqYxj9afqk+KqcbkqsN/jw9OBr6s1/sAdFbF
+j0foO855unJ2aoLL87fB1P1EDSB+HsAEJOXjO63hkA4jqsRpa/TqY1vuz3gW/41d3txydzSCt+gdGeWQ
+p5AvoznfZwllqwwSmDKYBZvFkgNPIdskEAC6LcL5kiVAnYYFZ0Ecs5DiLerw8yUTpdZN52O1RTX3FzS0
+w5dEz620szByVKoyISVzoL8eIeXB1My0ttxfZ+S6I6XlnsWmrjAk5YEQ3NshC4sgHsgwilGavMF35mIF
+YpjAVgnzRZhIoPKO0tKX38QHK7561Q1yvktCmAj0lHrAiMg0RGVPH36JXU1zzmpI06boboukT5dk4DMb
+biq2mWpH215K2nXnm6mPP18U4eMw9YV9JGv5LbCsSHFBmIQzwf37MDqGd/xkJJI/5JspZrAQiS+sA2On
+mYxYsR7ZVKx8CBHsExmDm6yKBFfFef4Jlwbhlz10m2cRl+cZy6N/kuF1r60rY2hCM+NH4D5VJMd6KXcQ
+x5iL8wjc+xSNPPon08qqG/shOrjjCb3Hvoif/LIJzeVEX9PEcwmCYaDKWivZlg9hExntN4SdlGyFDqo6
+bxVQ3uCWx+ctNuxNmnHh0yiD99f9F+VLjaCuoVfb+dwBuyUMuEcnq4FPlvx5mnGWGZx5AAAQ4KcIjTp1
+V8my4YPrB18y+JoCXpbyo9FogXnWFxFfbqZ0U1rFl8lsOQrDrw7+Mv3rlyx88M034Vd//etf/vKNdnjQ
+6JNSNd/C4BhWlm7cSodVeZ696ahJNGgwqk083b9vDXM4WjFMjaBlMXS0zcPp93THhAmEX9IlUF466Qri
+/Pm30Z9Xoz+H+3/+r8KHvSEHx9jquVlUjVjygYyD7tUk0SJNSraIkpqXDk/XR3B4UI1Ehlmm66/EReEI
+vlTexWzOj+DBw4M7Sufc2r0OM5QmGiuRIuJPHAfrRnL0aAimWLcNvMfRCUzgbv3N2MIb2xF2798XleGP
+Oh47/2xhquLzdjLUsfn+69biQrtDmTS/gbPsK/HVk+CmfeGu5ftuNwqSDiVcarXDL335UFKg7/y7Ekye
+CG5OR4EPObLCMIIhTO3IIcCbkI82azFDRWeQMW+K73r6korRqvpA/hqb/RQVKDLarss0qtDRBKz3JaRg
+n+yyLyYMuGzEMw2yJZP55x9S+j1BpfG0phYgZd8qSrzy5RC+ejjoUyi4UAsdPjSQl28XPxYFa4TBFwrS
+PckAfZ6uqwfB3fR4S2qqCvZVJPt9kOTbhfBcFHIN/xwfTOLscwlZFiqrQI5bPRGb1qO4eIPTVNlicnwW
+Qi4/Q8t97/hgKGo6MZBx8fQikos13y784CLKPVMaZcTuiUoNIGkWkRRY9JJrErVhlsRLzzzEkh66PZTC
+LX2NwXrNktBz8+3ClP4Ztx/PpV5wh2V/W4HFdHCH1XToqL6jcp4FSY4HAFQd0wPaspDKXB30PXCHbnP2
+ugNdP9Jg9at8hgHasOILwOEFHu/jv9ei+IDoK5eZnjYxRfwwXQVR4uk9s8IviVGINdz06i5tMARQ6Muk
+ByrcTIGrjkwzmXEe+RtcDYbGuoOLHnUHF7vVXaiLzNWblmHMFiwJrzHvw2jbc/R5vC9qcQ00IAs5LQkR
+P65dOUnTS0PZohPx51u9oVQZmv92ScB9EXtRv4JkV7v+hW9bEjT/I9LnGSDwvuwRLzU0DYO9yv1FXbfd
+ttFyRx+2Uz1ERmkt1lWmxY09158GWW7z40NDUIFWnDOK8bIUIemkZwEoWokXJ1vd9WmqX4w4eV3Yg7AI
+hAdXnRgvjNgEf/KqlRuKSTnogxZP3ZF62ugsUW4m/YsUm1VHsIvG4VXTrlKBDfuWZu/s7YhCqFW6ycnd
+xceeLp9OL3qXu+zfQpUziIVdJg+5JvmzOJqd1QkYwu9Wr+wggyiECbjkZ1kGXqCZ+fvYWE69N7oi9RsW
+PdG6lZgKRmE3vOUia2sXdN9aw2vFY5BX12C9ji9tqgFMBbtTEs9iNBhM4J7n/MnB/rTRCOVNswstAMAs
+TfI0xu5YYJRJXPDhENhg3FmyS5li7y8AgHuei1r6IWCyDXdQpv5de8xP5/Ocoa0oT9e2ARlcP767fvuI
+gymLjbsjbR64zw7u7LxTlLsErumOEym74PtBMlumGR5n6CBzp4P/H1ghQgRx9/2HbGVHFSKncv0HnYCX
+TYYSKduOF8Ee+A8H6uZh3HEEkzMcTcc9xy1na+ugifPZTYbNsrn37pLDnj3S2E0Pbzj6xR57brkN2ve5
+9hCUDVXAPJsJ2gVMiutSRKZMHt47sLCH4XgH+qMrSDeKU8sJuZpElTTauxh0ix9rTwbdDhner/gvScQp
++IaL6+NM2EUPwf0B//cW//cK//cdhvotuyaZr7iXD2FFkcPyzXyOBoPpuorthr9hIv5RfAyx0oRMu7Oc
+fR+nAcaBrAy7o/zn4GcvoTya0lUmF44ywkve1UjTc1VwjkiwTj+jDPclbFIIqei9lyh13k0GLZTUIHgC
+7gG4sFc8H4F74GqI/fgR7kb591ESceYlgxY6d18x8g8KStCgXqUjQDP/w2aAz2SzmrKsKDOP0zQT9vi4
+sQUDGEH5hIOhzo0ARrLYOj33xFApWARmtQBSUcyIY/G5JSKXXTGBJmDZTa3pVjYdmxH4PP0+umCh97DW
+9kdwyPYf1oZXQst0vy39SMIWMIEEHsEBjtS+i+Pj1nQbCLIH3l42UKhTTPlFeCDPxels0zVXH3TqnGIx
+oPXrEHAZlf7RTTVqUeH0krP8Nmp88NUQ3G+xSqCZfUTHS+isP+K3V/20d/WKBo6hiUM0QyXlHQAARVNZ
+V6V9Um2lTVlZJC+N0EmtFFhrX3/8CIq+MueXMfPlTqgVJAjdvzXEQPFnwGugZLwjDsrIpH6mk+qPhWjQ
+WV9o0ltc6SXCqNJME8+JkvWG40EmWaBBqWirLmhzoQ0WELjVd6lz75j1ud8GmVB4i7AMuGXhNP2+iAOt
+jL2AGCIHawctN2heodC+PjiozyypgW2+LrSwjddSEXtwYEkmoVGxHhmy2WlcMAFAinbrbphQN2+Ba5te
+NDU4e2IaLsuVcPjw4A/Qz1gUMt2KFqFjwRtykJlUGJct+DQL0dPcVACb8Al0Hv3VGLUTsXt4cPBn16qe
+4em6UyGiy8D0yfQhn0yX5fB07ZjGedcKL/tUiE13jLrlwhIA17DJAKCc0jBp2YpLFtaFv3t3kSzn3Kzm
+vCo4eCtWVvF37k8jcY1EMO2pYleh0pXtWibq8WyZ/+5WGeq6Ra675PXrrR0uKCkLPLIEpL4eLeoimxSq
+rqrhRp2Yr0Y7/wK+Mcc2vqF6W3ZYuVNUtFW1Hz7Ul7vxXgGVDrOHFh0A4FKBfo2H2W+DJMypnKDmZAj+
+oakwcpE6gzB2yCfjn3X8LWV5V4FypxE/DPA8XZt3JsM4UOfwaHaWe0QVjODrAwNwmAXnXi8Xt6aJ1NbK
+EqpY4U+zLLhEaIoJ1CNg/PXWZ8GndmqKaP2/grVd1hT+ylqtmyG1WQnyj4F1ARZGBAfDXdjUr4Wx1Ill
+5iqS0oVU/8oAbiauPBr9F5lQmBeDOP04C6dLxeeQwtMZgiOsMmwFrMrlm1R92a9qYlAWoKojKb2pDR/d
+IT1HkeUjHSwJHYtr7VREEquPmYNKbWcgBNnKxNajQRS9VdYO3g136EYipAv60jFNV7F6PLEi+uigHcE9
+nWFt38Etxxt0lTWolhsqZLmCBvr9vc0Erm9LTfGg/5Db96H+9n1ouH1/qb1+f/Npb99BkqRKHCX7/bz9
+ccESlgU8zQzfp9kmX5J/DgJMyR/HBPYdSuTcyRT1bEONhS26M3yLgEfg/v80EKvgwkDFKkoMXxLUUsTR
+P1ln99gBigS3BijUqD9t9LRN2FHEnjoC91EYbYEW/sTJ0nPn8aNRGG0fu7DXqqUJC7M03o8X+4cPepYS
+FXSilmi/7k1LvwLi065SnyHcw4hcUWwMnkVaSgwX0BJ4+LNlFIcZSzyD2qswUussfWg3s3uaUN61IEpI
+O6LzplaxPbBja1HTrqSzZZXlRmMG23SHQZLsWLe2X67MtukvwguYwKGN3nLJmiktET3oW/m/o4zw0igj
+LC6RhXG7cW7b7LlvLPHqNs3+ZBItF/dOo20lXe1Kz4HD8soMI3hwMLCUkjrt6jBgWKVRwsZG53cxu8qN
+0+oA7wYZCwz5MCR7ZErfZSywWT0ZMm8AAITCnbpvTfjk9V7ZtLtXhenRNMYXHSLSxHOpPBo34r8s7IKk
+40S5T38rC9mk4uWG8W8tDP9jvQOQtpDN84bBMb5yO5QKszhavwr40k5yhKNIsO6NLYS6ZUcWA2ob4nUa
+4Y1vn0IUkA18EMdd1vLReh8DyyL0Jou9P+GbW/bA+HSeF9eh6VLSpO927Iu8WIV27U4LpGeXCA5yi6ei
+fuRe3dGKJ3tMWyHEcNN1MIv4ZaehWbcpWjeO5hrpw7kU3mFvyGyT5cKoUq4Yd3Cn0276EmfN23SxiE3a
+p4s4ncGkPLHXXTaaMpTyUqLzJ4rTWUHrHO2+kFRxphibt7i3MtrYjtWXcOs2FImtSG7gdPnxVHcDPWDw
+UwEpj/EGrpfGaaZVJBenylYxAAD3T+yrw+BwZkhF5f7py7/8hU2/MX7+KgzmXwXGz3/95isWfGn8PJ//
+ZX5wYPwcfP3w6wfmuud/+eZwOjfXTX9uf9eqAC9D/9uLN+xFskm9gAkcWL5fmr+ncWgpvUy3dBW/zv5F
+ZTtYdfskkKQJ6ygURvk6Di4raAvtr7ACmIgH9Ux6NIuyWczsbUHe+9CG/jWb6bF3n67mURxjE86XEbe3
+QTLMdiU22/yCLacJ35c6fPfwwfrCVBMF5LjmSFPZa450mxrCVtIQR3oouhTjVvd/WZbq07iAqoRTtkWj
+Lk7Fd7d8uKlKk878WYCSzXJv6TanaJ2xojSxOmIJ3CKUpOt2RNYKStV9hd1cYjQScQGnJAQpaipGiN5r
+9mR8LQrtY3R4luQstKhjLFU4YbR1OlqUUdR2QlAv1qQLhbcWXFl6bi8vTyQPnIGw4neeZYz675ecZbeB
++fCgQB34Km476n9R4/9+ntx+qwnpZ9ncX7L41hpbrJ/A4oRbcVvngoT8GHzWGULg/5LFZX/RbzTTpTuA
+09t5EwBAZjzDzLoC0xCc02kcJGfONVzZ/rWj8yzgbJFml7e+CiXez7LRmN/3thtsyRn8r23sS5bnwYLd
+dnslWpN/JoxGfQzmi42+3OVPfb7MUs5jphjblIqZF+GFTV6CRgd5eWFtuA+KTO4dcQP6GOT8LI199C0n
+GvpHFDDHfNCdGotWWKu+wJRjZpR2syASdkQtTz5xZTKUIZkGTQv3rUiIJBKn4IN3EQ0sVXGsKcIUYQQ8
+gBG5D5kLrKLkeZRjMRINlWJEa4mfKQfvKhKXZyvofxHcb5YONvcsC2rGxmG3A/4FTGCKqYC4F/rPadbZ
+mAh524UX6CAnwDuijJXlqKJaEdi3xYK9stLNampa8u20HzTXvKz+OAovTuwtXPOu9rBCvIzZgN0hrPmx
+yiJOBuOO4jRZxUqGPXDLGSv9wtYc1csdWETDKqdXLHVwUmR/6FP2EiaFwdSOLRDlw8IdMn+fca/0cESi
+9qWQY0hh9q24AACUspdF2Uss2yMOAdLxqFiXXSMHAAUoTLAJ4z7g/0WwF71gfyPYy16wMgV7cyLQ8PdC
+UAjjSLQpJ9TguiETrnaO/VHIvLF2oxlcnTbjvkWikq6NyKbdw2Eq1Xo4Djbz7ErQVEpdhGyn6FRbORTu
+dPiJy8Ht7rt+1RK3zl9Lmwxq6mMoTLgf2Mp8SyYKotBv8FixB7h2AytNTEHSE9h/CEfwsF/An4KmJ7D/
+DRzBYXexeriKqlYKXAFH4ArrO0vnJRT7v2qdjy9sZ5DpFCZUCs8G336bXni2GYEyxT4dNp36yBsPe3XU
+dOpf9gKugiJN/VKp+aC39ep06hdnmQe2UxlMJFM3u9lcFNPSxocNsmszCxLSxSK+mJAudvb2oTuEi26w
+B73ALg/NWkUV7EGX1wZ2ErvgLOFvRJB4+wkthwko4PZziwDE8PB3J9CzEgCAnDLjwL7IIFsgGfcp41VF
+nmMCKJmJcPe9p9QyCvZpOtBd6fziHh50OOA9S2MZO99zj8XdSjEOHjZtWE+6XAEb8DXZMH6zyoct3wEA
+CZOX322UR9Mojjhau4un2H6L3tnXxFTZMgpDlpjq6hZbX/2vC2V1fLq+C+Xn5OV4Cz6Iu7kBXiiefBc2
+Tz7yxpLyAUuTS6Vrsdcd7uhwups7H5qHaYMj/aGued3aYnH7Fxdq8buWmbwmecLkUnA1oBG3YXvaC53M
+JEZhKDOOQRQH/iaJ8KBlruQz9zUMv1SEfo5/ISxCnYHw/aIHfxaTJbNtGe9ipwVtJwyvCnaovB38D3aa
+3MGGHjrt6M3VtEOSeV0nuHXhFF7FIRt38ygS5XTAXcKkS1JQSba9osk7zQKz9ykdoqKuxq+Ci8olntA8
+t0m2sS1WNVWJoklOR7RRAIDQX6NxNlYCI6KMJGXIEA5uKQAkSGty/9KzeyceF6NxMhjbMV14dt9CVeJn
+xES3N+H8CxM4NnevCBHeQwdRxBJvDqcazHsmtwv8T9I4tFbdzx+5CCV+K1WfWI4UuG/aJpXcV2XHGtfh
+VQ+PbInDMnyXKxq72x6hw7KbbFWrq/jWRqhcAt0UyNzCHlGyT30xgBE8PLCMHpaxjZ7EudMhECuG/QmV
+HRsgggvYs0EgcaWZko1AAsQKH9sPKSVhRqEK2DMPxjmTlQUX8KhPZcHFdSq7Ms+wijdhS4ZUhWVpVse6
+nynmoZyUOC1sxMtqkGWWrnCraOdzhqn24KJX7eW8V4gILq4fIeLSyjuiecstCzCMJ3lf2cgV+9iB9Enz
+DgaDXa9L/fIBQK+cANA7dENZ6+Vt1nrZL2CELfIy6GJGUDDo7ngHpdpDGGjgrcDb/+vBoF+khP1O5wWl
+ACa53/cqLUFnEAQnJJHRIVvZqCGp3ine796bY5rUUythVFOKaeL/nkaJ54zBudVLk7RAfBGKlGkwGilS
+QXgRwv5j8b0Lw3dJiLfXCg2VwuLyS9cZ+XV6bmeotdSoBzIvasMUtMwqRdlQuxTyqMjvvP8Wf9Se41aF
+x9GJ/yI8sZOu4JC9IfhvG9vBiS8hxn2iyvMo2bCbRIcvOzWT3U8/Hk2KEUEJEb7q7s2iRwmRWr5PwV4d
+nKXn476Yim7O0nN9R0c7dDQAlO2Z2Cw2enrj9h8epVNrLXqkb1ElRPof0ee30oVX17SvCWq6DVQSqJZs
+1dcuI1jFoq2mbzEZtr0I7dd/ldl1GbiBGoMIHS8Cpx9wV46daudzUWkSR1Pht9g/20a1WzUldH14seis
+Uh90Ew5Y4JLqHpsQpI+1szkqk1hrIS2sL8ArjhdfgH/wcCDUzj3rKKM11VD0KVkeuqpZ5PQqmPMsPWPG
+thUucR42r3c7BNJ96fbqDDGXRZ9yaC5yy6QgSoWQA/9wlxaQssIZQr9CF05HsCytUoByMArVgCrs6lNh
+Qd4O1vVlyvy3ZPDQi55uazmWhDV8cje4JrZZEM8K1aDsOaxAyUwlWtBhCzYawc9syzLIWBKyDKbpBcvh
+POJLiFmeA18GCXwD6+iCxTkEGQO+ZJf0AyUc0WwTc+ApkA9DJ8+riH4E3+zA6765BR5X1n19JofOGiR4
+p62nNqeCJOnD9O/ekOvfpB+ieS8yoZT6V2xSnAHGneVqDnPe4CbU9s121WfM2g42n/tYNW0k3D+t0jCI
+3yzTc/TO9XkWLRYsK+IHXNPnp3aaIpv9DtN8swDv/YbJHM0U4qKe76rDXOuW3B52jEEEfeMQAUDRvF5n
+TlCPkmtz7Jf2XuWKHbW/HW1fvKX3RsdQGExTb2TV2/M+0xmk6X/eMOyyxsqW38i1RmLpMmIMjU1HwqVe
+p1d6T9VaO9nE8Y1lsSzImTAADTJ3cANjcWlR5AkdX3loqnR5g4HVfFxND0cSbhl3p0OMTFC9gv/2zPTa
+K5QN1LKvUYSHvruhiF/QjuEwjVPzxtMr2SllGLohFaZoBLBLxlU1D91Oow2+MHx1u438S5aCkUjmbj+v
+gP0qJpLrHz542KeeZbBm++Iwj1naMKxYFuXr78KF2Wuvpx67ny1JVzjj4sRgNWquALR2yNXn51o1azmp
+ZBg9a9JAgzxOuphR5As/TzfZjH2Hvy1W3X6+jOb8b+zydk2bqtbCRLRIzjsTm1e6FhsRcIZpwsRbcwrE
+sr/bZQ7tZZ4LTfp8xZ9vMjpPFrf4qryP18XG64OTwWBwk7kGNVmaEkUZ7t+H6xjDC0RVYOe+xvdKOdGB
+PYzo+9xwrnYxvmuEgexjh7eDsuya8/a2Ftfdz2Z1aS7HCTuH6o7Yt2AlUarEQtXKkGKhuQjLinHXRITW
+vsgrnWR7LV4HdTlRUWb0dhnlEKeLHIIivzNlCQWWZWk2hOmGQxDnKZyn2VkOvg9pGPp3Ps1VV2/0vJqv
+OEzA/e23334bvXw5ev58/8cfj1arozx3LTtGwfnCDi+DQozX6EysdYeksK2I/hhoGhP/sSz3Z+Vvz/0O
++/UZz2IR3J+GBDf3e0vO1/QjTmdCJYMPWbrh9QuMKDIEKjCEEnwIAlht7r0qf3mULFqJ0gkFOsV57ihY
+RyMa86adhZ9vZjOW5w2T0WavyqoECpjAcePscSpKYfd+V/dtZ1k2JHd43UCxLPOlay2CjLUAbzYrveaa
+PmKe+FbTK7IQhgYl1xGHFxTTBCqq3psIE5tn6cbE+Oj791GWU2yCcinTlKt/s1mQ/hQYy/8UGIvr7vO1
+0RLWtCxreqyqBRtncFFyhykBE3Col2HO+GyJs1Hke3Bgj36pVR07cwxDGF86J3VvofaEFnHHaqRKGOIy
+NX8ummi0UzUpldsXT9evsnQdLFrc/6qFnqc8iH+KEpZb44lJJlPvb2ndYcEuLigs7K6giHxx0FxutSoN
+666JrDwAZxgfn83OzCcJvrfXyR0HY11fcG3DsR0Lxp+JWjubjHn1tVzmEzcb6y3Xi8lHv1c3ICZjT5Bj
+Sa0TELy1wIh/r9O8wcCHhLx9x+zJygFA7iV+xnCpeS3G0EbdhyGYmcIzbC+uaHrMdWyhxZE0K54F2dM4
+tk4eAvKOnSCOnZNudG/kQuw7Iasp3Ow0UTENjK3WbBOzn6KkzrmQxQ9BM3Ox5k2GLXZGszSZR4snQcwy
+PsH+K2bouFVknqWr2pmyeyPCWvYm4NwvylIVxUNxanLwkLb/8uX+8+eODQFWoEewXB6tVs6gTTNPDRQb
+tr6yPlGQauNpra4exPK0JJWn3YTKtb3J4rH2aDgajeBRxuYsY8mMkYpl4hzs04nR57kDo8d3sLFvg8Ub
+xmECGm/Z8o0AKt9fqdnGxbfxnSvyTpMof+1G+KsR3a8qstcBZ39fF2ZFNpwKpB61AqDWIPJcdSAXQF7N
+OwD9sny6wkxgjuKDuXj6+BGcYMNTZ9wADRZnCig+IWgTbF7QIwHlsw50kaWb9beXFWzx4uNHNU5qrRdE
+S9od8DJY9+qDl8Fa373lZxX3f25YdtmBl2A80cw3m/U6zfgQ3rd6OlgsMrYQxujwHtv7Xn338SO4+Wbl
+NrpoxTCtfFVCPiN0EzQTq14C0lO9H2uQ1aRUChQvP36kC35txqn7PxW5+95Hkes2wFRsTX47GsE0mJ0B
+JnPacAYVJHEyeH+nJe4oSWviKulWkEzAXQSbBXNNmeNAdfNottqf4Q2EZT1rktDddfXChnQYUV3dsSBs
+I5Njp7zDmYGr1x1rcAoEyqCHuXYqhTkXt6cSjJ51oDxYKPjoSc6egrPWl/xUrDOlTPVKFlRWYq1s8kO7
+cPJDj9K0Ky4DDhNCVH0YjeBZur4EIptMgEj0mgNPgXgRTC9hLvHnKaoNKIdZTlKe2pKorf/mvDoVYerK
+DlPFFNshnJnO2VuYTCbgOHbJTF/50FzK7bzvTemO5hX33uq+VgxbLySYF5uERu2NA1CN9fHZCUxgPrZe
+AEYj+CkNwnIEiHNkwTlpdS8hSEIQF6UlW0GU4KBN6W01K/wmQpLjrYIzlsuRJKQpX7IM1sGCiaEFL/KZ
+j4iBXazFl0GLZZ36yyD33mNkcVGbq/WGkqP/XnZubfTbaSiblQiIoutNkJoelgVxRKiv5cZpKr27HJnq
+S37YtcLmKF+ZGFXOZBLPXL0iFJ+e69+WnLD4KpDSJu2vs5SneMhRcBsvLMpppnmDNjIUdaGXI9Ee7yFy
+nNaqV0Zb/EOHItmpl2vDvhjwgkhx3dbmMlP6eKCnNvnhsyb3amwaxufmESy3frl/3b9fbG8D7c6anid5
+sFpTaHe13B64+y7sFe/Gu+zWKk63tSlbmqVu8/rmVdEGTOebl6qTpXpI7D4VNs5/6lorzM4ax5GWACHI
+GTiI1TnSn4skMfq9RGM9JTDKtl0Lqamjdi7wkvpd68TaKpKxnHHKh6x3vja2lE64He005JNo4KzNNsHp
+xaRTLzuUSvk1e79hedeNWgVtM81cKpLdw+V+sEibR8bKcrLgqYI8FamyGNbZJrEvglNE2+LFOgdCtX6b
+72Choiukomq54+ikz/mNbESx6tNGYXMuSWxuOoetNYkkOiFEycI5snrR3912xgZhMeMM3kfHZyfXi15n
+tG4UdE7TNGZB8vkTmk5/x3ztdjr/TkA+iiW97aBvNKVPSL9Zdq5b7OraUtf8QryfZyxfije/siwXOn4b
+A5BQelGK/FjUY1XzEmG7q3nde7gzC4M6vO8u37BsG8120wAPocAyBMSh0QhXEhriV06+IX/0VZTQPwE6
+9zjBdoH/hGyL//wzWpVQqwIwWiHsSUuKHebNGhD8tmtRTrFCtDcEBwMfsiyIT9OMHs+jOJwFWYgP9U9J
+yk+j9qv6m4wt2MUaf5WITupCI0nLVkwO/2Xwe5phVPUHeC5rfowS+dGgK61dt1u791VLWRBwdpqWh5uq
+F8QWO6xOFUN5ZGl34ixInm54Klzemx/b4TG9BeNv6m+9AeB1Hml12nrYvAVv1aUoXdrDYaON3GvRYbsJ
+Xt3pwkYHEWdg1NTlLMhmS5hUy9AXr7xBHfB3mEhg//dcTfeELZYfpl9/1WwlFgt4OlVBLDOipzUTEpSV
+R6Lf4Qn8x5u//+yvgyxn3u8DOKKyde7aqClKQhHfDMu8wPCfZQcsA0ydTAH6DlrleLDYNi+exYCnGWfh
+Kd7KDBAkITldNz42jzWyZcXhROWd7zWhzuq4j6MT2XVCAq5bmSgIH2vvnkVL5FGxoCSvWx3eK+wcQwWG
+JWELAtczCTbl6MvnuxNwaWK6rRKZ2PSqIuWLCbi4NNpFyjh7VSHlVbuYMmEpfJO2N0UYo70KTjdpVUzB
+hQlTcKFiCi50mCi2lLRZPV2R0qSOzMmdI/xfPXiYs8K3q+bbJb5dNt+G+DZsvj3Ht+fNtwm+fdl8e4lv
+Lx0TL4ny1yyGCYz+n/cu3Bt4784HeNG4N6rAKr0ai9+mT6e5tzKYnEi7tsKsLd9MeRbMuEfr9XtMF+ut
+0IZwWOu349Xxg5OT0gpOy2pKGp5O87fpaxZ7eVtP8nPKAY/0M04cAnX76Zwkj3g2AYHfh+/TDNgFSRKG
+8Psm5+A8ODj8yoHzKI5hylByHYVaixdFD5wPiyfpfSTNIH2Ugv6ctiKsaixR2q17cx6sKZNMrtuj7rbe
+mvu+3Zn1KkupB0zEHPDZBZu1ImdjtStLrcqUsNUkoZXBs+wn+Wa6ivhTdVcx792tPaiWQA8mdBr1f2Ac
+H9Gcr9klLYOWCpU7bKO/kYXLaBSy6QYtUvUZttuNwfBCqLZQDnbKV8qaB3dteo2ccYLyDKV7mcT22Oip
+yeNO0xtNlIhCk5AztsqBp6RSKPZXkFvJEM6XLGMQAIo6IUxZnri8m9AcJpqXeG2aBbzdJ9cwOqLnHlZH
+9O8upkXismteBqWdH07gY0eAOyetWTxyYQ90M+vGNreaAdUOwKmPCsF0o+nwfAin/jxKwn/g8Gq/f4AX
+4ZG2AXA12MFcVDtQ9kGiyJKtgXlDMiZk03kf1lScy0rerr4faLtPHNKaBVgSWifM0zB8G0z7kFSco+un
+0JaNaPugKhQN9oPqYGC3MuUvZO0VmVEHnZHxKhTE62UwZRynYjCdhWy+WEa/n8WrJF2/z3K+2Z5fXP7T
+8fN1HHHPUS9VbYZr8mRp2qwra0souUfESt2bWT8KUlLElMOkwxKxH1lFFstboWwmkAlx821Qhyknb4Wy
+ZZrzbqJa54wfGH8bLP727eXLwjJImZE48wyzkm6TxwRRXNuErVrrbFXgbd72qKg0QGqft+6KD9ahIPnJ
+sQA8MapcusUPzVHCezBtGCyZpSH75fWLZ+lqnSZ4tJRk3WjESJRv6hG9r6Y0i9HZwRR/hTHXqYT9cKU/
+X91VTGR01AlcJYisVWMGYz4qyWqSH7rrSX64SUU6nUxYJUi0xnGkm6tcMFo1SoM/Wi1ybJ18HJ70iZdU
+mspUvWCPB6HYR2AzegF3WdwYJsFx2Mfk46qrS5IfPqs+aRB07Raa4xwLCzZbS/G7qFvAHocnuwZTvivL
+9avGdXfBX2MqAoF54oi95NcccwmIvaPPGm4KEd+3TdqCmDwrbGZN2yDubP8Zu8QGbIO4t6/wQMdnJYPF
+f7Q3uZd4d8s3GQPclCHKIYjPg8ucpDBztPPHsr5pY1OlsdUOqyoMaVKNdyhP75SdKRjC1NabAYkfl3Q2
+6fQVhv2dIsCXVrTTnSo53C31CpUJfBSNxwy37yBj3rSHl95tXnfdX+gsDTwV3nfyMJSLFKUdl2HdyWTF
+eID71UgieiL+nXziw0qlqkIoFMPgv/5rbShW4/FMvrlWd/eTLfSSLChbev3Op9W+166VerGsZpCyaIZD
+4jzJo2QmvFhMYuEHqIMNLnOnCGB4E4mEnBata8BtiAV2msxq31dWPsX+cFbsDx13TLxkmA7iZxRdSJXr
+aG8WN5r7NMuJdZq5rXoRElbIuhvYZ8JTNNL2BeM6wysAUBSVZqWkAlcKeVTZjh5SyHYqkc7Yai1VH1/1
+ZLA2WbrfXfvmCyTsbOr+vodmcj2wXt3EscFynLDd7ahJu9xDml3Y10ugdqRCRm+FqugXM3+788m51wmx
+oVYW8rf3g3Efb9+sOWFbEpAzdhmKSAWKpY/WWz2aF1/KSCqkkhCvztjlM8qQPIHDLy0LWcwhs4Xy+I6u
+QKcfbCacYMu1fN0l9d7Am0HRldVuBYbZurr5yiM8WWXqSDz25mi7LxxnmDifBwvbEXl1zIPFyS2nPiT1
+BTSbLFYYVjfudUPZVWgh7fZb1er8S7qYxupYOO10Xu9tvWPuIVsvqa4jgobxzjgW/VGYuqum6zH3qarx
+MUN9IFeJo8IT6mqwQyq9jmgIwhC6pQluWlO506+/wgTnPA08slUS9sLR/NLLBoPO0sJwRlEi0zM8gU0S
+snmUsBCOCpuaTmRSD1phky8wjT3ZysBRhbcTW2lrU+ErX/XBSNwwSpSEzGU6q8oWZwBPFMscn6dvqPs8
+MvXaxLEGZXBhQxlcqCiDiy6U7XavIlTqr1r5rjSQASbcxyobkKYgFcrmVR0e9buSYO6NnV1cxPSGNSbr
+o9JUqznD3PviJ92O73nunygGpTsockDDUU0Ypp6IhTLkJeOBpz9GfgYX8+rKFyiX7Fu+fMjQZH6cLjwZ
+MmTBOI+SBRRNJiG8IADooivatuslxEvS15skiZLWrlsYVaNIYcZiT7Ux11jq3DUiAjXaB0HABFwJ7HYZ
+CeFkkk4yTRmqMhQaxYtOSaGf+EZ9BbarUQTT4ai+2xPpp228ERTk703kysAnmqBRn1NTfVVoiNGPu85v
+2W4m+v6F6fjZrPT9i9puSRsvZkO7hmes6s9cBLcweTUXAzJX/RSNLor1EpziY0xkRIw+QfSLWoQdwN/n
+nvOFM4DHsN8rM1ZRo+KKPQHnCweeVJ8qC3s4Ug33bxJ+3xC+wEie6iIwvtWETFYB8byPPPiau757fxUl
+pg1AeyRo7kg7nQjc+6vgoqu64KKjutIWJFphnO+B2Q5GxsNrIlA5FUtCCuyhmFYqnwamG2bDxlQpYrA2
+NcrmaoTeDwt+xwvDULcKROT2wUKsE3ejBhoKi+kOdrExoE3sCaLBA7Zp3LSnbtEfAwqvLg87ewp7p9m5
+R6e2WzheZCzfxDIIcuC/Icbbx26zKyCuwUYOha3fXhJR/tM++c7KCLDjnsIkWfF5kBUHANcubxM90NEO
+Bd3PKbymIrnbhx6sipr7D4GiOAvYchY3Kt2bQB2DSPYJzi4dUnnREq7/3BiGWoJjGIu37IIb7FnlTl/H
+3eUPYqoCA4Ldw6NlYeiGriJ74GDdsAfv8fc7U+61Mk1nnZail/ftGSZ1pATbhacjZ+DcRK3cPpi6nZbE
+OhCKTFdziwqm+S9ZrFNhINwGVVE5z7yDIWzKQ4b7xBXpG564umJ7k4ptVf5RXfzI2B4RSW/zh9od9+pz
+S0hUEzIMZurZhXJSamGad+r9BgdSXn/q1dOVCSUUaJ07hIeU0G3nVAL6a1n1Dish2cygGWb56XrthxFm
+2MDYKC7PX6XrzVqbjkLy6g+KaEA4qByB+51beepQ5xw1OmWTxUfgTtyKzKoAZ6s1piM5AvfRdMN5mgAl
+iJk4U57AlCf78pzgEE/bX/JVPBFuiuLFOg5mFDN74kxTztOV85itpix8NBLoHivUYXSfI6V10hcYg24P
+ATOJ5DqpvMCDo+i54rcryjQGS0YIPw/4bOkRNlwUam9ustio7DJ8g531XFxwdPdRlKw3nAKSTxx86UCa
+PMPAvhNHhsahRB6DsQMZC8I0iS8nTvHLEWGvJs79mI8DWGZsPrn/fpPyMfILCvEIrnhxf8HHCBWtFpBn
+Mw2Yv04Wk3WyqMOPAvzlPNZwJ9HN/jpdY8oTT98t6DLOEn5ELd7pDlB6w18Zl8JTjAT6Y5RztDrutSKK
+mfwLzfbROsh4FMT5iGKKLgUmH6ev26rd5Agv6/+jIp7v5JIrY7Z+aFhlVDecp1kWXBYeimj51RVQowKt
+aTmbxUBGEj7e6i3Z9PxQc5klJFWlJxaXcWzwOsiCVd6w4sL/DSzp293gzHQv2FLuKHHOc++rN4/mPSPn
+Ad/kdNGQROyBcz+I48mhcy1LE1UiqPF3EvNAxO49penbHGnd8DWz1m2HEBij2N2lzg/ObkEVuPUpaUbe
+GAXcmmHpt0O+i3eGXG3NRhXI1YYtLcdecVLdg0N4VBGml4irf0tMVCFJLYodE54TonZn27x+VSm9MrjJ
+ubcxWXBcWz7ExZ/kaUdV92SYejVnmoRnV3YxTjMGS4OMQcctrLi+IvCpZNAwaTbmGgE+myf8n1N4GcjA
+/bSv5PB9uklCc8TPblOvbv+vtiFXd4IN9GT57HYbxTmmcrLHpxaIjFAqQXht4RQgwVSBCKYUhBgZbO60
+YOc58jR9MIXKSq8ZBHC2yTKW8F9e/1Rr16Z+fSvQxM1w5SuTYUjTfsvTcO26jZN66i/+3lfhg1fNL4XT
+iTToPar1/FVT9Wy15Cksdzo86Jpufi0RTVufyYOpOwSDv6EYXX2SBL2J5wgbp1og4vPtmW/i9Do+aS9F
+UBNsw8RoWUoBB8p8Yso0H+iMM+vQW91MWgecsyyBCYxEnITw4+XH5OPy4+pjTgETRmOta70sJyTAW/1o
+F4LdgoAyuomMlYDhEfyM0Y3Nc4mHvHQHfe1xhW52wfgTNKOY4DjdR8PNDnk5jecNBzSQfOBUKmBaZ52o
+M54BDWwkjh7wGDR2lVcD8zQp9eOlPvzw4MBt8J315rSLTxBM08azzicFiBKPr/wCAE3mMtQ0WyyAI3DT
+3J+tN8r1u/irVJ5HVSDtNhiyoyP4QA4YDV5kEjKdmJe7IqzHEEKmWdMQ1FNvKFL6a00kOvAqsnfzZLEd
+NhQExwcnRWIn9xXLZizh8EvOQr0iaLbemGT/zXm2YqvOOUQw9jkkQGobRMfEKSaNk2J8+5XIENSIANNn
+PtSMq25OxiZn4U2puJ2JSG35/CbiYTkRHZyAjsGmYnVKAwoTPAhTHCJfJNJDW6dhc2pjYuXGba6mMMLc
+rPU+rleGXKtOpGUHThg/nV5ylndOfAXSPv1VwFtgpDgZE8Z9Qum0+WRG4lMUXei/yZizOGkls5XQUEWh
+PYJDVSrbOdmHEM2DGTtCO4QhSMlZmtDzH8ielZ7+PNYGCWBXzRhrxRfqszfFhOgjUJHEqGeNXL6Jwgt9
+VD/8TGsIJupTc0WtlSV1HK6PD06GEK6PD0/gC/jmZGy0SpYo3waL3C8HnkxS0g23hO+5BbL2D0/6qohp
+OJX+xrh6fz9PMEUdy/hlrRUENjCLdEokx61SJzjS4u1JH7Iswhl7PWL7Eu8HYy0CvpJhTeyYdrYyLmKY
+yEMwX63N3HTezUbnnfxzfouMM4zyM3+e+/k6mLFT3bGig88hAj1bG94eXZpzxrXJ+nTcdv5vwmbn+Sfm
+sfLmzuuc7Fj5rZgmnGhTydNdPEvXBtsLhdEWQV0mxvls4lwFfgF1Q/ZUINu0j3kWDjzPu9kvNsnIfQsE
+x80Sds5rL3qMjUEEOIg37BdzLT1Y9rVJrPF7M5br8vp5bmP23XLkF8ksClnCrxPEO6eI3S4KRq8ZwDuf
+sSEo5a+t44zCSlYchQ2/hyjsCim32uQc8g1edCCSPYI4g1xmKyJtHsMQ0+OezhJNRi7yVt4o67RAcSrN
+Bpo+5+2ourNWDD+d2bRUEcPEIprDqc180ohgQntdH4A7EhU+wUqEySZaG6NVwRm7pBdn7NImZl4w/l0Y
+8TdRjGkjW+lB9Y4q6vTxf2hhKFsgXg2h/vzCHt8OPW5QEIgZOF8FCasnXd1q1IvkyuuHacJ+ktmb0SZ3
+6xttp3psoeU81bgw1arSJhRpmuTFQc5/TpNfkrMkPU+eToUX1ovwQgn4OU1DbfyXLcq7UY1XHQiL5eK/
+EV80Gyihq0rgkw6q3pLrhhcjIeyZhr5i9o4NqWUxJS1a7gVnheGztxVSYHP4VrIaaq/Om4UAUfpYHHr6
+dCzzebbJ+dP8R76KxWHpWxzEW7Ty29oDi/a33eseanuY0lzmutdE8p+lcRys83rGmWjYzsqiohKx0O82
+Xo1NAfvbTKFaOEVhixa7VVzwkf4e6lJ3ajG8KVRBxTQe6AwHCqWHLgi2+T5SLKd8RH74+ZMoFF5NN4rt
+WmBtbWtQC2rKWXtda2HFTlSaVz+dNdKgKqC7LiFZTLS+qKBpHzE2b99yWxMPyG9MfKp0v6BANU0oTAdd
+8G4tk5Lw+dOZ8FgThL6Qz/oeFntiyXfEow30RdgAfhHqe6u1rd/erg0Gp7/aQHW5/GFZZXIxaddzor/v
+MXSE4BuRoUCMgYtMoPZ+IzZYt8e2qnIH4+480XkOalgfsjs2GMNoBN9drCnN5pLBmhiVjE0vDR8A6blz
+/axFdyxUjP8QE5k+icbt1x/OVrnl7mO4zagEGwJSfW6BpYRp4k0iS2njRaGMS8bdkubfN2q5Pq7up2k3
+1WVt9U7J7H+qOFpH+i0FspGCS/mi5ND7wX4vKplkxR0baTTvFjDIiyoow4VKlXLVDWvnabZgJEsTOPzv
+xQv0hxTf3IaXY4FyJEs8IXEyhWgT+ZQp84TERt5lA9irZxO5z5LQUOC7JGyDkzEgFSjAaEdtwaHIlsA0
+196iJEaIIqFqsxrR2japYVSrutgO5YFKP7z281znWc5wjqtnkFNqriV2Q2v6XE5FR9mTnaFa5DY47ass
+ncZ2ZlsTNHXLkW5iohiFSrKdKNTncMKTep/Y9rHlJlPdL6uan4BgoGvRJcURugI4qgPko3TNEtcUrBL9
+UW7B97SorEEq6edP4OiPDrRXkGOJtGe8cbYHRshFOu6VanwVYv04eG+DRQc7fxss9JkU3wYLNVvj81cd
+iJ6/0uN5/qpXMsZXG7sUt9sIuGX5W0VdJPWeDJrXMKMN11KPiHQCAAAAhGv/rG0t2b5qyhrCNVUQrk/G
+/24HqxtH7CxyfFAOos7IcxpDZ6jHPuzSpCmD2idIoZBoniGL3PrGfK8UNG3ri8CE/nZXbYaBxnC9E4n3
+79tIpC7K1Vx+isHn1j8bAs/DKXq3l3m1ZMSB/3JNkcDo7kuqHX2doCiTa3NwaATH40DOg9X6CHhuBtsK
+YyAlzxk2fWiNyX1E/7+lkGZtd16RRYvCFVG/+L7ezFEuWXNoH5PHdStx1ppSyNKC77MD9gtRZPYEVwhH
+1TGIJctb5py3sTHu5JpO/4onf8XyPFjsJFCleMuLTtaDsgnFEgRXvPJbVaS3ZadYVqxQ+rW1tJyQlTag
+b4PFDrLRp2H4/NWODQnXZTvC9S00w7gnavdG4j4CbZsBBWHoHT4cgpuzWZqEuavbQttbqei9cL1Dx9ky
+0/QIHH2mOmbcQixourU2PVQJ+OZuiJ2HGptUcZd8J7yKM2ywC6B5YE2J0loTvAej/lziX9+mpE5KP65h
+p3B9+4SbWiY00rEJAGuWXAmiS5JbOOtUUMWbFiiJQCo4etR66eV1N718bDj5l154izYIyjwUysOoXVUl
+NxJA4lnjqUc7VwXX2srUSEFFvKtG9xhO6UrvuYdL1xofseY6RKP1LZunGZMPT+ecZUNgSVj9KgDiaBVx
+rV8g45qjOX4hS29iMLrofRpfbUwpauKZVD92i8D6eKKnaHemSXNeEUNJYZ3JI7nQp5TgKKozASPhSjcj
++XnheSVe3U5062r0RBWPlPG8nRqKOYH4GTwq58itYa96iMHjauLdHH/G+DFOLE1geWOsd94ZZbTr5CDF
+pOgreCtp+SS+XHNBLmZlkp53+tM3sNkueNhnR+AKraqrv34VeI7qnCVJz4cg3GqUnweDXQJY7UDiL+tZ
+usLQq7sR2aTvE1P5Ksj5tShU/4/UPng4+EOzD/XOBULrQh8IGS+V2guL3IZV0Q3KY1x3INOMukN3YDRY
+oa1d14TqVEl+5JOGc3UukY+OYfjxZG9UhED52BKHXLUc72TGrsINVx9zZSsSSY+1YT+loKtNNTHto9rh
+qj1hWFJl8MVYlu1LmDwQHDVPCG1QOj8d1Q5XJg8AarPopaGrkcbg8ehIPTkNdbeOBauA5qWKrYiQTpNc
+47JAZ6WjxmGqPkxaRt4QhdbdmZPLxsEV5111SlWeiv5TXlFXKc80qZTnYh4bjoWF8T6DSbU62iZfyyBZ
+sD56mjDK8TLzDE1vslU7iFAzPtJlh8RXE/69KaAqNricoaCKGnPze7HpIwCUqwZcL0kTNnCPhO6jOfZg
+vRqzvNA25588xVJvxqkQZx/tViQKmrhV3oFyHuMAdoblZ0lYFZVTvlfBYkFUpdUl0gsFLaCqfLmeehWm
+1VYVLhdfr8K4NKuyxULtVVRaAgwbHKxvccm4qvLyhQlBj+wIqgFetrJPns4lrl0iRjh5O+6TKQJB3aGu
+jcL8viJfbAW3wnpuddWqCtkF4/bBiFmQ1c10Q60L2nmUhOl50XrPfUYF0RikOBFS9NTrWNZfn58T9ZXt
+6xA+XH1OnfvHmgj38t6wWylBb38ODR69xNEQLXJ29gNmZRYyxJrAUEZ+rcsIFaGg/D6oxVZthJYEU2RV
+AIBgdkbRVduHN0oUjULViUphCyyfLVm4iZkBCxIYJGEogrQqUVyhHskVbDEwZ2dEjIh/WS+ze1DWsjso
+Qens7I2M2AYTKAy1zyiZxs+MhTk8naHVa8zCBcWJ1ei9RCkyZn2GwWdLRPcw5WzClU+mwhjlvVUMX5oK
+CPuwVhHx2lRI56PQ6BGtq4LRK7/lrmDzVlAZaKMkSr7EeqU5h7lEni2jOMxYomRmtYfp1sZINoJX5N+b
+ZmkQzoKce06a/H3NEqft+lCftHDQP96hsacxlK4m46DRa7g9SB5CWpLWt/MUym6XK9afLdnsDM24706U
+FFeWXiPRMBZSVoscMgPqE1/Cj61IC22EiO8SJdi0oYncgR2XUFoUkWKui6mmZxPaEdS1TRC9VcnWcnGz
+jKf6h3qlKOnKyH/VY0ZXi6gaq85R2CV1iqE3gdo6thTZrENNThHrOhHxNGsLRbbF6POt0ZCqHdNHU3qD
+zry63faLItbrQWtTexanOVO2NXO+/rKIMJreoUyQXCrQGhfP2x4ORLFoch3THlMe1Bd+x2zpv/yuOmZK
+vUfM/VfQtigcc/CsQadrt9+OpRlhS//bSa8RonoI9SalNXOuRcsOO+dqE/Oopx+5OnlkyPHjk7ERJCj8
+0ixtaMUdV+ajekqnN13pwO4SVN85ev2EqTTMVFXpL99j+xIl3tBF/5lwz2r7mHUhAoCqX+2ronuDwzEU
+yoFGa67TKc2RFCiLg+ewtuNEcdinxwjwM+0xQVufHtshw2y1LIsrSM4QveeIFbqP9TtDIsNQvBI6oQFO
+UdDphJYiKgfXvTOkwA69y4judYZFPyuaDH0mWitHqqJ/d2/Sle+FO5LldAl2/j/EaSjNx0SJyKHxffr8
+Vnsfqj/ZihPDgzR0ztKrHeRQxLcKQzazy6nO4VQrdLLmNBHy41uT6JDs8FLcQgjo2OH5D9naOTHewGv8
+uV5KOGiayyYiammh7FOH2VSkUBqqZajDTQWud8TRh8ypqB70OP0oBJt3MevM7Bd3h55FrfQq0IQBMDBe
+pBeVKyz8VsRKMJzYRFN4uljEu1yiUDJVl2f1kWWR6V9FVfflUnSxbIEjPcN837ckQK012n44MCSCkTll
+dHN3YMQFu6mEm0MlA1qQVbGu4hPqhHGPG0ety1oBMqbt2Bh1fmpvYI8wAH0GUmTJlsNZckHqhCNwhI3y
+NTaD/geSe2niuUKYWWPerDP3zGQiuGj33BVr6oaZXwjTP89zu16odo7Z2kgr2I873j0T8LZMLjDyjocf
+rrzByWC0wE3w8N3mwcHBdKczoZgRb9MNSskqnZbmo9EEVX/+E2XbeYVMfmgAANt6IqMihpSpYvNQicql
+q0qbnuP2K7PrSoN/imhu1JhckVWrr3vkRVVLUTiaYhPVoDu2VKEn98raLa9FsC462otQOhgjVUumMKIw
+nRtb8e0GJjT351m6el5kNe5ARQ49OPCF6tSpkh87A3sdb6PVNeugzMjOwGCPLW4zBrGMbQGQOrLv/Be1
+iGvwGeyBQ/EQtj2nfnml2XUCFhNCJI2W28ApobJedBRrvcGuk9C0MzZhBte/JvycNly2d03zaboXJGlx
+I+iRYXF29kloCGZnfUkgIewnIWKGmHuTESQzFn9CYir8fUn6vrC2un1qhOFWX0JebbLFp+mVNWLeoT9m
+7NON0LxE3yaob6Sgt+kZS36Kcl75unWFmGiX8KTbWrCpJyzECk7JOmpCRlIV78Enn0oh08J/G98QFUwI
+Y/3LsROymHHmnFjvdVRtFXDJeY6FtLes04qWCrfnCG9SbGv+ZBnkS1N4HlFclHsb4CQdaKyv+JIlHSYK
+TYqdsQGkaVwIwjih8/rSqEBxpxTUA7W29Kbs5bRdErTTYPxkuvEqY0EXWHUUnEGzFzOWa+Wfp/TFp0tq
+eYioivHUeGDg6RkdHzErTd0zR/1iyqSQnvmvWLaK8lwGfDwtp7L64fs0I3Sv05hZUOFnmSVHwYNvawjQ
+FNVzcHI26i8OLXvggPLa6Xn6EXWKrocJlD063nXi3mxqCk9fQcY1puaaZSvkVXVxWnsCjEY/Pn32t6OC
+JSM7BZHCgBTZMqW0XxxN93OeBWtYBjlMgxCCdURgWGXLsnGJffIojLYyRfk7R2J75wAPppR3f/LO2T98
+5zx+lxQlawWCLEvP3zmPH43CaGsCklj3ZYJrBN/Ej522Mwv2ybVmp06lT8j6RLRcw0QAa5X3CJGuWUJ9
+lfMsTRaPHT0YHZIIbmQGXOLh23kUR49xZRDmPVjDniy9h6XjqFny6o4Gx2gTy44X/9dGXYelnT2WoZzo
+/5ot1L8XJTLQ8nEpfndwcIooaCe1IGoaFK1garNyk3J5Ae4WKUYqf/YjHbJhA+hpfgTOjMt0I40TCS7c
+aFYdTMra5LlklwPJz+ycyOl9HmkX+COPI4JDFsEV8Lc3aEAsg/zbiNdlXdOo7QUnR5a+wX2VAUtOf7fm
+mN2cZjnjCNaqZghkENjak+XJZV0t/YFw866K4zej5w9hLbQp4uTTpWZEfP7TDmOL3fKL1DASwf63ov9E
+F99tObODNZpQs1cXjLcGzzBw2h7NWLiZMaVP881qCGq6qnyzgj3w1kUznsBaNOEI7U2bZqdXjXhnbJ7L
+een/ICZA3pqA69qhBIuoDL8BnCGKAozwqZyrvdiU4JGzjNls1jqWXzXTYVLr+1Z7qqPGM6yxdZJUDpHk
+LlE7RQ6V2nqdKDuON+KzaHr4VjKD8rRUyZYdlCk76ulLczCyHITokE2DUx2DquCw4+7REXcX80FdTkfN
+FafVws5adZuZq2xmbn0za2Ow7GVOIqEd3VbWRrXDTuY0trCf2TntYA7tYP//AQCuKa3J/5ECAA==
 `,
 	},
 
@@ -24368,25 +24407,69 @@ tGllGjddBO8ulyRuhKBue0KrbvyE3Puc+6ET1rj8EwAA//+xJoPmMQkAAA==
 
 	"/partials/action.html": {
 		local:   "web/static/partials/action.html",
-		size:    2476,
+		size:    2586,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/6xWQW/cNhM9+/sV8/GQtYGVt26DAk0kFUFzySG9uO2dIkcisRSpcoZeb399QUparw0n
-jVv7YomcefPmafi4dR/iCMpJokbk58qEaP8KnqUT7f8uam3vHm0PMaQp71zUTnbo1k0VXEVj9QOo4DkG
-V5Vd0X5QbIOvd+W1pJ0hLkk/FryLenpUaQUilmyVAD9UnfW6EXycULT1bipwO23vMtH1//OEc/ayepkI
-I/y/gc3mCn6GzQbewcZIqjDGEDfwguZ+J4xejvht7aUlurJ+StzWuycL/6qbkYY/pLP6P3byGYnk8I2N
-MN6zjCghhgM14uY78dyHKyzHoNE1YpzhZ+JG+gEbcZdpS8bPNFxezZ/Xpfh0va13a7kXCkQmHBohFds7
-/OSV1eiZ4M0byPMDTQMb5QLh5lxNnaLM8/oakmapPqKTR9TwS64EHxf0N76j6X2RMtMnPjpsREjsrMd3
-4IPH9ydJp+RcFe1gWJQK0nqMjeiCPgrIGMufliyryUmFI3rOAcxhFFDW2XIu8YiOeJqc0UvqrfUK4RgS
-yIiQVbJ+AOkw8iVdARvJZWfWdgts1uc5iOBgnYMOYUoM1oNe6hbBoQ+xpNCEyvYWNayyX8NvBk9vQCYk
-pzPOwaAvhPB+QsVzxVwKOEBETtHnJx/iKN01fOrPIoaABJ1U+4eIXCK3xMYSTBht0GcJK/nCVhc0OZdE
-ryH0X0izBMQ51QdfzXW2z6D2ISrU1QwOESk5zlysBwkeD2CXWS2FfXiQIxc4SWYXRI29TK7o8P19KacM
-qv0TSfPRkLzUSGOHEfrgXDighu4IEpK3DJSUAUlwY+CSgFAFr2kLI4zWJ0baggETUqQtHOCAuKcteBiD
-Z0NX1+fz1FE1hSncYWxrezqfEnpZ/ZmQMrFK2agcViEvukGAjFZWxmqNvhEcE4oW6p2dLWc57hdfN6ib
-xaCKn/6TJ60KPWtK61GdHeil/vwFeqHvCbk4xeqncHKBNTR/vS7ci3Z2mXZpJpvW2e5ZHz6w7Y9fsdYW
-bvPg/prjrJLn9/GZrq/cWOmrS8zBL9wpdaPlk6917KFjXy0DvLiwVfs1MjO/LU/1bgZ6faI/LUTN2/ZD
-PqU1TdI/XB57PNK1Qz+wgRZuREv1Lke09c68XS710z3gLHGVfHFzPeNe1M5mtIgTSm7EPp/ADHr2a2af
-B8ytY57cM03Wu9xZ+3cAAAD//822YQGsCQAA
+H4sIAAAAAAAC/6xWQW/cNhM9r3/FfPwArw2srLoNCjSRFATNJYe0B7e9U+JIIpYiVc7Q622Q/16Qktay
+4aRxa19MkTPz3jxxnrZonR+gMZKoFHGd9c7rv5xlaUR1timUvn1w3HkXxniyKYys0SyHjTMZDdkP0DjL
+3pksnYrqXcPa2SJPjyltVXFO+jHV2xTjA6SlELFk3QiwXVZrq0rBxxFFVeRjKpcrfVud3f9/mnDMnncv
+AqGH/5Ww3V7CW9hu4TVse0kZeu/8Fp7R3O+E3soBv629MEdn2o6BqyJ/tPGvuhmo+0Marf5jJx+RSHbf
+2AjjHUuPErw7UCmuvxNPvbjEcnAKTSmGqfxEvJe2w1LcRtqS8SN1F5fT6zXBP96vinyBe6ZA1LtDKWTD
++hY/2EYrtExwfg7x/kBZwrYxjnC7VlMFL+N9fQlJo1Tv0cgjKvg5IsH7ufq5rWl8k6SM9ImPBkvhAhtt
+8TVYZ/HNSdIxGJN53fUsEoLUFn0paqeOAmKN+U9JltloZIMDWo4BzG4QkPZZc4R4QEc8To7VU+qNtg3C
+0QWQHiGqpG0H0qDnC7oE7iWnk0nbHXC/rKcggoM2BmqEMTBoC2rGTYJD63xKoREb3WpUsMh+Bb/1eHoC
+6l0wKtY59GgTIbwbseEJMUIBO/DIwdu4ss4P0lzBh3YV0TkkqGWzv4+IELEl7jXBiF47tUpYyCe2KlWT
+EyRaBa79QpomII6p1tlswtk9UbV1vkGVTcXBIwXDkYu2IMHiAfR8VxOwdfdyaFpJpueKClsZTNLh+7sE
+1/TY7B9JGkdD8owRhho9tM4Yd0AF9REkBKsZKDQ9SILrHi4ICBtnFe1ggEHbwEg76KF3wdMODnBA3NMO
+LAzOck+XV+v7VFM2utHdoq8KfZpPCa3M/gxIkVjWaN8YzFzcNJ0A6bXMeq0U2lKwDygqKHI9Wc487puv
+G9T1bFDJT//JkxaFnjSlZVQnB3quP3+BnmtbQk5OsfgpnG0ehca3V7s7UU0uU83NRNNana76sI51e/yK
+tVZwEy/uLzFON3L9PV7p+sKNpb7qwOzszJ1CPWg++VrNFmq22XyBZxfWzX6JjMxv0qrIp0IvT/SnmWj/
+6v6LMXpXGxxE9euI9jSNFAe/kDGs99iWIp/j3mpVfvo0P3z+LKp5Cf9f7Ra5rIq8f7WgVe+iJxQ0SnsP
+vMcjXRm0HfdQwbWoqMhjxCoznL46RhNnwaZvh5q62BRGx2oeR5Rcin2c91h09dtpH6+zWYYqmCckLfKo
+Y/X3AJuMqpEaCgAA
+`,
+	},
+
+	"/partials/alert.html": {
+		local:   "web/static/partials/alert.html",
+		size:    1793,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/6xVP2/7NhCd7U/BcvghGSQhabo4FIsizdQgKNIWnSnxLBGlSOF4imu4/u4FKcn/Aicd
+usjS8fHdu3dHWrT38icLSGy3c6qD/Z6J0ClrpVDMNVmLsC55UXu3Ns2PKiLLGcnl22CB/aoaEIWSTCg2
+wROu9oH4RP7kA0WMKCbyor2Xy6XQ5p3VVoVQcvQbHjOG1m9KDogeuVwuTiG1t5ltsrv7uLAQPcK8khKy
+9My0cg1g4qqM0zMXC7S1UPJNawiy0KsaVqxHyDao+kcuRdEjxISFNu9yOf9cVWi90sY1X2g8WTnVaNza
+J8DiZaTJ8zzCx6QXGj6KmErpFDbGZZUn8t3q7of+78ckZ+2xm/HxPTPOGgfsqC2VMVSdobGQm9sPctPG
+Bv3Qj0KFVRVY+aJiJ8f3FDauH+hsU+0dobec0baHkruhq2I/OuNKfpdSd16DLbk1naEzdhxcOGGf/ViI
+aiDybmIclfM5aUWOVeQyDWs1WOLyDWJNohg3JT+jsAtDXZOZdcljytyCa6hN5rUP08z+AtvAqi37jRQN
+QRTtw2WrW4XEGYWsQdW3TCtScQAGR4HLKVcifH5XdlBkvGO/mw7YTYDaOx1u/zOrHjDtPxJ/OaCxMPbt
+G/vupML0/T9N7qtnKYVfM3V+g2wAgSHUHjXoz+aaVGUPZ3j8SM84QhpcAD19B0LTg76o7rRt1ILSSTjh
+OFHUymi2KKg9BH6ebDwLPqEhUyt7FvxToTOuOYv94f5yfuMuYjA2F/RZ/NVjd0H5HO+hOSKKpFMUs3BB
+ldfbqYJYJ0IPikqOzLjkdKp+Mms33nIrhnmi3U/HiLQUoVcuzg+ZDkqOeXQhjk2MS1GQPkB3O8xnS9g/
+bDypq+/3+3AKO96kmM9ecXkNMRl3HTC5+BngYOl10Ojv9fXn8Q9kXj7aPbosijRYcvnvABcLXmEBBwAA
+`,
+	},
+
+	"/partials/alertcost.html": {
+		local:   "web/static/partials/alertcost.html",
+		size:    2354,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/5RVUW/bOAx+Tn4FoacNOMdoD/eSyT7cDTjcQ4fDdf0Dss3EQmXJlegmgef/PkiyE7vZ
+1vYlsciP5EdSpHh9m/+l0BJ8No54Wt/m6zWv5DOUSjiXMWsODPQ+cbU5ZAytNZbl69UcUhqVqH1yc+sV
+K95anDQieA6/SSX0Hm3wVUhdTb7A0Ulhxg61JExcK0rcQmsxOVjRfmI5T1uLPmBayed8Pf39lKEyopJ6
+/wrHmWbOUeqdCYDVXXSz2Ww8PAZ9weGaxJhKI+xe6qQwRKbZ3vzRHj8FOjtjmwnvvxOpldQIF24hja5o
+JMVEPny8ohsM99Z0bSTKpW47WmhLo8kaxaBVosTaqAptxv6RitBCaLYLkRpTocrYLihioDHRX0dUokCV
+f1XmgI6gOPE0SoLSocLyJ3wuMZ2xFM5l7a/FItvVipuWpNHwLFTn64lCs/wLCg0PskGeRvWPseLI8i/i
++DpSCUcsvxOOXseSIaFY/uD/XkdbdJ0ix/L7+LEA8zRW6L3lfjDtos6/aDudWsyY7prCz1sjdcZu5sVX
+spH0ot9FR2T0aBqvIJu8F6ShIJ1UuBOdIp+XbxdPo1Gwd63QE57wSEnTEVazuRxL+LU2B6n30Pdh4txG
+od5TPQxgdtD3ATUMMCp56v2+LYCTukSW+zXmQFj0DqlGKGssHx3YTkNxAqqlg8K4TkNrTYnOQTAESeBI
+WMIKYixySdCcPY9k3kXqYOyjn63/tDqNSUVCWHk2fR8BPmOL4G30xT9PfV9n+4ZEoc67NR7Cr299hdph
+NZ4dWdkuiCyqHbYR1SiqkAXZeKWojk8BT6k+Sz6H8i1Es0mcS8XxWjgbr5l0Pkcz8XlcZrL/O7QSHbRo
+IVBZaP8W5SPq6mzC05ALT6fkOBWmOo1Z+mpYbFFQxgRIPTXkG8QVuO1D+tvxOIzzR1XOhbetLe4ylgar
+P7VoMOt7sQk2w8Dy2YGnIucpVZODy6MnNrGgbKEPtr6svibwDeLsbn8fBncNE8c3oHzh3wBbQm5eQOa0
+vcP7abFd+ZnalMKU4MLrAh9Xpt94s348hX4EP6c76Wh8CVZ9/7R5OLU4DFvw32OgYYCn+PVbEP+4duBf
+Dg9o0Zao6cPT5l9J94Kk+TgMUIqyxirSmRbhaqJ6uUrxBvE0jFa+/j4AoWuxKjIJAAA=
 `,
 	},
 
@@ -24464,6 +24547,26 @@ kxPzklNznHPyi1PVNWtrleycwQIKLqk5iZWpKQpgGRv9RDtAAAAA//+WIGxxXgAAAA==
 `,
 	},
 
+	"/partials/cardinality.html": {
+		local:   "web/static/partials/cardinality.html",
+		size:    1756,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/4xUXW+rOBB9Tn7FyFopu9ICaqV9ocA+7Oq+3Fupaqv7buIJWDU2MkPTiPLfr7BxQvqR
+3pdk8IznnDM+dlZfF/9xK6TmStIhS+rrYr3OhHyGreJdlzNr9gx0FXW12ecMrTWWFevVsmRrVKSq6Op6
+Sqyy1mLIcIWWwP1GgusKretVSi1CL+jooDBn+1oSRl3Lt5hCazHaW97esCJLWosTYCLkc7EOf58yVIYL
+qasvOC4yS45S74wrWP3wbeI4nso96BsO70nMUhpuK6mj0hCZJr36p325cXR2xjahfoojqZXUCCduTkZf
+NpK8kD//ekfXbays6VtPNJO67eksuzWarFEMWsW3WBsl0Obsm1SEFm6RrNx2DqoxAlXOdi7jkWallyEV
+L1EVj6bNEh9+RYQOLeZM9005OaCROmdXSwZKNpLeECh7IqPnrX4oLHQvSUNJOhK4470iVtzjNK4s8Zvc
+/q7lOtQTvlDU9IRi4RQyxBUrHmqzl7qCYWj8aGKFuqJ6HMHsYBhc2ThCyGbJ1PkShDfQJKlL16tQd3Ko
+y8S3/OUBrcTuDq0/FFYMw6fJcYTOrUCLdiZzovI5xiOvfnLVY3fe/bg8jvDsAteXeAVPePiocS0FXiAP
+r6/wIao2GhczC1GWTBZZXCbipTo+HP7D/U4uEqg7FPN3R1a2Zyd5fnDurlGNXDhAsl4H1cXtPDWqj0te
+xdnSI6/Akz9bvsddP5H4nxOHOyM1HfNZ4kCyJKBmVBpxmOEnnhZb5JSzBqQOToJX8DcvHTyxdP4enbR5
+EoN/N1NoYmcpFON8C0mc3tImDhbKEhIhXwxDE3uF4/j7LoQELhpxPsMzpGP3oPTJKY2/48E572mKxjGF
+KQzOG4Y/FO8I/oXNBlLY/A2bD7svdc5DOAk9Dd/PPEucTYr1rwEAquFRWNwGAAA=
+`,
+	},
+
 	"/partials/close.html": {
 		local:   "web/static/partials/close.html",
 		size:    115,
@@ -24567,40 +24670,73 @@ AAA=
 
 	"/partials/dashboard.html": {
 		local:   "web/static/partials/dashboard.html",
-		size:    1350,
+		size:    1855,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/5xUzW7cNhA+r5+CZQtsAoSrprm5kgAjaHpIURQIei5G4kgiljtUyZGdxcLvXpCU1lpn
+jQa+SJwfzvfNH0tt7kVrIYRKevcgBfUqDO6hktaBNtTL+mazdmqdVbZX73+JhgsLWPQs0lcZ6lyK1RjS
+q1hloc19jJj/y+9FEui986+joIF69CsSc6zvo3AFMhzU+5+/gTQ0Tqx676Yx25LiZrPZzA6d8wfVOmLv
+rIx6Po5YScavnETq1cFptJXsjGX0i3KPR+0eqJLz4c1PeI/Eb5N9tNDi4KxGf3GPnbNsxnQ0bLGSn5JR
+GGqNRuKQSG5KuMJfgdaOpNDAoBLCAYkr2Thmd5Bi8NhVcmAew21RNC5MtHO+L6YAPf64IKhMJ0jB4Hvk
+Sv7TWKB9Bt6UZkHuQHSg/p0wsHGkWuNbi8pFpe2lAG9ADUZrpEqynzC2zsxBwjTWzyPhV0ZPYJU1tH/x
+flnEu6kGBcT/PADngbjW93nSYC5BkUYpyLpsJmZHi3fDlOZtFk/bhklp7GCyvL0VP4R2QD1Z3H0CYw31
+d3FQwzuR/dK8bm/Fda9HKQIfY0c764BvvekH/lWKPE2Zh6xvhEjlGeGJFOgeZX06vRD3sViZ/qbWuoD6
+t5Tg42NZxFC1yHKqVoaqc/WuLNF5d89B/0KK27+zSD0PabWGD/WsLYvhQ9QwNBYXzllI37g6Gimgzj3g
+AUHn/rGfp4GHOiUjPuOxLHh4Un9h4Clc6u4acv4AVnwx1OKl7Q8ILD4O2O7P+rLIOGVxhi65cfq4kIgZ
+exwRuJKjMCSeJ76eiREI7cd4fjPuMrt3Yrt9u2wH6/p0Gncpnc94jB1gfWnLt55bcs85qBCzquS4S+nF
+oU8t/D/vmHlK/NsbqwrMeZdF6s1l4zkoaPf5KVl1//coh92fiPqujXvZ7iu5jWIQd+2e3INF3aenZivP
+tXsKIAWbAwJpDRzfzSdB1t8Pv0LSC4e17pXQ/w0AH5YkbT8HAAA=
+`,
+	},
+
+	"/partials/dashboards.html": {
+		local:   "web/static/partials/dashboards.html",
+		size:    3665,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/5xTTW/bOBA9O7+Cy13AW6CMmh5dSUAOTW+99VyMxBFFmJ5RyVGcIPB/L0TJjtM6QJCL
-xfnge88zj6X196oNkFKlI++1ImdSz/tKBwbryen6anXe1HIwwZmbz1PhRQUCRlH513jqOGM1nuwZVllY
-fz8hzt/j51URGCPH90mwQA7jmYgF620SLlCmnbn59Belp2EU4yKPw1zLiavVarU0dBx3pmWSyEFPeXkc
-sNKCD5JDcmbHFkOlOx8E4zG5xUfLe6r0cvj/P7xHkg+5PgRosedgMb64J8xB/JCPXgJW+i4XlafWWyRJ
-WeSqhAv6DVjLpJUFAZMZdkhS6YZFeKdVH7GrdC8ypE1RNJxGuuboijGBw3+PDGaWk7QSiA6l0j+bALSd
-iVelPzJ3oDowv0ZM4plM62Mb0PCUDE4riB5M761FqrTEEafV+QUkjUP9JxI+CEaCYIKn7av3y2K6m2dQ
-QF7YbICTIS7tfXEaLCMospWSrstmFGE6djdC2W9L+LRuhIzFDsYg6436J7U92jHg9R344MndTkZNH9Xc
-l/263qjLXQetkjxOG+0Cg2yid7180Wp206xD11dK5fEM8CwKrENdPz29gnsozko/qA2c0H7Nf/BwKIsJ
-qlZznKc1U9Xz9C48IkkG2u3sqEqfkL9Ncbr+jmhv22k97bbS6ylM6rbdEu8DWpcdt9anGTwDaCV+h0DW
-gkzP5zk4Pek30J8x2aOG89w7qX8HAAD//zYcprRGBQAA
+H4sIAAAAAAAC/6RWW4/UuBJ+7vkVxkIwI03SMOK8QBJ0dOAc0FlGrGDZh9U+VOLqjhfHNrbTTauZ/76y
+nfv0cFleupNypa5ffa6M8R2pBFibU6P2lMhtYmu1zykaowwtzlZTlUqJRGyTx1f+YJVpg/0JCDSOhN+E
+gdyiCbZKLllvi1h3EJjTfc0dJlZDhU+JNpjsDehntMjW2qB3uGZ8V5x1f2fBv9wmfJPTexIaDEHVV8UL
+sHWpwDCbresrL3RQiiGi+BJ+k0pJhtIi696tM1wjm6TLBmOpQLl1dczQ1QjMP60yZ8K/lxXX0GC2dvUo
+ec+dWIjegkRh57LfLJq55I1ifMORDdJsHT1l68F55krFDn0YPmiDGsHllBEuyRg77e2yIgOvVxvc5HQ9
+ajz3FcyPR5b6HG5uaDE+Z2sosrVjg5GxgywNCdK7z2O2XfHIly/k0VeUfRkWx0VmNUjibOJ4g16pr4xX
+9Gej/qREXWGydWjsAq5TUHK5USf7TR48IPdOt/9aTWpLwCCxsEOWDhhdZRtlmt6df064FFxi9NSWDXc5
+rQyCw/OLiXtoXZ2+AvsWTcOt5UqeP3zJuCMjqB9eRAhO8gkOtka1msY6cKlbNzutlHRGCUrcQWNOHX52
+lGgBFdZKMDQ5DSPkI2kUQ5HT+xoMSpdK3F+HI4OfWm4wIq/Lc5WVrXNKdmZjZrT3XDpJSicThhtohaPF
+Ne7HTLJ1/Da0yQd5erano308DnWPwPOAkgGkJLMNCOHxfQvcdMYJEOLu1ULKleDVx5wi4+7H2xGA4j/l
+cksLfxwHpoun46A7CVUoYOHLs9VXOPXr+A0aq1+ipTRN4zD0Leoeuv8ulpHQ+8i9qscFGITT2DFqb3N6
+9Wig7I2SLtlAw8XhKWmUVIG9n52CkVWtqSJRdD7iVdF7qlHopBSq+hizibxBatghAfL+oJGoDcHP2lxG
+sr4kn1o0h0vCZcUZSmeJMqRW1hGQjIAkL4Pyr1Hrv1w4NF7llbIuVOgDGO4tDV48zi+9N9j+Hw+XwY7S
+jisJQhwIkDfoDK+iffIiojol9z0CPeEC0T5qwi0xGIaLkfJAXI1kB6INGcSX6DhANw0t0tNhWoyPNrwB
+c5gi1fPN+QUt3sEOJ3N0l4XJzdsZMNio3Zx7hnmhxQsU6OaGazOD0J381iGjAbPlMimVc6p5+vhf+vOz
+U67SoQez6/U0t00vuN3sghvNdMMioERRHI+74QqLknBoUWB1Bz2OwN2BsX/E7/+Mlat9FXNq0X0Acx6P
+LmgYPblNIlBsTmOrN8p0TecyPozWussqxjEf0ciDt/liKMukBtqbDoizdMEax6NOf+fM1Tc3nbf6SeGF
+gTV9PeonUf7tXS22TKfdtjZc14PkO/e3Bb3VYBztSV6nWwO67kHgr/sgIAwcDMe06As1FiN+7ceezncb
+L3ruf3Kft3+4uXkQdojHLIGtosUoj4Q9mP5H+2IMxFPksG0NS+JkTQzy4n8e0OPCF4UfPEomwn6dme18
+s63v1t5nAiSmUSx2LBMgON2xbimEMGYas0AG5+Nu9XMlG/j7O+r2utNdlu7fHrRL4TsHrrU/V1AeC7qM
+8cQu3as85yw/Hnn6msUtmqfv2vIvrNxykV4UnqchiW+0h6f/aY2/UGNyP9qmby3Bi46E1WbyPpD0tSJK
+oxzv3vSudaP7+3sA+J0sn1EOAAA=
 `,
 	},
 
 	"/partials/errors.html": {
 		local:   "web/static/partials/errors.html",
-		size:    1698,
+		size:    1802,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/6SUz47bNhDGz/JTTIkCsgFLThbpxZEEpEFycoOi3lvQAyWNJcIUKZDUOoaqdy/4x7uy
-14sWyGW94gy/+XH4DbP2oYAvSkmlIdu0D8VikdXsCSpOtc6JkicCokl0K085QZtHikU0T6kkT3iTvH+w
-gSjrFV4ilKMy4P4mNRUNKqdVMlFftECbM8ecnFpmMNE9rXALvcLkpGj/kRTZpldoC25q9lQsLj9vEnJJ
-ayaa/2CcReaMTBykS4h2XiZNU5vui94weIjnwkYayndMoF6uoIB35A5lOGtHVcNEUkpjZLd9/1v/46Pj
-LQdjpAg74tIIKI1IajzQgRvoB84TxZrWxLZqxVl1zEnFkapPnC9Xrgk107TkWN/w5LkF+mxz4RPnMI7z
-8DRlG1/7pyj2yLEyWL9C0SFwj2Ycb6LTBJeVF6o7195TgTxplBz628t2IcegsEdqnNeACUBv839AqhrV
-7+ctfI/3Q1Wh1vE6TvZDF/997fZ0P3TEH9RJj7Gv690cb+EXn+Q01hCiOmhuZ8GJwK31fHKL3rGzdrpt
-rTwJyEMB++GdmbUfrvcbZjiSwvt4HG36N9rhNEECS/+9H7ppAnxCYfTKiXBaIn/Web7T0HpfKYoyJvrB
-gDn3mJOqxepYyh/k9TbH3skauWd3qViTK4ew6rhEpdbwqwNZheNsHEr4v/1QwGzg7nSrlPXZ6bLDrE9B
-642xvjw9Strpc2tX7uBMYLBH+uXWIt/jZEe1eWQdWnNcjfD2XRjdKIrG0cqkf6DWtPHdD0uf5SDMNIFh
-HWr4qmQHme6pAKMTu+YB0q9M+TL20bPxAh7l3cwLz0uiI4AEMmrP1So85GQc1cBxx8RxaXetUanVNJHi
-r4Ej/EkbzDY0tP3S7bvv3P947vxAL968gDAPvlPfZJjDFB5bpoFpoNBIWYNp7ZP7AhJ+/g0AAP//aB7A
-n6IGAAA=
+H4sIAAAAAAAC/6RVTW/cNhA9a3/FhCigXcCSEiO9bCQVrpGctkHR9S3IgZLGEmGKFEjKG0PVfy/4sbas
+rNECvawkzsybx5k3s3l3XcJnpaTSkOuecl7mFDqF9wXJKEdlaqkNKW/sK9xKbfKMlnkWXLPuutxs8oY9
+Qs2p1gVR8kRAtInu5KkgaJFJuYmWLrXkCW+TD9fWEOWDwrPFJQT3mzRUtKgcVsVEc8YCbZ44FuTUMYOJ
+HmiNexgUJidFh0+kzLNBoU2YNeyx3JwfbzLkkjZMtP/CcWFZcmTiXjqH6OBh0jS17j7pioMn8ZzYSEP5
+gQnU2x2U8J5cYBnu2lPVMpFU0hjZ7z/8Ovz45PhWozFShIi4MgIqI5IG7+nIDQwj54libWdim7XmrH4o
+SM2RqhvOtztXhIZpWnFsVnyKwhK6tb5wwzlM09I8z3nmc/8vFkfkWBtsfqKig+ESm2laWecZzicvrC60
+faACedIqOQ7rZjuT46BwQGqc1oAJQD8Yf4NUDarfn/bwLT6OdY1ax1dxchz7+PtrtafHsSf+og56in1e
+r+Z4D++8k8O4gmDVAXO/MM4E1tLzzh16xS7K6cI6eRJQwLvnD6/MvPv4Ot4ww5GUXsfTZN2/0h7nGRLY
++u/j2M8z4CMKo3cOhNMK+TPOc09D6X2mKMqZGEYD5mnAgtQd1g+V/EF+DnPce9kg99ydKzbklUJY/bBF
+pa7gF0dkF66TOSrhvftYwmLgLlSrks2Tw2X3izoFrDfG+rx6lLTT585eqYMzgUEe6ee1RL7FyYFqc8d6
+jL+vRnj/PoxuFEXTZGHSP1Br2vrqh6NbOQozz2BYjxq+KNlDrgcqwOjEnnkC6RemfBq79Ky9hDt50fNA
+146OASSQU3svv+6nSY0cD0w8bG3UFSq1m2dS/jVyhD9pi3bzXwr0/xO/CdpjsdSTDRX6HPXSo4vb8T8s
+Sb8GNm+2LUyRr+9XGaY3hbuOaWAaKLRSNmA6u6hfiITHPwMAVIL46QoHAAA=
 `,
 	},
 
@@ -24750,49 +24886,49 @@ kPbj6XFNA6z51KuYSaTMHYWJRyzmlGCtFn9hrRxGXePwpOlxhYTb5W8O37F+S5OMtQkoZ+OZhB8rhTJy
 
 	"/partials/incident.html": {
 		local:   "web/static/partials/incident.html",
-		size:    6267,
+		size:    6399,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7xY3W/bNhB/7v6Km7alCVDZSZoVmCcryLoMC9p1w5LuZegDLZ4lLjIpkJQTQ9P/PvBD
-H3bkJK2TvVj08Xj83QfvjowoW0KSE6WmgRQ3AfA0VJm4mQYopZBB/NWLPksi8jBPw6NjM7E2Q3KUGuxv
-SAlPUVpZM8ZpKysaU7Y0Et23+Rgp7baMJ4wi17C3B0oTjZsQDEq7efbafF5EqiAcNNM5ToOrjCnA2yQn
-C6KZ4MASwUFiIVEh1wp0RjToDB1SEHPQZkm7qx0D4UASzZYI+1zwkAu5IPmBwzPq2YipM8sWNODmBOYk
-7AEIEyaTHI3uBqdDTIyETOJ8GlQVUqYvWY48wfeMX9d1sKbMotT42VpkRMEMkYNygimUXLMcqkqzBe57
-6uic04O6ho9Xb/tK+dkNnZYiLxcYivnc6EKsIhfNflXVbD26oHUNzid3fDr6gEjPkusghhA4IlVAkmsu
-bnKkKS6Q685K0dj6tw2ZoQgoBrY45zSIzzmFK7bAiQ8OFRq9e1yXmkgdmAnkdHPSiAAuwpzx62mgZdm5
-LxoXD4HaOC1qEb4JnNsHuAf5X/upF1ERR0pLwdP4zDr6Ha4m0diTHBbD5uEMS/uhldY4xZ3JVl0r+h2u
-AhhvyuuNdgFvre0dshv6rZ58LuhvSylNhF9qokv19Mb38p14owYoxhOEbeoaIz6ftu+J0nA2c/nu2XQ2
-uzSb3K94WHJ2u2Xl81riL4Y3u6jeS/HjjCkt5Or0GlfTqkKeCIr7d47fQV0H8S9lnsOvjt9k2Vd3xVVV
-Ivicpb5YxH+WOcI5ZVrILSvGeFvIU/MzraqZFqTb/Py2kHZjM0ClmOBNcn96m/4hcclEqaCpGwr2q6qt
-WqNm/oIqOB0kj3Lkqc5gAodQ1we7+kdigURPA0ZN0R/E8S/kbMH0lZh8H/Qs2vCeMtpzKbWm/KaqmC2C
-jSF9ocoY7Z/k3i5B/EFwHNBhu+EHBH7AW31BYTqFw8/zi1nY+uSJYn7YQOtQW2utk+v6vhDsBs9TaH0r
-tpMZ/MxMc5hpHlKckzLXdnyr+mHkW61TWkrbLU6Psj3b0k17VrEJoq73NEnVgC2vSKqsJY8gE6XsRd0u
-QI53AHJsgagnQvJmByRvnhTJ0S5GOXpaqxyf7OKgkw0sT57qzbVI8C8poEZpNp8G7q7V9qbNFlqFXGiM
-o3Ez6iZIcu3oZtCRk1wov8ANu6m5kAn2GfqENbYUdYMslaIsRq5xMdn2ZcnNHYa/DFoZKere8qKUqZfv
-hp+V5TfuN7YFstb9ktbOLvzy3NaLtQ7H6GpVYF1v9HxbYY8+KpRBPFvBsDQzbUqAvW8B0Vtb4T4C0wv2
-r9iPAvIbKkVSDOLJFiieoUXzUE26916YnTSnIhpnJ/dwd6WdOH7f9ZguAUgjYvtap/DmWgNBk1mODbv7
-Y39DpSUrkAag9CrHaXDDqM4mR4eH3/mKqjMk1OuvaWw8Ho11j2K8tk7xxlsnGketU35GQnPGO2o07jaL
-9EzQVcMs+z0bse80TskuO9DuukFsXNozSYfnXSBun2/jY50lXn+wIv5O1gYoacPR+OiBtY36a+tb4h0Z
-0VjLxkqNaaKxdWIXUdsD8Hxpuu774w8KwjG3keSJlvDWjPeXPu8dBH1f7H/LOMXbV7A8MF5Bu8ud1xAr
-JjS+ZTz18llybbNMTgqFPTE+7LKT9cX2YazJewRMcRy+azY4B5NCI7LM81CyNNO9Y7Pc9GaP0D0VOQ3/
-dnjDo0+WB05hmD6Brgb/XiCHUyg5xTnjSGEC/XenA9j28uTdT7z3rQeHm2FnKhMdXTHNxA33sD71muRW
-7a+XIyo4vhfOObEfjEajxxSn5ah9LB4oIN17sa1IEh/1ZNzIbFNSxjSGqiAJTqCQGN5IUvxozFPIR1ZU
-axgX3k2rNQB5PUwb2NnrwUi8LGf/YKL9Y+X2Qtr5BDQuipxoXNNVOTndE/n/qMNPgq52UiDM9CI3WtiY
-ix8ukO7zXwAAAP//bY1XK3sYAAA=
+H4sIAAAAAAAC/7xZbW/bthN/nf+nuL+2pQlQ2UmaFZhnK8i6DAvadcWS7s3QF7R4lrjIpEBSTgxN333g
+gx7sSGlaJ3tjMXfHu989iHdippStIM6IUrNAitsAeBKqVNzOApRSyCD6315XJBZZmCXh8YlhbHBIhlKD
+/Q0p4QlKq2vOOG10TceUrYxG96wfRktjlvGYUeQa9vdBaaJxG4JBaY2nr8xjb6pywkEzneEsuE6ZAryL
+M7IkmgkOLBYcJOYSFXKtQKdEg07RIQWxAG22NFbtGggHEmu2QjjggodcyCXJDh2eUSdGTJ1bsaAGtyCw
+IGEHQBgzGWdofDc4HWJiNKQSF7OgLJEyfcUy5DG+Y/ymqoINZ5aFxi/2IiUK5ogclFNMoeCaZVCWmi3x
+wFNHF5weVhV8vH7Tdcpzt3xaiaxYYigWC+MLsY5c1vbKsjY9uqRVBS4n93I6eo9Iz+ObIIIQOCJVQOIb
+Lm4zpAkukes2StOxzW9TMn0VkPeYuOA0iC44hWu2xIkvDhUavztSV5pIHRgGcrrNNCqAizBj/GYWaFm0
+6ZuO88+B2npb1DJ8Hbi090j3yr/yrL1pHk2VloIn0blN9FtcT6ZjT3JY9vYaOP3afmi01Ulx72TjrlX9
+FtcBjLf1dVa7gLfR9gnZDf1gJp8L+ptCSlPhV5roQj198L1+p964AYrxGGHIXRPE5/P2HVEazufuvHs2
+n42V2sjDjocFZ3cDO583En8yvN3F9c4RP06Z0kKuz25wPStL5LGgeHDv9TusqiD6pcgy+NXJm1P25X11
+ZRkLvmCJbxbRH0WGcEGZFnJgxxjvcnlmfmZlOdeCtMYv7nJpDZsFKsUEN0qGzu8PUswzXF7SoNdO7thn
+jM7K8v4mY8f/Ad/0C1jjbad8+rx+kLhiolBQ9y4FB2UJHSyOf0kVnPWSRxnyRKcwgSOoqsNda0RijkTP
+AkbN4NGL4x/I2JLpazH5PuhEu5Z14a7Litp0mvDaRjwmTQzsVkZxFvRZCaL3gmOPD8OB71H4Hu/0JYXZ
+DI6+LC9mY5OTJ3rv+gO0CbWJ1ibZVeJQJNrF8zR7Pw7uFAbPmWsOc81DigtSZNqu71S3jPy4d0YLaSfW
+2XG6b8fK7jtsD6mq2tckUT2xvCaJspE8hlQUslN1uwA52QHIiQWingjJ6x2QvH5SJMe7BOX4aaNycrpL
+gk63sDz5UW8+zQT/miZunGaLWeC+95r5uDahVciFxmg6rlctg8Q3jm4WLTnOhPIb3LJlLYSMsSvQJWyI
+JahrZIkURT5yw5M5bV8U3HxH8RdBoyNB3dmeFzLx+t3yi075rTHAjmE2ul8zXtqNX3+2dWqtxTG6XudY
+VVtz5yDs0UeFMojmayjLIbZpAUZRBEQPjuNdBGYe3RheHgPkN1SKJBhEkwEoXqCqupof6EkPfpump/Vb
+MR2npw9It62dOHk/9ZgpAUitYnivc3h7r4GgyTzDWtz9YX9DpSXLkQag9NrcftwyqtPJ8dHRd76j6hQJ
+9f5rGpmMT8e6QzFZ26T44G0STaI2KT8joRnjLXU6bo1N9VzQdS0suzMbsXdFzsn2dKDtJw+xdWnfSdrP
+d4U4zG/qY1Mk2rw0I/67sClQ0pSjydFn9tbub+xviPd0TMda1lGqQzMd2yS2FTVcgBcr5Poz9Qc54ZjZ
+SvJES3hj1gcrf+4dBt1cHHzLOMW7l7A6NFlBa+XejYxVE5rcMp54/Sy+sadMRnKFHTW+7NLTzc32cq4+
+9wiY5tj/vVvj7D0UapVFloWSJanuvDar7Wx2CO11lfPwL4c3PP5kZeAM+ukTaHvw7zlyOIOCU1wwjhQm
+0L37OoSh2y+ffuKzbzPYPwy7UJnqaJtpKm65h/WpMyQ3bv9/NaKC4zvhkhP5xWg0ekxzWo2aC+u9vQfu
+rA0zl/ioa+taZ3MkpUxjqHIS4wRyieGtJPmPJjy5fGRHtYFx5V2PWj2QN8u0hp2+6q3Eq2L+N8baX5gO
+N9I2J6BxmWfmbr3rq3J62mv6/9CHnwRd7+RAmOplZrww7F4Xev/58O8A/n91KP8YAAA=
 `,
 	},
 
 	"/partials/items.html": {
 		local:   "web/static/partials/items.html",
-		size:    817,
+		size:    871,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7yQvW7rMAyF5+QpBA0XyWAYvrjIcCE5W9GlzxAotmypkE2XkpPB9bsXlBLkrwjQpYtJ
-8BwfUp+o7YFVTnkvOcKRs77NvIGj5D6oMHpeLhfXngpc5tqs+Bude9vXF6fIa3sol+dyl/x90IbmC2GK
-8k0HtJUXuSniqAHsGILTklMbfTcJNM1ahHFI2kLYfhjDjVxBHxAcZ4NTlTbgao2Sv1gXNLLTxviSDmrt
-JG+ichbSyvQcaigydqNLkrP0L+pBqyB5F39jtmep8+yTpcD/d7lCMYO6kTxvUQ1mu9/8k9O0D6BW7x76
-FeqPXYPQ7bpVilqv1/N8hTxNCbkqRe5sui+edb73KexX8OE3Ucd9j6DT+IeYDfhAkKk+ID4lXgCTa0sf
-OU1U5vlPsJ2WRZ2pFq6QkvgM6Kl8BQAA//8QdQT2MQMAAA==
+H4sIAAAAAAAC/7yRv2rDMBDG5+QphIaSDMa4lAxFcoZC6dJnCIot2yonnyvJCcX1uxdJDs6fEujSxXe+
+7/Td6SdWqgMpQFjLqcEjJW2d2AaPnFonXG9pvlyc9xQICdRJ9hg696ot506WluqQL0/hyvl3o42vL1iT
+5e/SGVVYwqwWADkTpDGy4jQthClVK0C5L5q/zD8sFTlLp+60yYJRhUYTgyA59Wlwv5jrq0ltsO+itmCq
+7Xp3IRfYOoNASQeikA1CKQ2nrwqcNGTaM9xfYymB0yooJyGOjBB84i1D1kOUQPmzRnZSOE51OEZUS/RE
+4JtEw+cr3xlJbUTXbPebJz4Me4di9WGxXRn5uasM6p1eRav1ej2OZw8VqzSP5EDF/cJap33vPtEbWmf/
+EXWYdws6lv+IuUHrPGQfbxBPjjNg37X1Hz4MPozjg1Na8qxMRI1nSL14D+gUfgYAhmEBs2cDAAA=
 `,
 	},
 
@@ -24820,6 +24956,26 @@ UXzjrUA6GI4wXUoHJKwvKdp/3tStj5r8HQAA//8fYTuV7gQAAA==
 `,
 	},
 
+	"/partials/problems.html": {
+		local:   "web/static/partials/problems.html",
+		size:    1833,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/6RUTU/kOBA9d/8KEyQaJJIsHMEJQpxWu2JXw0hzduLqxOC2LbuaptXKfx/Z+ewPxGjm
+ktiu51eVV/VC61uiqrgWHLJI8Cj/z4Ai/1tdSFg5mta3+bzDuFpvWkwXJue7neBN06LmlIt3UkrmXBZZ
+vYnGO2CttlE+n00hpZaxrOKbWx+YUWOhjzAJFkl4xpypCmzgKoTiPRdxuJWQRZtaIMTOsBLuiLEQbywz
+91FOU2PBJ0y5eM/n/evTCqVmXKjqixonkWmNQi11AMz+bWmSJPHwNukv12A60cnFBTnrN4kEVWH9lXj5
+sybad264R1NzlHsvuWEKZEhvwQDDLDJEjAQhMoU++fWlSX5o6/AFGa7d1WFZARjXMIg5oyyMl4VlFqUd
+94Pg2W5nkr9500T5eb+kKfM3/PbbWkLT3JEQUqXgoLCXommI6I6oM0xNBDzCkrOM3ES5o6lH5kQvA+Wj
+b5tLXrVQl4trsrhqGl9qYOu/ZC1lbEVVY9vZo1TeJ1HukFkETtowutgJVYKPv/iIH8SQuXvtMbWe65lK
+qR3wETeshjE60rnQfDsO5mFtvUVWzFZCxYVG1Ku7m7/Mx333SaynK1CRAlXMYcnWEsP6w0WTzrEShVYP
+uDWQsfLtouvkpI2P5ZvSGwm8gq6Rv50gSHEixZM//1NypfEU97PGnroXfEaRFXL4K7Wb8IxLrTgoB7xT
+Ev3Ih+WMom0X/jTvB5KmWE+OwwCSf2B7cN7a6vBwXbxCeUjhezwe0bRLS9OxFop+QoayplYXweqjXz5x
+u0ie1taC6vx+TRaLq2gogud77u5d2dpbjPYWg71pinxy3YeCFM9sBU3jt99Z5V7QClU1zQn0XjknEZ1a
+J2Nes2lgqlovFU1Di4/+nT8HAMphhy8pBwAA
+`,
+	},
+
 	"/partials/purge.html": {
 		local:   "web/static/partials/purge.html",
 		size:    72,
@@ -24938,48 +25094,49 @@ Pg0Exj9G3PR0Lhd5Fu79+Iz8EQAA//8VMhJI5ggAAA==
 
 	"/templates/index.html": {
 		local:   "web/static/templates/index.html",
-		size:    8593,
+		size:    8755,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/8xa/3LbuPH/W3mKDf2dyL5vKNpOcmkUSTM+271mJjdJz7mZdq6dGxBYkYhBgAFAyT6d
-XqMP0r/7NH2SDkBS/CE5sd3JuZmJTQC7n/2Bxe4S9OTx2bvTD399fw6pzcTs0cT9ApmEJM+nQaxMIU/y
-PJg9GkxSJGz2aDCYWG4FOpqYSzYNht85KniSMWLS1zCE/wdPEcwmkX/wTBlaAqm1eYifCr6YBn8JfzoJ
-T1WWE8tjgQFQJS1KOw3enE+RJRhA5DkFl5egUUwDkyptaWGBUyUDSDXOp0E0Jws3HnGqgkaUJBlOgwXH
-Za60baEvObPplOGCUwz94ClwyS0nIjSUCJwejQ6DRnIlxVhiOY2oMVGslDVWk3yUcTmixgSVevZaoEkR
-7WfZP34qUF+HBb8f+1xJG5IlGpXhFxD82D0NRowbEgtkT2HzOE7VAjWs3PqAFtooPQapbEiEUEtkr8sF
-Jdz83iv/Dx7zzPmTSOuX1x5ckkVMdFgqGVqVV6AZ0QmXYaysVdkYjl7lVw3XnlCJqghzZbjlSo6BxEaJ
-wmIp3Kp8DC9HL/Kr1xB9A/ulIEiRJ6mF/eeHwCUsiObOIDMSaMwBhGD4rwhqDl7C/vELR3UY+mAeWXNw
-ABEcwzdRV5XQ4pXtKi5wbsfw7A+V2gPvegc+huPNZO2go/mL41fflnMCrUUdmpxQLpMxhEdty0fWq6u0
-o3GnCvWJobBjOuRSoh6PY5wrjfVWlZE8huG///HP4Wdhz/CeuP+qcT1wTiQKz8pl0guYXHFpUb+GeiIl
-km0zlzlj1Xfj0YuNY9xPQWIUI4MC6c6tODquvV5N+wAJjzveXXKbhiUGsi6KdnEzhlroIFbaeaOaPsqv
-wCjBGexRSkuKnDBndJ+zVNcskpZJYzg6dAhEmtCg5vOWTuSKG8iJyzXls+ASn8JormhRDmogLoQ7hbI6
-AcZqdYlj2Ds8PKxmUpJjqFEy1D64qOYmP2cJmpbAL0KWqc8bfQMuKaxqIV77zenae7zL3jIWRdi2uR62
-1Oqp0Y5jEUqlMyI6Buw9oy+/fca6dFRzyykRpQjUWuku08mr58+fH3eZlkTLJpArwjnBuNr0DWEhL6Va
-yi4he4Vs/rJLKDBBydrhvcQyYGIl2OudyfCwF4RVHokFoZedhcpDx53Qc9VOhiYjQmxyeOtcHY5eYtYi
-/1kmfxtTocjl35/CzzIJN8+MWBK2J67ao1H9/BRGzQKsHgEwbnJBrsvI6tQF55ZJVBefSVQ1DpNYsWvX
-NrhEo5VTfBr43uHUahG4FVeHp4H7ub9ajS7QWi4Ts14flPWM8QVQQYyZBlUlqCoPwzkphIWtQhSAVgI9
-OU+IKzEeqQPl1CFcoi6Xdoipsma1PpjEhbVKgr3OcRqUg6DHYVWSuKbGu7ccOElCkNxspolO0E6DvYpn
-s1zJGUxMTmQNbHSopLgOZh88GjQmTSJHt5PJR0lMtOvFvgrRJCrtr4ek54dYE8k2rVpjmfMxZ9PAVV4H
-yfhi55qvysHMh0mbahKRareayR0bV7sUGtc75BscPilEi7+OJkkWjd6C+wguiQi1fIH7Q24xM8ODDZVz
-g4vm+TRQOUprWHwufc+18YRnCaA+UbM3brwxqgPxeAujEl83cgFYpYTledl3TwMPBmRBuHAEMFfa9b9z
-nhQaGbzLUX64OPvOR6FRhaZY6/WRLIihmud2vFCc7R8efEbHSSR4zzEmVcsdRm+7LNEkT53LJqR2iZ8K
-Zt+7X07KDvgeCF7luovhZoLZ+VWu0Rh/NG6DU/qmi1TOBbMfC4FwzrhV+nZghguUFLto1WQwuygfboAq
-3UekVJZY/Iz7KhKu5LC9Q43AhiCYXRRxxi2cbKZ60idRIb50AupH3wf1j4OL0k8FR7uVBV1LTQRqGzIi
-E9QtXf/sGOAHxRAqQ3f61pFuzkSVeCshsZUQW9nP/vW0ySppnF5W744o90t3pZxhNedes4LZhXuEt1xe
-9jLaYDDhMi9sle19OurnuI1Ad9Kyur6B5/NquJzTCGt2uqVA50xVg9UK/u8ng9q9y8J4CsFqRQqbjuq5
-/YP1OoD1ens3PFnl1v0D+O03sOoSpdlKIVrlTC3lrhja26LaXdUajFm7YgznBOYkLAzqYV0zYLXaGLRe
-w4TXEkpaSjTasMRyL3VumxjKaWB14a8T+KyTIptg3WiYoSyaPHyzS9qHU6iEy0gVNoCqJA9/MSjmw9lb
-lYAqbP+0dnA7joUnT8AL+hMx71Fn3Geh/eEPRJIE4YOn7aaGkj+YVSQnhU0rui2xrYM6uOmw1N5vncDh
-ppL0wuLJE3jcjYvb7GArJtfrvhr9uPovwmnWD49PBRqXvkLKNXVljYQiuTFSfqfo6pPUreIPhEs4U7TI
-UNZZtxdBjW9Sa3MzjqLyikLpJCoMSXATj8EvsSAuS/zkpmEDMujbiFfWbY0I/eVR+x3hszbfSTXcVFez
-rWBTeuEtkUnx8OoynPvrvZ3qnjWLsO9K/amS84MH1thcG4vZL3XHVlbxLdUvPBWctqkeWHGS8209T96/
-gXPJ/D2ReWAFNZbt7o5IeGdT1PCjI6Bo4C039uvremP2aGnj1L2FkQm3aRGPqMpKe8NMSde0lqNte282
-rkS60ZCGsbz3f6/VR6QWlITvPefDbbERhF56F9zFXs91C3MvHB2cpsTCj0plD5wjnDJv5IJb/Hq2lvj6
-ISw1ltBLtUA9F2rpt7Su/iayJEmQ3T2wPWZYg97GC44B3lUMUMb7B5L8bziEtZuL0huRVTmn5vd3ypmi
-BohkcH5Fslyg+bouuqERbqabW6HNU/Nw891fe0mr5Y4LQapEKJLw6Ni/MS04Lm8hsLxRAaNp8ynvY/0h
-0H/D+2j8vZqn+zJLWPBbcxSSoTZUaQzvIsj1boLo0T14Qq0Ki/fiNMQ1Y7/ek9l/kr0fl83F3WRSdP/v
-LO0uPN3PzLflypTLCfdgCVnVRIZzpTNyNwj2bLR4do9Ibn+bvBP7H7nAC7K4IxcVPI8V0exOXDK5H19Z
-rfvUq9XojaSiYGjW60eDSRQrdj17NInKP8D4TwAAAP//GXbiO5EhAAA=
+H4sIAAAAAAAC/8xa/24bufH/W3mKyfqLyL5vVrKd5NIokgCf7V4DJEgaJ0CLaxFwydGKMZfckFzJPp1f
+ow/Sv/s0fZKC5K72h+TEdpFzDziLP2Y+84NDziyZ8cOTt8cf/vruFOY2E9MHY/cDMo1Jnk+iRJlCHuV5
+NH3QG8+RsOmDXm9suRXoaBIu2STq/+So4FHGiJm/hD78P3iKaDoe+oZnytASmFubx/il4ItJ9Jf441F8
+rLKcWJ4IjIAqaVHaSfTqdIIsxQiGnlNweQ4axSQyc6UtLSxwqmQEc42zSTSckYXrDzhVUS1Kkgwn0YLj
+MlfaNtCXnNn5hOGCU4x95zFwyS0nIjaUCJwcDPajWnIpxVhiOR1SY4aJUtZYTfJBxuWAGhOV6tlLgWaO
+aL/K/vlLgfoyLvjd2GdK2pgs0agMv4Hg+67VGzBuSCKQPYZ1czRXC9SwcvM9Wmij9AiksjERQi2RvQwT
+SrjxnRf+P3jIM+dPIq2fvvLgkiwSouOgZGxVXoJmRKdcxomyVmUjOHiRX9RcO0KlqiTMleGWKzkCkhgl
+CotBuFX5CJ4PnuUXL2H4A+wGQTBHns4t7D7dBy5hQTR3BpmBQGP2IAbDf0VQM/ASdg+fOar92AfzwJq9
+PRjCIfwwbKsSW7ywbcUFzuwInvyhVLvnXe/AR3C4HqwcdDB7dvjixzAm0FrUsckJ5TIdQXzQtHxgvbpK
+Oxq3q1AfGQpbhmMuJerRKMGZ0lgtVYjkEfT//Y9/9r8Ke4J3xP1XheuBcyJReFYu007A5IpLi/olVANz
+ItkmczgzVl03HjxbO8b9FSRBMTAokG5dioPDkrwa9gESH7a8u+R2HgcMZG0U7eJmBJXQXqK080Y5fJBf
+gFGCM9ihlAaKnDBndJczqGsWacOkERzsOwQiTWxQ81lDJ3LBDeTEnTWhLbjExzCYKVqETgXEhXC7UJY7
+wFitznEEO/v7++XInOQYa5QMtQ8uqrnJT1mKpiHwm5Dh6PNGX4NLCqsaiJd+cdr2Hm6z11NbETdtrroN
+tTpqNONYxFLpjIiWATtP6PMfn7A2HdXcckpEEIFaK91mOnrx9OnTwzbTkmhZB3JJOCOYUNomLOS5VEvZ
+JmQvkM2etwkFpihZM7yXGAImUYK93HoY7neCsDxHEkHoeWui9NBhK/RctpOxyYgQqJuCw77aHzzHrEH+
+i0z/NqJCkfO/P4ZfZBqv24xYEjcHLpq9QdV+DIN6AlYPABg3uSCXIbJaecG5ZTysks94WBYO40SxS1c2
+uINGK6f4JPK1w7HVInIzLg9PIvd3d7UanKG1XKbm6mov5DPGF0AFMWYSlZkg/MQMZ6QQFjYSUQRaCfTk
+PCUuxXikFpRTh3CJOkxtEVOemuV8b5wU1ioJ9jLHSRQ6UYfDqjR1RY13b+g4SUKQ3KyHiU7RTqKdkmc9
+XcrpjU1OZAVsdKykuIymHzwa1CaNh45uK5OPkoRoV4t9F6LxMNhfdUnHD4kmkq1Ltdoy52POJpHLvA6S
+8cXWOZ+Vo6kPkybVeEhCqzG4ZeEql0Ltes42ZtcLW4gGfxVNkixqvQX3ERyICLV8gbt9bjEz/b01lXOD
+i+bZJFI5SmtYcip9zbX2hGeJoNpR01euvzaqBfFwA6MUXxVyEVilhOV5qLsnkQcDsiBcOAKYKe3q3xlP
+C40M3uYoP5yd/OSj0KhCU6z0+kwWxFDNcztaKM529/e+ouN4KHjHMWaulluM3nRZqkk+dy4bk8olfiia
+/ux+nJQt8B0QvMh1G8ONRNPTi1yjMX5r3ATHfbgkimhm2mj1eDQ9Wbdvhhn83cYLY9H0fSEQThm3St8M
+zHCBkmIbrRyMpmehcTOoXKtElOFaY1Wj0fRd2boGLSwwkVJZYvErC1yScCX7zRiqRdYE0fSsSDJu4Wg9
+1JE+HhbiW3u0avpKrbth3T76UnC0G+e0K/qJQG1jRmSKuqHrnx0DvFEMoTR0q3sd6XrXlqmhFJJYCYmV
+3fxUDZuslMbpefl1i3I3uGvOGZZj7kMwmp65Jrzm8rxz5vZ6Yy7zwpb5yB+Y3VN4LdCdBVmVgcHzeTU4
+awqrV7qhQGvXl53VCv7vo0HtvrZhNIFotSKFnQ+qsd29q6sIrq42V8OTlW7d3YPffgOrzlGajUNOq5yp
+pdwWQzsbVNvzbo0xbea0/ozAjMSFQd2vshqsVmuDrq5gzCsJgZYSjTYOWERz4paJoZxEVhf+woNPW4d4
+HaxrDTOURZ0prndJc3sKlXI5VIWNoCwa+p8Mill/+lqloArb3a0t3JZj4dEj8IL+RMw71Bn35+Ru/w2R
+JEX44Gnbh0Pgj6YlyVFh5yXdhtjGRu1dt1kq7zd2YH+d6zph8egRPGzHxU1WsBGTV1ddNbpx9V+E07Qb
+Hl8KNO74iinX1CVeEov02kj5naKrS1IVs28Il3CiaJGhrE7dTgTVvnE3d2Y0HIZLFKXTYWFIiut4jD4l
+grhT4qMbhjVIr2sjXli3NCL211vNr5iv2nwr1XCd/82mgnVxAK+JTIv7V5fhzF9AblX3pJ6EXVc4HCs5
+27tnjc2lsZh9qmrKkMU3VD/zVHDcpLpnxUnON/U8evcKTiXzN1nmnhXUGAryLZHw1s5Rw3tHQNHAa27s
+99f12tOjoY1T9wZGptzOi2RAVRbsjTMlXQkcepv2Xm9cQLrWkJoxvEy80+qzu1BUEn72nPe3xMbd7ngX
+3MZez3UDc88cHRzPiYX3SmX3fEY4ZV7JBbf4/WwN+Po+LDWW0HP3gjITaumXtMr+ZmhJmiK7fWB7zLgC
+vYkXHAO8LRkgxPsHkv5vOIQ1i4vgjaFVOafm93fKiaIGiGRwekGyXKD5vi66phCuh+t7q3Wrblx/O9mc
+0mq55cqSKhGLND449F9M7gH0BgLDnQ8YTevHxs/VU6V/ZfxsnKWB7tss7nXzphyFe3EwVGmMbyPI1W6C
+6MEdeGKtCot34jREcst/vSOzfzS+G5fNxe1kUnT/31rabXjaD+E35cqUOxPuwBKzsoiM3SUGuR0EezJY
+PLlDJDdfT2/F/kcu8IwsbslFBc/9NeOtuGR6N76QrbvUq9XglaSiYGjczc146B5upg/Gw/BPRP4zAEmy
+qyEzIgAA
 `,
 	},
 
@@ -52,6 +52,36 @@ bosunApp.config(['$routeProvider', '$locationProvider', '$httpProvider', functio
         templateUrl: 'partials/errors.html',
         controller: 'ErrorCtrl',
     })
+    when('/alert', {
+        title: 'Alert',
+        templateUrl: 'partials/alert.html',
+        controller: 'AlertCtrl',
+    })
+    when('/dashboards', {
+        title: 'Dashboards',
+        templateUrl: 'partials/dashboards.html',
+        controller: 'DashboardsCtrl',
+    })
+    when('/alertcost', {
+        title: 'Alert Cost',
+        templateUrl: 'partials/alertcost.html',
+        controller: 'AlertCostCtrl',
+    })
+    when('/problems', {
+        title: 'Problems',
+        templateUrl: 'partials/problems.html',
+        controller: 'ProblemsCtrl',
+    })
+    when('/problem', {
+        title: 'Problem',
+        templateUrl: 'partials/problems.html',
+        controller: 'ProblemsCtrl',
+    })
+    when('/cardinality', {
+        title: 'Cardinality',
+        templateUrl: 'partials/cardinality.html',
+        controller: 'CardinalityCtrl',
+    })
     when('/graph', {
         title: 'Graph',
         templateUrl: 'partials/graph.html',
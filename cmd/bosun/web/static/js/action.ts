@@ -7,6 +7,7 @@ interface IActionScope extends IBosunScope {
 	message: string;
 	notify: boolean;
 	keys: string[];
+	problem: number;
 	submit: () => void;
 	validateMsg: () => void;
 	msgValid: boolean;
@@ -31,7 +32,10 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
 		$scope.durationValid = $scope.duration == "" || parseDuration($scope.duration).asMilliseconds() != 0;
 	}
 
-	if (search.key) {
+	if (search.problem) {
+		$scope.problem = +search.problem;
+		$scope.keys = [];
+	} else if (search.key) {
 		var keys = search.key;
 		if (!angular.isArray(search.key)) {
 			keys = [search.key];
@@ -53,6 +57,9 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
 			Keys: $scope.keys,
 			Notify: $scope.notify,
 		};
+		if ($scope.problem) {
+			data['Problems'] = [$scope.problem];
+		}
 		if ($scope.duration != "") {
 			data['Time'] = moment.utc().add(parseDuration($scope.duration));
 		}
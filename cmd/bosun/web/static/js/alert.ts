@@ -0,0 +1,54 @@
+/// <reference path="0-bosun.ts" />
+
+interface IAlertScope extends ng.IScope {
+	name: string;
+	limit: number;
+	runs: any[];
+	counts: any[];
+	durations: any[];
+	error: string;
+	loading: boolean;
+	load: () => void;
+}
+
+bosunControllers.controller('AlertCtrl', ['$scope', '$http', '$location', function($scope: IAlertScope, $http: ng.IHttpService, $location: ng.ILocationService) {
+	var search = $location.search();
+	$scope.name = search.name;
+	$scope.limit = +search.limit || 288;
+	$scope.load = () => {
+		$scope.loading = true;
+		$scope.error = '';
+		$location.search('limit', $scope.limit);
+		$http.get('/api/alerts/' + encodeURIComponent($scope.name) + '/runs?limit=' + $scope.limit)
+			.success((data: any[]) => {
+				$scope.runs = data;
+				// The charts need the runs oldest first.
+				var runs = data.slice().reverse();
+				var series = (name: string, value: (r: any) => number) => {
+					return {
+						Name: name,
+						Data: _.map(runs, (r: any) => {
+							return [moment.utc(r.Time).unix(), value(r)];
+						}),
+					};
+				};
+				$scope.counts = [
+					series('Critical', (r: any) => { return r.Critical; }),
+					series('Warning', (r: any) => { return r.Warning; }),
+					series('Unknown', (r: any) => { return r.Unknown; }),
+					series('Unevaluated', (r: any) => { return r.Unevaluated; }),
+					series('Normal', (r: any) => { return r.Normal; }),
+				];
+				$scope.durations = [
+					series('Duration', (r: any) => { return r.Duration; }),
+				];
+			})
+			.error((error) => {
+				$scope.error = 'Unable to fetch alert runs: ' + error;
+			})
+			.finally(() => { $scope.loading = false; });
+	};
+	if ($scope.name) {
+		$scope.load();
+	}
+}]);
@@ -0,0 +1,50 @@
+/// <reference path="0-bosun.ts" />
+
+interface IAlertCostScope extends ng.IScope {
+	alerts: any[];
+	worker: string;
+	since: string;
+	total: number;
+	limit: number;
+	sort: string;
+	filter: string;
+	error: string;
+	loading: boolean;
+	load: () => void;
+	percent: (n: number) => string;
+}
+
+bosunControllers.controller('AlertCostCtrl', ['$scope', '$http', '$location', function($scope: IAlertCostScope, $http: ng.IHttpService, $location: ng.ILocationService) {
+	var search = $location.search();
+	$scope.limit = +search.limit || 100;
+	$scope.sort = search.sort || 'mean';
+	$scope.percent = (n: number) => {
+		return (n * 100).toFixed(0) + '%';
+	};
+	$scope.load = () => {
+		$scope.loading = true;
+		$location.search('sort', $scope.sort);
+		$http.get('/api/alerts/cost?sort=' + encodeURIComponent($scope.sort) + '&limit=' + $scope.limit)
+			.success((data: any) => {
+				_(data.Alerts).forEach((a) => {
+					a.Queries = 0;
+					a.QueryList = _.sortBy(_.map(a.Backends, (q: any, t: string) => {
+						a.Queries += q.Queries;
+						q.Type = t;
+						q.HitRatio = q.Queries ? q.CacheHits / q.Queries : 0;
+						q.MeanTime = q.Queries ? q.Time / q.Queries : 0;
+						return q;
+					}), (q: any) => -q.Time);
+				});
+				$scope.alerts = data.Alerts;
+				$scope.worker = data.Worker;
+				$scope.since = data.Since;
+				$scope.total = data.Total;
+			})
+			.error((error) => {
+				$scope.error = 'Unable to fetch alert costs: ' + error;
+			})
+			.finally(() => { $scope.loading = false; });
+	};
+	$scope.load();
+}]);
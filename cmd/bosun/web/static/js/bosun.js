@@ -173,9 +173,27 @@ var Groups = (function () {
     }
     return Groups;
 }());
+var PendingAlert = (function () {
+    function PendingAlert(p) {
+        this.AlertKey = p.AlertKey;
+        this.Alert = p.Alert;
+        this.Status = p.Status;
+        this.WorstStatus = p.WorstStatus;
+        this.Since = p.Since;
+        this.LastCheck = p.LastCheck;
+    }
+    return PendingAlert;
+}());
 var StateGroups = (function () {
     function StateGroups(sgs) {
         this.Groups = new Groups(sgs.Groups);
+        this.Pending = new Array();
+        if (sgs.Pending) {
+            for (var _i = 0, _a = sgs.Pending; _i < _a.length; _i++) {
+                var p = _a[_i];
+                this.Pending.push(new PendingAlert(p));
+            }
+        }
         this.TimeAndDate = sgs.TimeAndDate;
         this.FailingAlerts = sgs.FailingAlerts;
         this.UnclosedErrors = sgs.UnclosedErrors;
@@ -226,6 +244,36 @@ bosunApp.config(['$routeProvider', '$locationProvider', '$httpProvider', functio
             templateUrl: 'partials/errors.html',
             controller: 'ErrorCtrl'
         });
+        when('/alert', {
+            title: 'Alert',
+            templateUrl: 'partials/alert.html',
+            controller: 'AlertCtrl'
+        });
+        when('/dashboards', {
+            title: 'Dashboards',
+            templateUrl: 'partials/dashboards.html',
+            controller: 'DashboardsCtrl'
+        });
+        when('/alertcost', {
+            title: 'Alert Cost',
+            templateUrl: 'partials/alertcost.html',
+            controller: 'AlertCostCtrl'
+        });
+        when('/problems', {
+            title: 'Problems',
+            templateUrl: 'partials/problems.html',
+            controller: 'ProblemsCtrl'
+        });
+        when('/problem', {
+            title: 'Problem',
+            templateUrl: 'partials/problems.html',
+            controller: 'ProblemsCtrl'
+        });
+        when('/cardinality', {
+            title: 'Cardinality',
+            templateUrl: 'partials/cardinality.html',
+            controller: 'CardinalityCtrl'
+        });
         when('/graph', {
             title: 'Graph',
             templateUrl: 'partials/graph.html',
@@ -716,7 +764,11 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
         $scope.validateDuration = function () {
             $scope.durationValid = $scope.duration == "" || parseDuration($scope.duration).asMilliseconds() != 0;
         };
-        if (search.key) {
+        if (search.problem) {
+            $scope.problem = +search.problem;
+            $scope.keys = [];
+        }
+        else if (search.key) {
             var keys = search.key;
             if (!angular.isArray(search.key)) {
                 keys = [search.key];
@@ -739,6 +791,9 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
                 Keys: $scope.keys,
                 Notify: $scope.notify
             };
+            if ($scope.problem) {
+                data['Problems'] = [$scope.problem];
+            }
             if ($scope.duration != "") {
                 data['Time'] = moment.utc().add(parseDuration($scope.duration));
             }
@@ -752,6 +807,81 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
         };
     }]);
 /// <reference path="0-bosun.ts" />
+bosunControllers.controller('AlertCtrl', ['$scope', '$http', '$location', function ($scope, $http, $location) {
+        var search = $location.search();
+        $scope.name = search.name;
+        $scope.limit = +search.limit || 288;
+        $scope.load = function () {
+            $scope.loading = true;
+            $scope.error = '';
+            $location.search('limit', $scope.limit);
+            $http.get('/api/alerts/' + encodeURIComponent($scope.name) + '/runs?limit=' + $scope.limit)
+                .success(function (data) {
+                $scope.runs = data;
+                // The charts need the runs oldest first.
+                var runs = data.slice().reverse();
+                var series = function (name, value) {
+                    return {
+                        Name: name,
+                        Data: _.map(runs, function (r) {
+                            return [moment.utc(r.Time).unix(), value(r)];
+                        })
+                    };
+                };
+                $scope.counts = [
+                    series('Critical', function (r) { return r.Critical; }),
+                    series('Warning', function (r) { return r.Warning; }),
+                    series('Unknown', function (r) { return r.Unknown; }),
+                    series('Unevaluated', function (r) { return r.Unevaluated; }),
+                    series('Normal', function (r) { return r.Normal; })
+                ];
+                $scope.durations = [
+                    series('Duration', function (r) { return r.Duration; })
+                ];
+            })
+                .error(function (error) {
+                $scope.error = 'Unable to fetch alert runs: ' + error;
+            })["finally"](function () { $scope.loading = false; });
+        };
+        if ($scope.name) {
+            $scope.load();
+        }
+    }]);
+/// <reference path="0-bosun.ts" />
+bosunControllers.controller('AlertCostCtrl', ['$scope', '$http', '$location', function ($scope, $http, $location) {
+        var search = $location.search();
+        $scope.limit = +search.limit || 100;
+        $scope.sort = search.sort || 'mean';
+        $scope.percent = function (n) {
+            return (n * 100).toFixed(0) + '%';
+        };
+        $scope.load = function () {
+            $scope.loading = true;
+            $location.search('sort', $scope.sort);
+            $http.get('/api/alerts/cost?sort=' + encodeURIComponent($scope.sort) + '&limit=' + $scope.limit)
+                .success(function (data) {
+                _(data.Alerts).forEach(function (a) {
+                    a.Queries = 0;
+                    a.QueryList = _.sortBy(_.map(a.Backends, function (q, t) {
+                        a.Queries += q.Queries;
+                        q.Type = t;
+                        q.HitRatio = q.Queries ? q.CacheHits / q.Queries : 0;
+                        q.MeanTime = q.Queries ? q.Time / q.Queries : 0;
+                        return q;
+                    }), function (q) { return -q.Time; });
+                });
+                $scope.alerts = data.Alerts;
+                $scope.worker = data.Worker;
+                $scope.since = data.Since;
+                $scope.total = data.Total;
+            })
+                .error(function (error) {
+                $scope.error = 'Unable to fetch alert costs: ' + error;
+            })["finally"](function () { $scope.loading = false; });
+        };
+        $scope.load();
+    }]);
+/// <reference path="0-bosun.ts" />
 bosunControllers.controller('AnnotationCtrl', ['$scope', '$http', '$location', '$route', function ($scope, $http, $location, $route) {
         var search = $location.search();
         $scope.id = search.id;
@@ -916,6 +1046,29 @@ bosunApp.component("usernameInput", {
     template: '<input type="text"class="form-control"  ng-disabled="ct.auth.Enabled()" ng-model="ct.auth.Username" ng-model-options="{ getterSetter: true }">'
 });
 /// <reference path="0-bosun.ts" />
+bosunControllers.controller('CardinalityCtrl', ['$scope', '$http', '$location', function ($scope, $http, $location) {
+        var search = $location.search();
+        $scope.limit = +search.limit || 100;
+        $scope.load = function () {
+            $scope.loading = true;
+            $http.get('/api/cardinality?limit=' + $scope.limit)
+                .success(function (data) {
+                _(data.Metrics).forEach(function (m) {
+                    m.Keys = _.sortBy(_.map(m.TagKeys, function (n, k) {
+                        return { Key: k, Values: n };
+                    }), function (k) { return -k.Values; });
+                });
+                $scope.metrics = data.Metrics;
+                $scope.limits = data.Limits;
+                $scope.total = data.Total;
+            })
+                .error(function (error) {
+                $scope.error = 'Unable to fetch cardinality: ' + error;
+            })["finally"](function () { $scope.loading = false; });
+        };
+        $scope.load();
+    }]);
+/// <reference path="0-bosun.ts" />
 bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$route', '$timeout', '$sce', function ($scope, $http, $location, $route, $timeout, $sce) {
         var search = $location.search();
         $scope.fromDate = search.fromDate || '';
@@ -944,7 +1097,10 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
             "template": "https://bosun.org/definitions#templates",
             "lookup": "https://bosun.org/definitions#lookup-tables",
             "notification": "https://bosun.org/definitions#notifications",
-            "macro": "https://bosun.org/definitions#macros"
+            "macro": "https://bosun.org/definitions#macros",
+            "func": "https://bosun.org/definitions#funcs",
+            "report": "https://bosun.org/definitions#reports",
+            "problem": "https://bosun.org/definitions#problems"
         };
         var expr = search.expr;
         function buildAlertFromExpr() {
@@ -984,7 +1140,7 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
         }
         function parseItems() {
             var configText = $scope.config_text;
-            var re = /^\s*(alert|template|notification|lookup|macro)\s+([\w\-\.\$]+)\s*\{/gm;
+            var re = /^\s*(alert|template|notification|lookup|macro|func|report|problem)\s+([\w\-\.\$]+)\s*\{/gm;
             var match;
             var items = {};
             items["alert"] = [];
@@ -992,6 +1148,9 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
             items["lookup"] = [];
             items["notification"] = [];
             items["macro"] = [];
+            items["func"] = [];
+            items["report"] = [];
+            items["problem"] = [];
             while (match = re.exec(configText)) {
                 var type = match[1];
                 var name = match[2];
@@ -1447,6 +1606,219 @@ bosunControllers.controller('DashboardCtrl', ['$scope', '$http', '$location', fu
         };
     }]);
 /// <reference path="0-bosun.ts" />
+bosunControllers.controller('DashboardsCtrl', ['$scope', '$http', '$location', function ($scope, $http, $location) {
+        var search = $location.search();
+        $scope.name = search.name;
+        $scope.vars = {};
+        // subst replaces the $variables of s with their selected values.
+        function subst(s) {
+            return (s || '').replace(/\$(\w+)/g, function (m, name) {
+                return name in $scope.vars ? $scope.vars[name] : m;
+            });
+        }
+        // varParams returns the selected values of the variables as the
+        // parameters of the expressions of panels.
+        function varParams() {
+            return _.map($scope.vars, function (v, name) {
+                return 'var-' + encodeURIComponent(name) + '=' + encodeURIComponent(v);
+            }).join('&');
+        }
+        function groupName(group) {
+            var name = '{';
+            angular.forEach(group, function (tagv, tagk) {
+                if (name.length > 1) {
+                    name += ',';
+                }
+                name += tagk + '=' + tagv;
+            });
+            return name + '}';
+        }
+        function toChart(res) {
+            var graph = [];
+            angular.forEach(res, function (d) {
+                var data = [];
+                angular.forEach(d.Value, function (val, ts) {
+                    data.push([+ts, val]);
+                });
+                if (data.length == 0) {
+                    return;
+                }
+                graph.push({
+                    Data: data,
+                    Name: groupName(d.Group)
+                });
+            });
+            return graph;
+        }
+        // toRows returns a row of the group and the value of each result, which
+        // is the last value of series.
+        function toRows(res) {
+            return _.map(res, function (d) {
+                var value = d.Value;
+                if (angular.isObject(value)) {
+                    var last = _.max(_.keys(value), function (ts) { return +ts; });
+                    value = value[last];
+                }
+                return { Name: groupName(d.Group), Value: value };
+            });
+        }
+        function renderPanel(p, i) {
+            var v = {
+                Panel: p,
+                Title: subst(p.Title),
+                Width: p.Width || 12
+            };
+            var fail = function (error) {
+                v.error = error;
+            };
+            switch (p.Type) {
+                case 'expr':
+                case 'table':
+                    $http.get('/api/dashboards/' + encodeURIComponent($scope.name) + '/panels/' + i + '/expr?' + varParams())
+                        .success(function (data) {
+                        if (p.Type == 'expr' && data.Type == 'series') {
+                            v.graph = toChart(data.Results);
+                        }
+                        else {
+                            v.rows = toRows(data.Results);
+                        }
+                    })
+                        .error(fail);
+                    break;
+                case 'query':
+                    $http.get('/api/graph?json=' + encodeURIComponent(subst(p.Query)) + '&autods=300')
+                        .success(function (data) {
+                        v.graph = data.Series || [];
+                    })
+                        .error(fail);
+                    break;
+                case 'incidents':
+                    $http.get('/api/incidents/open?filter=' + encodeURIComponent(subst(p.Filter)))
+                        .success(function (data) {
+                        v.incidents = data || [];
+                    })
+                        .error(fail);
+                    break;
+                case 'host':
+                    v.host = subst(p.Host);
+                    var r = new GraphRequest();
+                    r.start = '1d-ago';
+                    r.queries = [
+                        new Query(false, {
+                            metric: 'os.cpu',
+                            derivative: 'counter',
+                            tags: { host: v.host }
+                        })
+                    ];
+                    $http.get('/api/graph?json=' + encodeURIComponent(JSON.stringify(r)) + '&autods=100')
+                        .success(function (data) {
+                        if (data.Series) {
+                            data.Series[0].Name = 'CPU Percent Used';
+                        }
+                        v.graph = data.Series || [];
+                    })
+                        .error(fail);
+                    break;
+            }
+            return v;
+        }
+        function render() {
+            $scope.panels = _.map($scope.dashboard.Panels || [], renderPanel);
+        }
+        // Changes of the search reload the page.
+        $scope.setVar = function (name) {
+            $location.search('var-' + name, $scope.vars[name]);
+        };
+        $scope.create = function () {
+            $location.search('name', $scope.newName);
+            $location.search('edit', true);
+        };
+        $scope.edit = function () {
+            var d = $scope.dashboard || {};
+            $scope.source = JSON.stringify({
+                Title: d.Title,
+                Variables: d.Variables || [],
+                Panels: d.Panels || []
+            }, null, '\t');
+            $scope.editing = true;
+        };
+        $scope.save = function () {
+            var d;
+            try {
+                d = JSON.parse($scope.source);
+            }
+            catch (e) {
+                $scope.error = 'Invalid JSON: ' + e;
+                return;
+            }
+            $scope.error = '';
+            $http.put('/api/dashboards/' + encodeURIComponent($scope.name), d)
+                .success(function () {
+                if (search.edit) {
+                    $location.search('edit', null);
+                }
+                else {
+                    load();
+                }
+            })
+                .error(function (error) {
+                $scope.error = error;
+            });
+        };
+        $scope.remove = function () {
+            if (!window.confirm('Delete dashboard ' + $scope.name + '?')) {
+                return;
+            }
+            $http["delete"]('/api/dashboards/' + encodeURIComponent($scope.name))
+                .success(function () {
+                $location.search({});
+            })
+                .error(function (error) {
+                $scope.error = error;
+            });
+        };
+        function load() {
+            $scope.loading = true;
+            $scope.error = '';
+            $scope.editing = false;
+            $http.get('/api/dashboards/' + encodeURIComponent($scope.name))
+                .success(function (data) {
+                $scope.dashboard = data;
+                $scope.values = data.Values || {};
+                $scope.vars = {};
+                angular.forEach(data.Variables, function (v) {
+                    var values = $scope.values[v.Name] || [];
+                    $scope.vars[v.Name] = search['var-' + v.Name] || v.Default || values[0] || '';
+                });
+                render();
+                if (search.edit) {
+                    $scope.edit();
+                }
+            })
+                .error(function (error) {
+                $scope.dashboard = null;
+                if (search.edit) {
+                    // A new dashboard.
+                    $scope.edit();
+                    return;
+                }
+                $scope.error = error;
+            })["finally"](function () { $scope.loading = false; });
+        }
+        if ($scope.name) {
+            load();
+        }
+        else {
+            $http.get('/api/dashboards')
+                .success(function (data) {
+                $scope.dashboards = data || [];
+            })
+                .error(function (error) {
+                $scope.error = 'Unable to fetch dashboards: ' + error;
+            });
+        }
+    }]);
+/// <reference path="0-bosun.ts" />
 bosunApp.directive('tsResults', function () {
     return {
         templateUrl: '/partials/results.html',
@@ -3420,6 +3792,23 @@ var LinkService = (function () {
     return LinkService;
 }());
 bosunApp.service("linkService", LinkService);
+/// <reference path="0-bosun.ts" />
+bosunControllers.controller('ProblemsCtrl', ['$scope', '$http', '$location', function ($scope, $http, $location) {
+        var search = $location.search();
+        $scope.id = +search.id || 0;
+        $scope.load = function () {
+            $scope.loading = true;
+            var url = $scope.id ? '/api/problem?id=' + $scope.id : '/api/problems/open';
+            $http.get(url)
+                .success(function (data) {
+                $scope.problems = $scope.id ? [data] : data;
+            })
+                .error(function (error) {
+                $scope.error = 'Unable to fetch problems: ' + error;
+            })["finally"](function () { $scope.loading = false; });
+        };
+        $scope.load();
+    }]);
 var Tag = (function () {
     function Tag() {
     }
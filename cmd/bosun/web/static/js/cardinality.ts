@@ -0,0 +1,36 @@
+/// <reference path="0-bosun.ts" />
+
+interface ICardinalityScope extends ng.IScope {
+	metrics: any[];
+	limits: any;
+	total: number;
+	limit: number;
+	filter: string;
+	error: string;
+	loading: boolean;
+	load: () => void;
+}
+
+bosunControllers.controller('CardinalityCtrl', ['$scope', '$http', '$location', function($scope: ICardinalityScope, $http: ng.IHttpService, $location: ng.ILocationService) {
+	var search = $location.search();
+	$scope.limit = +search.limit || 100;
+	$scope.load = () => {
+		$scope.loading = true;
+		$http.get('/api/cardinality?limit=' + $scope.limit)
+			.success((data: any) => {
+				_(data.Metrics).forEach((m) => {
+					m.Keys = _.sortBy(_.map(m.TagKeys, (n: number, k: string) => {
+						return { Key: k, Values: n };
+					}), (k: any) => -k.Values);
+				});
+				$scope.metrics = data.Metrics;
+				$scope.limits = data.Limits;
+				$scope.total = data.Total;
+			})
+			.error((error) => {
+				$scope.error = 'Unable to fetch cardinality: ' + error;
+			})
+			.finally(() => { $scope.loading = false; });
+	};
+	$scope.load();
+}]);
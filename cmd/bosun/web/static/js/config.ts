@@ -100,7 +100,10 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 		"template": "https://bosun.org/definitions#templates",
 		"lookup": "https://bosun.org/definitions#lookup-tables",
 		"notification": "https://bosun.org/definitions#notifications",
-		"macro": "https://bosun.org/definitions#macros"
+		"macro": "https://bosun.org/definitions#macros",
+		"func": "https://bosun.org/definitions#funcs",
+		"report": "https://bosun.org/definitions#reports",
+		"problem": "https://bosun.org/definitions#problems"
 	}
 
 	var expr = search.expr;
@@ -141,7 +144,7 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 
 	function parseItems(): { [type: string]: string[]; } {
 		var configText = $scope.config_text;
-		var re = /^\s*(alert|template|notification|lookup|macro)\s+([\w\-\.\$]+)\s*\{/gm;
+		var re = /^\s*(alert|template|notification|lookup|macro|func|report|problem)\s+([\w\-\.\$]+)\s*\{/gm;
 		var match;
 		var items: { [type: string]: string[]; } = {};
 		items["alert"] = [];
@@ -149,6 +152,9 @@ bosunControllers.controller('ConfigCtrl', ['$scope', '$http', '$location', '$rou
 		items["lookup"] = [];
 		items["notification"] = [];
 		items["macro"] = [];
+		items["func"] = [];
+		items["report"] = [];
+		items["problem"] = [];
 		while (match = re.exec(configText)) {
 			var type = match[1];
 			var name = match[2];
@@ -0,0 +1,230 @@
+/// <reference path="0-bosun.ts" />
+
+interface IDashboardsScope extends ng.IScope {
+	name: string;
+	newName: string;
+	dashboards: any[];
+	dashboard: any;
+	values: any;
+	vars: any;
+	panels: any[];
+	editing: boolean;
+	source: string;
+	error: string;
+	loading: boolean;
+	create: () => void;
+	setVar: (name: string) => void;
+	edit: () => void;
+	save: () => void;
+	remove: () => void;
+}
+
+bosunControllers.controller('DashboardsCtrl', ['$scope', '$http', '$location', function($scope: IDashboardsScope, $http: ng.IHttpService, $location: ng.ILocationService) {
+	var search = $location.search();
+	$scope.name = search.name;
+	$scope.vars = {};
+	// subst replaces the $variables of s with their selected values.
+	function subst(s: string) {
+		return (s || '').replace(/\$(\w+)/g, (m: string, name: string) => {
+			return name in $scope.vars ? $scope.vars[name] : m;
+		});
+	}
+	// varParams returns the selected values of the variables as the
+	// parameters of the expressions of panels.
+	function varParams() {
+		return _.map($scope.vars, (v: string, name: string) => {
+			return 'var-' + encodeURIComponent(name) + '=' + encodeURIComponent(v);
+		}).join('&');
+	}
+	function groupName(group: any) {
+		var name = '{';
+		angular.forEach(group, (tagv, tagk) => {
+			if (name.length > 1) {
+				name += ',';
+			}
+			name += tagk + '=' + tagv;
+		});
+		return name + '}';
+	}
+	function toChart(res: any[]) {
+		var graph: any[] = [];
+		angular.forEach(res, (d) => {
+			var data: any[] = [];
+			angular.forEach(d.Value, (val, ts) => {
+				data.push([+ts, val]);
+			});
+			if (data.length == 0) {
+				return;
+			}
+			graph.push({
+				Data: data,
+				Name: groupName(d.Group),
+			});
+		});
+		return graph;
+	}
+	// toRows returns a row of the group and the value of each result, which
+	// is the last value of series.
+	function toRows(res: any[]) {
+		return _.map(res, (d: any) => {
+			var value = d.Value;
+			if (angular.isObject(value)) {
+				var last = _.max(_.keys(value), (ts: string) => { return +ts; });
+				value = value[last];
+			}
+			return { Name: groupName(d.Group), Value: value };
+		});
+	}
+	function renderPanel(p: any, i: number) {
+		var v: any = {
+			Panel: p,
+			Title: subst(p.Title),
+			Width: p.Width || 12,
+		};
+		var fail = (error: any) => {
+			v.error = error;
+		};
+		switch (p.Type) {
+			case 'expr':
+			case 'table':
+				$http.get('/api/dashboards/' + encodeURIComponent($scope.name) + '/panels/' + i + '/expr?' + varParams())
+					.success((data: any) => {
+						if (p.Type == 'expr' && data.Type == 'series') {
+							v.graph = toChart(data.Results);
+						} else {
+							v.rows = toRows(data.Results);
+						}
+					})
+					.error(fail);
+				break;
+			case 'query':
+				$http.get('/api/graph?json=' + encodeURIComponent(subst(p.Query)) + '&autods=300')
+					.success((data: any) => {
+						v.graph = data.Series || [];
+					})
+					.error(fail);
+				break;
+			case 'incidents':
+				$http.get('/api/incidents/open?filter=' + encodeURIComponent(subst(p.Filter)))
+					.success((data: any) => {
+						v.incidents = data || [];
+					})
+					.error(fail);
+				break;
+			case 'host':
+				v.host = subst(p.Host);
+				var r = new GraphRequest();
+				r.start = '1d-ago';
+				r.queries = [
+					new Query(false, {
+						metric: 'os.cpu',
+						derivative: 'counter',
+						tags: { host: v.host },
+					})
+				];
+				$http.get('/api/graph?json=' + encodeURIComponent(JSON.stringify(r)) + '&autods=100')
+					.success((data: any) => {
+						if (data.Series) {
+							data.Series[0].Name = 'CPU Percent Used';
+						}
+						v.graph = data.Series || [];
+					})
+					.error(fail);
+				break;
+		}
+		return v;
+	}
+	function render() {
+		$scope.panels = _.map($scope.dashboard.Panels || [], renderPanel);
+	}
+	// Changes of the search reload the page.
+	$scope.setVar = (name: string) => {
+		$location.search('var-' + name, $scope.vars[name]);
+	};
+	$scope.create = () => {
+		$location.search('name', $scope.newName);
+		$location.search('edit', true);
+	};
+	$scope.edit = () => {
+		var d = $scope.dashboard || {};
+		$scope.source = JSON.stringify({
+			Title: d.Title,
+			Variables: d.Variables || [],
+			Panels: d.Panels || [],
+		}, null, '\t');
+		$scope.editing = true;
+	};
+	$scope.save = () => {
+		var d: any;
+		try {
+			d = JSON.parse($scope.source);
+		} catch (e) {
+			$scope.error = 'Invalid JSON: ' + e;
+			return;
+		}
+		$scope.error = '';
+		$http.put('/api/dashboards/' + encodeURIComponent($scope.name), d)
+			.success(() => {
+				if (search.edit) {
+					$location.search('edit', null);
+				} else {
+					load();
+				}
+			})
+			.error((error) => {
+				$scope.error = error;
+			});
+	};
+	$scope.remove = () => {
+		if (!window.confirm('Delete dashboard ' + $scope.name + '?')) {
+			return;
+		}
+		$http.delete('/api/dashboards/' + encodeURIComponent($scope.name))
+			.success(() => {
+				$location.search({});
+			})
+			.error((error) => {
+				$scope.error = error;
+			});
+	};
+	function load() {
+		$scope.loading = true;
+		$scope.error = '';
+		$scope.editing = false;
+		$http.get('/api/dashboards/' + encodeURIComponent($scope.name))
+			.success((data: any) => {
+				$scope.dashboard = data;
+				$scope.values = data.Values || {};
+				$scope.vars = {};
+				angular.forEach(data.Variables, (v: any) => {
+					var values = $scope.values[v.Name] || [];
+					$scope.vars[v.Name] = search['var-' + v.Name] || v.Default || values[0] || '';
+				});
+				render();
+				if (search.edit) {
+					$scope.edit();
+				}
+			})
+			.error((error) => {
+				$scope.dashboard = null;
+				if (search.edit) {
+					// A new dashboard.
+					$scope.edit();
+					return;
+				}
+				$scope.error = error;
+			})
+			.finally(() => { $scope.loading = false; });
+	}
+	if ($scope.name) {
+		load();
+	} else {
+		$http.get('/api/dashboards')
+			.success((data: any[]) => {
+				$scope.dashboards = data || [];
+			})
+			.error((error) => {
+				$scope.error = 'Unable to fetch dashboards: ' + error;
+			});
+	}
+}]);
@@ -250,14 +250,39 @@ class Groups {
 }
 
 
+class PendingAlert {
+    AlertKey: string;
+    Alert: string;
+    Status: string;
+    WorstStatus: string;
+    Since: string;
+    LastCheck: string;
+
+    constructor(p) {
+        this.AlertKey = p.AlertKey;
+        this.Alert = p.Alert;
+        this.Status = p.Status;
+        this.WorstStatus = p.WorstStatus;
+        this.Since = p.Since;
+        this.LastCheck = p.LastCheck;
+    }
+}
+
 class StateGroups {
     Groups: Groups;
+    Pending: PendingAlert[];
     TimeAndDate: number[];
     FailingAlerts: number;
     UnclosedErrors: number;
 
     constructor(sgs) {
         this.Groups = new Groups(sgs.Groups);
+        this.Pending = new Array<PendingAlert>();
+        if (sgs.Pending) {
+            for (let p of sgs.Pending) {
+                this.Pending.push(new PendingAlert(p));
+            }
+        }
         this.TimeAndDate = sgs.TimeAndDate;
         this.FailingAlerts = sgs.FailingAlerts;
         this.UnclosedErrors = sgs.UnclosedErrors;
@@ -0,0 +1,27 @@
+/// <reference path="0-bosun.ts" />
+
+interface IProblemsScope extends ng.IScope {
+	problems: any[];
+	id: number;
+	error: string;
+	loading: boolean;
+	load: () => void;
+}
+
+bosunControllers.controller('ProblemsCtrl', ['$scope', '$http', '$location', function($scope: IProblemsScope, $http: ng.IHttpService, $location: ng.ILocationService) {
+	var search = $location.search();
+	$scope.id = +search.id || 0;
+	$scope.load = () => {
+		$scope.loading = true;
+		var url = $scope.id ? '/api/problem?id=' + $scope.id : '/api/problems/open';
+		$http.get(url)
+			.success((data: any) => {
+				$scope.problems = $scope.id ? [data] : data;
+			})
+			.error((error) => {
+				$scope.error = 'Unable to fetch problems: ' + error;
+			})
+			.finally(() => { $scope.loading = false; });
+	};
+	$scope.load();
+}]);
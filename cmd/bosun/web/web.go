@@ -132,7 +132,15 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handleFunc("/api/", APIRedirect, fullyOpen).Name("api_redir")
 	handle("/api/action", JSON(Action), canPerformActions).Name("action").Methods(POST)
 	handle("/api/alerts", JSON(Alerts), canViewDash).Name("alerts").Methods(GET)
+	handle("/api/alerts/cost", JSON(AlertCosts), canViewDash).Name("alert_costs").Methods(GET)
+	handle("/api/alerts/{name}/runs", JSON(AlertRuns), canViewDash).Name("alert_runs").Methods(GET)
+	handle("/api/cardinality", JSON(Cardinality), canViewDash).Name("cardinality").Methods(GET)
 	handle("/api/config", JSON(Config), canViewConfig).Name("get_config").Methods(GET)
+	handle("/api/dashboards", JSON(ListDashboards), canViewDash).Name("dashboards").Methods(GET)
+	handle("/api/dashboards/{name}", JSON(GetDashboard), canViewDash).Name("dashboard_get").Methods(GET)
+	handle("/api/dashboards/{name}", JSON(SetDashboard), canEditDashboards).Name("dashboard_set").Methods(http.MethodPut)
+	handle("/api/dashboards/{name}", JSON(DeleteDashboard), canEditDashboards).Name("dashboard_delete").Methods(http.MethodDelete)
+	handle("/api/dashboards/{name}/panels/{panel}/expr", JSON(DashboardExpr), canViewDash).Name("dashboard_expr").Methods(GET)
 
 	handle("/api/config_test", JSON(ConfigTest), canViewConfig).Name("config_test").Methods(POST)
 	handle("/api/save_enabled", JSON(SaveEnabled), fullyOpen).Name("seve_enabled").Methods(GET)
@@ -152,6 +160,10 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handle("/api/errors", JSON(ErrorHistory), canViewDash).Name("errors").Methods(GET, POST)
 	handle("/api/expr", JSON(Expr), canRunTests).Name("expr").Methods(POST)
 	handle("/api/graph", JSON(Graph), canViewDash).Name("graph").Methods(GET)
+	handle("/api/grafana/", JSON(GrafanaTest), canViewDash).Name("grafana_test").Methods(GET)
+	handle("/api/grafana/query", JSON(GrafanaQuery), canRunTests).Name("grafana_query").Methods(POST)
+	handle("/api/grafana/search", JSON(GrafanaSearch), canViewDash).Name("grafana_search").Methods(POST)
+	handle("/api/grafana/annotations", JSON(GrafanaAnnotations), canViewDash).Name("grafana_annotations").Methods(POST)
 
 	handle("/api/health", JSON(HealthCheck), fullyOpen).Name("health_check").Methods(GET)
 	handle("/api/host", JSON(Host), canViewDash).Name("host").Methods(GET)
@@ -159,6 +171,8 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handle("/api/quiet", JSON(Quiet), canViewDash).Name("quiet").Methods(GET)
 	handle("/api/incidents/open", JSON(ListOpenIncidents), canViewDash).Name("open_incidents").Methods(GET)
 	handle("/api/incidents/events", JSON(IncidentEvents), canViewDash).Name("incident_events").Methods(GET)
+	handle("/api/problems/open", JSON(ListOpenProblems), canViewDash).Name("open_problems").Methods(GET)
+	handle("/api/problem", JSON(GetProblem), canViewDash).Name("problem").Methods(GET)
 	handle("/api/metadata/get", JSON(GetMetadata), canViewDash).Name("meta_get").Methods(GET)
 	handle("/api/metadata/metrics", JSON(MetadataMetrics), canViewDash).Name("meta_metrics").Methods(GET)
 	handle("/api/metadata/put", JSON(PutMetadata), canPutData).Name("meta_put").Methods(POST)
@@ -266,6 +280,9 @@ func (rp *relayProxy) ServeHTTP(responseWriter http.ResponseWriter, r *http.Requ
 	clean := func(s string) string {
 		return opentsdb.MustReplace(s, "_")
 	}
+	if schedule.Search.LimitsEnabled() && !limitTSDB(responseWriter, r) {
+		return
+	}
 	reader := &passthru{ReadCloser: r.Body}
 	r.Body = reader
 	w := &relayWriter{ResponseWriter: responseWriter}
@@ -284,10 +301,7 @@ func Relay(dest string) http.Handler {
 	})}
 }
 
-func indexTSDB(r *http.Request, body []byte) {
-	clean := func(s string) string {
-		return opentsdb.MustReplace(s, "_")
-	}
+func decodeTSDB(body []byte) opentsdb.MultiDataPoint {
 	if r, err := gzip.NewReader(bytes.NewReader(body)); err == nil {
 		body, _ = ioutil.ReadAll(r)
 		r.Close()
@@ -298,6 +312,54 @@ func indexTSDB(r *http.Request, body []byte) {
 	} else if err = json.Unmarshal(body, &dp); err == nil {
 		mdp = opentsdb.MultiDataPoint{&dp}
 	}
+	return mdp
+}
+
+// limitTSDB applies the search cardinality limits to the data points in the
+// body of r. Data points of new series over a limit are either removed from
+// the body or cause the request to be rejected, depending on the configured
+// action. It returns false if the request should not be processed further,
+// in which case a response has been written.
+func limitTSDB(w http.ResponseWriter, r *http.Request) bool {
+	body, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	mdp := decodeTSDB(body)
+	if len(mdp) == 0 {
+		return true
+	}
+	admitted, limited := schedule.Search.Limit(mdp)
+	if len(limited) == 0 {
+		return true
+	}
+	if schedule.SystemConf.GetCardinalityConf().Action == "reject" {
+		http.Error(w, fmt.Sprintf("%d of %d data points exceed cardinality limits", len(limited), len(mdp)), http.StatusBadRequest)
+		return false
+	}
+	if len(admitted) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return false
+	}
+	b, err := json.Marshal(admitted)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	r.ContentLength = int64(len(b))
+	r.Header.Del("Content-Encoding")
+	return true
+}
+
+func indexTSDB(r *http.Request, body []byte) {
+	clean := func(s string) string {
+		return opentsdb.MustReplace(s, "_")
+	}
+	mdp := decodeTSDB(body)
 	if len(mdp) > 0 {
 		ra := strings.Split(r.RemoteAddr, ":")[0]
 		tags := opentsdb.TagSet{"remote": clean(ra)}
@@ -308,6 +370,9 @@ func indexTSDB(r *http.Request, body []byte) {
 }
 
 func IndexTSDB(w http.ResponseWriter, r *http.Request) {
+	if schedule.Search.LimitsEnabled() && !limitTSDB(w, r) {
+		return
+	}
 	body, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		slog.Error(err)
@@ -445,6 +510,17 @@ type Health struct {
 	UptimeSeconds int64
 	StartEpoch    int64
 	Notifications NotificationStats
+	// Cluster is set if alert checks are sharded across bosun processes.
+	Cluster *ClusterHealth `json:",omitempty"`
+}
+
+type ClusterHealth struct {
+	// Worker is the name of this process.
+	Worker string
+	// Leader is true if this process sends the notifications queued in the database.
+	Leader bool
+	// Workers are the live processes and their load.
+	Workers []*models.Worker
 }
 
 type NotificationStats struct {
@@ -493,6 +569,10 @@ func HealthCheck(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (
 	n.EmailNotificationsFailed = collect.Get("email.sent_failed", nil)
 
 	h.Notifications = n
+
+	if name, leader, workers := schedule.ClusterWorkers(); name != "" {
+		h.Cluster = &ClusterHealth{Worker: name, Leader: leader, Workers: workers}
+	}
 	return h, nil
 }
 
@@ -616,6 +696,76 @@ func Alerts(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 	return schedule.MarshalGroups(t, r.FormValue("filter"))
 }
 
+type alertCostsResponse struct {
+	// Worker is the name of this process if alert checks are sharded, in
+	// which case only the alerts it checks are included.
+	Worker string `json:",omitempty"`
+	// Since is when this process started recording costs. They are kept in
+	// memory, so they reset when bosun restarts.
+	Since  time.Time
+	Alerts []*models.AlertCost
+	// Total is the number of alerts checked, which may be more than the
+	// number of alerts returned.
+	Total int
+}
+
+// AlertCosts returns the alerts that are the most expensive to check. The
+// optional sort parameter is one of mean (the default), max, last, total or
+// results, and limit is the number of alerts to return, defaulting to 100.
+func AlertCosts(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	n := 100
+	if v := r.FormValue("limit"); v != "" {
+		var err error
+		if n, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("could not parse limit: %v", err)
+		}
+	}
+	var key func(c *models.AlertCost) float64
+	switch sortBy := r.FormValue("sort"); sortBy {
+	case "mean", "":
+		key = func(c *models.AlertCost) float64 { return c.MeanTime }
+	case "max":
+		key = func(c *models.AlertCost) float64 { return c.MaxTime }
+	case "last":
+		key = func(c *models.AlertCost) float64 { return c.LastTime }
+	case "total":
+		key = func(c *models.AlertCost) float64 { return c.Time }
+	case "results":
+		key = func(c *models.AlertCost) float64 { return float64(c.LastResults) }
+	default:
+		return nil, fmt.Errorf("invalid sort %q", sortBy)
+	}
+	costs, since := schedule.AlertCosts()
+	sort.Slice(costs, func(i, j int) bool {
+		if ki, kj := key(costs[i]), key(costs[j]); ki != kj {
+			return ki > kj
+		}
+		return costs[i].Alert < costs[j].Alert
+	})
+	res := alertCostsResponse{
+		Since:  since,
+		Alerts: costs,
+		Total:  len(costs),
+	}
+	res.Worker, _, _ = schedule.ClusterWorkers()
+	if n > 0 && n < len(costs) {
+		res.Alerts = costs[:n]
+	}
+	return res, nil
+}
+
+// AlertRuns returns the last runs of an alert, most recent first.
+func AlertRuns(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	n := 0
+	if v := r.FormValue("limit"); v != "" {
+		var err error
+		if n, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("could not parse limit: %v", err)
+		}
+	}
+	return schedule.DataAccess.Runs().GetAlertRuns(mux.Vars(r)["name"], n)
+}
+
 type ExtStatus struct {
 	AlertName string
 	Subject   string
@@ -724,15 +874,24 @@ func userCanOverwriteUsername(r *http.Request) bool {
 	return false
 }
 
+func userCanRunTests(r *http.Request) bool {
+	user := easyauth.GetUser(r)
+	if user != nil {
+		return user.Access&canRunTests != 0
+	}
+	return false
+}
+
 func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	var data struct {
-		Type    string
-		Message string
-		Keys    []string
-		Ids     []int64
-		Notify  bool
-		User    string
-		Time    *time.Time
+		Type     string
+		Message  string
+		Keys     []string
+		Ids      []int64
+		Problems []int64
+		Notify   bool
+		User     string
+		Time     *time.Time
 	}
 	j := json.NewDecoder(r.Body)
 	if err := j.Decode(&data); err != nil {
@@ -787,6 +946,28 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 			successful = append(successful, ak)
 		}
 	}
+	for _, id := range data.Problems {
+		p, err := schedule.GetProblem(id)
+		if err != nil || p == nil {
+			if err == nil {
+				err = fmt.Errorf("no problem with id %d", id)
+			}
+			errs[fmt.Sprintf("problem %v", id)] = err
+			continue
+		}
+		// Act on the incidents of the problem that are still open.
+		for _, is := range p.Incidents {
+			if !is.Open {
+				continue
+			}
+			ak, err := schedule.ActionByIncidentId(data.User, data.Message, at, data.Time, is.Id)
+			if err != nil {
+				errs[fmt.Sprintf("%v", is.Id)] = err
+			} else {
+				successful = append(successful, ak)
+			}
+		}
+	}
 	if len(errs) != 0 {
 		return nil, errs
 	}
@@ -62,7 +62,7 @@ func (c *IntervalCollector) Run(dpchan chan<- *opentsdb.DataPoint, quit <-chan s
 			timeFinish := time.Since(timeStart)
 			result := 0
 			if err != nil {
-				slog.Errorf("%v: %v", c.Name(), err)
+				slog.With("collector", c.Name()).Error(err)
 				result = 1
 			}
 			if !collect.DisableDefaultCollectors {
@@ -0,0 +1,329 @@
+package collectors
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/scollector/conf"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const promMetricNameLabel = "__name__"
+
+// Prometheus adds a collector scraping the Prometheus text exposition format
+// from p.URL.
+func Prometheus(p conf.Prometheus) error {
+	t, err := newPromTarget(p)
+	if err != nil {
+		return err
+	}
+	collectors = append(collectors, &IntervalCollector{
+		F:        t.scrape,
+		Interval: t.interval,
+		name:     fmt.Sprintf("prometheus-%s", t.name),
+	})
+	return nil
+}
+
+type promTarget struct {
+	url      string
+	name     string
+	interval time.Duration
+	prefix   string
+	tags     opentsdb.TagSet
+	include  []*regexp.Regexp
+	exclude  []*regexp.Regexp
+	relabel  []*promRelabel
+	client   *http.Client
+}
+
+type promRelabel struct {
+	sourceLabels []string
+	separator    string
+	regex        *regexp.Regexp
+	targetLabel  string
+	replacement  string
+	action       string
+}
+
+func newPromTarget(p conf.Prometheus) (*promTarget, error) {
+	if p.URL == "" {
+		return nil, fmt.Errorf("empty Prometheus URL")
+	}
+	u, err := url.Parse(p.URL)
+	if err != nil {
+		return nil, err
+	}
+	t := &promTarget{
+		url:    p.URL,
+		name:   p.Name,
+		prefix: p.Prefix,
+		tags:   p.Tags,
+	}
+	if t.name == "" {
+		t.name = u.Host
+	}
+	if p.Interval != "" {
+		if t.interval, err = time.ParseDuration(p.Interval); err != nil {
+			return nil, fmt.Errorf("prometheus %s: invalid Interval: %v", t.name, err)
+		}
+	}
+	// A scrape must not outlast its interval, or a hung target would block
+	// the collector.
+	timeout := t.interval
+	if timeout == 0 {
+		timeout = DefaultFreq
+	}
+	t.client = &http.Client{Timeout: timeout}
+	if t.include, err = compileRegexps(p.Include); err != nil {
+		return nil, fmt.Errorf("prometheus %s: invalid Include: %v", t.name, err)
+	}
+	if t.exclude, err = compileRegexps(p.Exclude); err != nil {
+		return nil, fmt.Errorf("prometheus %s: invalid Exclude: %v", t.name, err)
+	}
+	for i, r := range p.Relabel {
+		rl := &promRelabel{
+			sourceLabels: r.SourceLabels,
+			separator:    r.Separator,
+			targetLabel:  r.TargetLabel,
+			replacement:  r.Replacement,
+			action:       strings.ToLower(r.Action),
+		}
+		if rl.separator == "" {
+			rl.separator = ";"
+		}
+		if rl.replacement == "" {
+			rl.replacement = "$1"
+		}
+		if rl.action == "" {
+			rl.action = "replace"
+		}
+		regex := r.Regex
+		if regex == "" {
+			regex = "(.*)"
+		}
+		if rl.regex, err = regexp.Compile("^(?:" + regex + ")$"); err != nil {
+			return nil, fmt.Errorf("prometheus %s: relabel %d: %v", t.name, i, err)
+		}
+		switch rl.action {
+		case "replace":
+			if rl.targetLabel == "" {
+				return nil, fmt.Errorf("prometheus %s: relabel %d: replace requires TargetLabel", t.name, i)
+			}
+		case "keep", "drop":
+			if len(rl.sourceLabels) == 0 {
+				return nil, fmt.Errorf("prometheus %s: relabel %d: %s requires SourceLabels", t.name, i, rl.action)
+			}
+		case "labeldrop", "labelkeep":
+		default:
+			return nil, fmt.Errorf("prometheus %s: relabel %d: unknown action %q", t.name, i, r.Action)
+		}
+		t.relabel = append(t.relabel, rl)
+	}
+	return t, nil
+}
+
+func compileRegexps(exprs []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, len(exprs))
+	for i, e := range exprs {
+		re, err := regexp.Compile(e)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = re
+	}
+	return res, nil
+}
+
+// wanted returns true if the metric family name passes the include and
+// exclude lists.
+func (t *promTarget) wanted(name string) bool {
+	for _, re := range t.exclude {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+	if len(t.include) == 0 {
+		return true
+	}
+	for _, re := range t.include {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply runs the relabel rules over labels, which is modified in place. It
+// returns false if the sample should be dropped.
+func (r *promRelabel) apply(labels map[string]string) bool {
+	switch r.action {
+	case "labeldrop", "labelkeep":
+		for k := range labels {
+			if k == promMetricNameLabel {
+				continue
+			}
+			if r.regex.MatchString(k) == (r.action == "labeldrop") {
+				delete(labels, k)
+			}
+		}
+		return true
+	}
+	values := make([]string, len(r.sourceLabels))
+	for i, l := range r.sourceLabels {
+		values[i] = labels[l]
+	}
+	value := strings.Join(values, r.separator)
+	switch r.action {
+	case "keep":
+		return r.regex.MatchString(value)
+	case "drop":
+		return !r.regex.MatchString(value)
+	}
+	idx := r.regex.FindStringSubmatchIndex(value)
+	if idx == nil {
+		return true
+	}
+	res := string(r.regex.ExpandString(nil, r.replacement, value, idx))
+	if res == "" {
+		delete(labels, r.targetLabel)
+	} else {
+		labels[r.targetLabel] = res
+	}
+	return true
+}
+
+func (t *promTarget) scrape() (opentsdb.MultiDataPoint, error) {
+	req, err := http.NewRequest("GET", t.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/plain;version=0.0.4")
+	res, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("prometheus %s: HTTP status %v", t.name, res.Status)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return t.convert(families), nil
+}
+
+// convert turns parsed metric families into datapoints. Families are
+// processed in name order so that output is stable.
+func (t *promTarget) convert(families map[string]*dto.MetricFamily) opentsdb.MultiDataPoint {
+	var md opentsdb.MultiDataPoint
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !t.wanted(name) {
+			continue
+		}
+		mf := families[name]
+		help := mf.GetHelp()
+		for _, m := range mf.Metric {
+			ts := now()
+			if m.TimestampMs != nil {
+				ts = m.GetTimestampMs() / 1000
+			}
+			add := func(suffix string, value float64, extra opentsdb.TagSet, rate metadata.RateType) {
+				t.add(&md, name+suffix, ts, value, m.Label, extra, rate, help)
+			}
+			switch mf.GetType() {
+			case dto.MetricType_COUNTER:
+				add("", m.GetCounter().GetValue(), nil, metadata.Counter)
+			case dto.MetricType_GAUGE:
+				add("", m.GetGauge().GetValue(), nil, metadata.Gauge)
+			case dto.MetricType_SUMMARY:
+				s := m.GetSummary()
+				for _, q := range s.Quantile {
+					add("", q.GetValue(), opentsdb.TagSet{"quantile": promFloatTag(q.GetQuantile())}, metadata.Gauge)
+				}
+				add("_sum", s.GetSampleSum(), nil, metadata.Counter)
+				add("_count", float64(s.GetSampleCount()), nil, metadata.Counter)
+			case dto.MetricType_HISTOGRAM:
+				h := m.GetHistogram()
+				for _, b := range h.Bucket {
+					add("_bucket", float64(b.GetCumulativeCount()), opentsdb.TagSet{"le": promFloatTag(b.GetUpperBound())}, metadata.Counter)
+				}
+				add("_sum", h.GetSampleSum(), nil, metadata.Counter)
+				add("_count", float64(h.GetSampleCount()), nil, metadata.Counter)
+			default:
+				add("", m.GetUntyped().GetValue(), nil, metadata.Unknown)
+			}
+		}
+	}
+	return md
+}
+
+// add relabels and appends a single sample to md.
+func (t *promTarget) add(md *opentsdb.MultiDataPoint, name string, ts int64, value float64, labels []*dto.LabelPair, extra opentsdb.TagSet, rate metadata.RateType, desc string) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+	l := make(map[string]string, len(labels)+len(extra)+1)
+	for _, lp := range labels {
+		l[lp.GetName()] = lp.GetValue()
+	}
+	for k, v := range extra {
+		l[k] = v
+	}
+	l[promMetricNameLabel] = name
+	for _, r := range t.relabel {
+		if !r.apply(l) {
+			return
+		}
+	}
+	// Prometheus names may contain colons, and relabeling may produce any
+	// characters, neither of which are valid in OpenTSDB.
+	metric := opentsdb.MustReplace(l[promMetricNameLabel], "_")
+	delete(l, promMetricNameLabel)
+	if metric == "" {
+		return
+	}
+	if t.prefix != "" {
+		metric = t.prefix + "." + metric
+	}
+	tags := t.tags.Copy()
+	for k, v := range l {
+		k = opentsdb.MustReplace(k, "_")
+		v = opentsdb.MustReplace(v, "_")
+		if k == "" || v == "" {
+			continue
+		}
+		if _, present := tags[k]; !present {
+			tags[k] = v
+		}
+	}
+	AddTS(md, metric, ts, value, tags, rate, metadata.None, desc)
+}
+
+// promFloatTag formats a bucket bound or quantile as a valid tag value.
+func promFloatTag(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
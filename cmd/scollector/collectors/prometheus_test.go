@@ -0,0 +1,128 @@
+package collectors
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bosun.org/cmd/scollector/conf"
+	"bosun.org/host"
+	"bosun.org/opentsdb"
+	"bosun.org/util"
+)
+
+func TestPrometheusScrape(t *testing.T) {
+	hm, err := host.NewManager(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	util.SetHostManager(hm)
+	ht := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testPrometheusText)
+	}))
+	defer ht.Close()
+
+	target, err := newPromTarget(conf.Prometheus{
+		URL:     ht.URL,
+		Prefix:  "app",
+		Tags:    opentsdb.TagSet{"host": "web01"},
+		Exclude: []string{"^go_"},
+		Relabel: []conf.PrometheusRelabel{
+			{SourceLabels: []string{"__name__"}, Regex: "myapp_(.*)", TargetLabel: "__name__"},
+			{Action: "labeldrop", Regex: "instance"},
+			{Action: "drop", SourceLabels: []string{"code"}, Regex: "5.."},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, err := target.scrape()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := opentsdb.MultiDataPoint{
+		{Metric: "app.http_requests_total", Value: float64(1027), Tags: opentsdb.TagSet{"host": "web01", "method": "post", "code": "200"}},
+		{Metric: "app.temperature", Value: float64(21.5), Tags: opentsdb.TagSet{"host": "web01"}},
+		{Metric: "app.latency_seconds_bucket", Value: float64(24054), Tags: opentsdb.TagSet{"host": "web01", "le": "0.05"}},
+		{Metric: "app.latency_seconds_bucket", Value: float64(144320), Tags: opentsdb.TagSet{"host": "web01", "le": "inf"}},
+		{Metric: "app.latency_seconds_sum", Value: float64(53423), Tags: opentsdb.TagSet{"host": "web01"}},
+		{Metric: "app.latency_seconds_count", Value: float64(144320), Tags: opentsdb.TagSet{"host": "web01"}},
+		{Metric: "app.rpc_duration_seconds", Value: float64(3102), Tags: opentsdb.TagSet{"host": "web01", "quantile": "0.5"}},
+		{Metric: "app.rpc_duration_seconds_count", Value: float64(2693), Tags: opentsdb.TagSet{"host": "web01"}},
+		{Metric: "app.job_requests_rate5m", Value: float64(4.5), Tags: opentsdb.TagSet{"host": "web01"}},
+	}
+	for _, e := range expected {
+		if !mdContains(t, md, e) {
+			t.Errorf("md must contain %v", e)
+		}
+	}
+	for _, d := range md {
+		if d.Metric == "app.go_goroutines" || d.Metric == "go_goroutines" {
+			t.Errorf("excluded metric %s was sent", d.Metric)
+		}
+		if d.Tags["code"] == "500" {
+			t.Errorf("dropped sample %v was sent", d)
+		}
+		if _, ok := d.Tags["instance"]; ok {
+			t.Errorf("dropped label instance was sent in %v", d)
+		}
+	}
+}
+
+func TestPrometheusTimeout(t *testing.T) {
+	done := make(chan struct{})
+	ht := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ht.Close()
+	defer close(done)
+
+	target, err := newPromTarget(conf.Prometheus{
+		URL:      ht.URL,
+		Interval: "100ms",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := target.scrape(); err == nil {
+		t.Fatal("expected error for hung target")
+	}
+}
+
+func TestPrometheusBadRelabel(t *testing.T) {
+	_, err := newPromTarget(conf.Prometheus{
+		URL:     "http://localhost:9100/metrics",
+		Relabel: []conf.PrometheusRelabel{{Action: "hashmod"}},
+	})
+	if err == nil {
+		t.Fatal("expected error for unknown relabel action")
+	}
+}
+
+var testPrometheusText = `# HELP myapp_http_requests_total The total number of HTTP requests.
+# TYPE myapp_http_requests_total counter
+myapp_http_requests_total{method="post",code="200",instance="a:1"} 1027
+myapp_http_requests_total{method="post",code="500",instance="a:1"} 3
+# HELP myapp_temperature Current temperature.
+# TYPE myapp_temperature gauge
+myapp_temperature 21.5
+# TYPE job:requests:rate5m gauge
+job:requests:rate5m 4.5
+# HELP go_goroutines Number of goroutines that currently exist.
+# TYPE go_goroutines gauge
+go_goroutines 12
+# HELP myapp_latency_seconds A histogram of the request duration.
+# TYPE myapp_latency_seconds histogram
+myapp_latency_seconds_bucket{le="0.05"} 24054
+myapp_latency_seconds_bucket{le="0.1"} 33444
+myapp_latency_seconds_bucket{le="+Inf"} 144320
+myapp_latency_seconds_sum 53423
+myapp_latency_seconds_count 144320
+# HELP myapp_rpc_duration_seconds A summary of the RPC duration in seconds.
+# TYPE myapp_rpc_duration_seconds summary
+myapp_rpc_duration_seconds{quantile="0.5"} 3102
+myapp_rpc_duration_seconds{quantile="0.9"} 3272
+myapp_rpc_duration_seconds_sum 1.7560473e+07
+myapp_rpc_duration_seconds_count 2693
+`
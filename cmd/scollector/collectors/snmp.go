@@ -1,18 +1,22 @@
 package collectors
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 
 	"bosun.org/cmd/scollector/conf"
 	"bosun.org/metadata"
 	"bosun.org/opentsdb"
 	"bosun.org/slog"
 	"bosun.org/snmp"
+	"bosun.org/snmp/mib"
 )
 
 var builtInSNMPs = map[string]func(cfg conf.SNMP){
@@ -32,9 +36,12 @@ func SNMP(cfg conf.SNMP, mibs map[string]conf.MIB) error {
 	if cfg.Host == "" {
 		return fmt.Errorf("empty SNMP hostname")
 	}
-	if cfg.Community == "" {
+	if cfg.Community == "" && cfg.User == "" {
 		return fmt.Errorf("empty SNMP community")
 	}
+	if err := registerSNMPV3(&cfg); err != nil {
+		return err
+	}
 	if len(cfg.MIBs) == 0 {
 		cfg.MIBs = []string{"ifaces", "cisco", "bridge"}
 	}
@@ -58,10 +65,113 @@ func SNMP(cfg conf.SNMP, mibs map[string]conf.MIB) error {
 	return nil
 }
 
+type snmpV3Host struct {
+	v3 snmp.V3
+	// clients by context, which keeps the discovered engine of the host
+	// between polls.
+	clients map[string]*snmp.SNMP
+}
+
+var (
+	snmpV3Lock sync.Mutex
+	// snmpV3Hosts are keyed by host and the key of their SNMPv3 user, so
+	// that a host can be polled by several users.
+	snmpV3Hosts = make(map[string]*snmpV3Host)
+)
+
+// snmpV3Key returns the community identifying the user and security
+// parameters of v3, which does not reveal the passphrases.
+func snmpV3Key(v3 snmp.V3) string {
+	h := sha1.New()
+	for _, s := range []string{v3.User, v3.AuthProtocol, v3.AuthPassphrase, v3.PrivProtocol, v3.PrivPassphrase, v3.Context} {
+		io.WriteString(h, s)
+		h.Write([]byte{0})
+	}
+	return "snmpv3-" + hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// registerSNMPV3 makes SNMP requests to cfg.Host use the SNMPv3 user of cfg,
+// if any. The community of cfg is set to the key of the user, which the
+// collectors pass to snmpClient.
+func registerSNMPV3(cfg *conf.SNMP) error {
+	if cfg.User == "" {
+		return nil
+	}
+	v3 := snmp.V3{
+		User:           cfg.User,
+		AuthProtocol:   cfg.AuthProtocol,
+		AuthPassphrase: cfg.AuthPassphrase,
+		PrivProtocol:   cfg.PrivProtocol,
+		PrivPassphrase: cfg.PrivPassphrase,
+		Context:        cfg.Context,
+	}
+	cfg.Community = snmpV3Key(v3)
+	key := cfg.Host + " " + cfg.Community
+	snmpV3Lock.Lock()
+	defer snmpV3Lock.Unlock()
+	if _, ok := snmpV3Hosts[key]; ok {
+		return nil
+	}
+	// Validate the user now rather than at the first poll.
+	c, err := snmp.NewV3(cfg.Host, v3)
+	if err != nil {
+		return err
+	}
+	snmpV3Hosts[key] = &snmpV3Host{v3: v3, clients: map[string]*snmp.SNMP{v3.Context: c}}
+	return nil
+}
+
+// snmpClient returns the client for host. Communities which are not the key
+// of an SNMPv3 user registered for host are used as is. The Cisco
+// community@vlan indexing is mapped to the SNMPv3 context vlan-<vlan>.
+func snmpClient(host, community string) (*snmp.SNMP, error) {
+	key, vlan := community, ""
+	if i := strings.Index(community, "@"); i >= 0 {
+		key, vlan = community[:i], community[i+1:]
+	}
+	snmpV3Lock.Lock()
+	defer snmpV3Lock.Unlock()
+	h := snmpV3Hosts[host+" "+key]
+	if h == nil {
+		return snmp.New(host, community)
+	}
+	v3 := h.v3
+	if vlan != "" {
+		v3.Context = "vlan-" + vlan
+	}
+	if c := h.clients[v3.Context]; c != nil {
+		return c, nil
+	}
+	c, err := snmp.NewV3(host, v3)
+	if err != nil {
+		return nil, err
+	}
+	h.clients[v3.Context] = c
+	return c, nil
+}
+
+// snmpWalk is snmp.Walk using the SNMPv3 user of host, if any.
+func snmpWalk(host, community string, oids ...string) (*snmp.Rows, error) {
+	s, err := snmpClient(host, community)
+	if err != nil {
+		return nil, err
+	}
+	return s.Walk(oids...)
+}
+
+// snmpGet is snmp.Get using the SNMPv3 user of host, if any.
+func snmpGet(host, community string, nameval ...interface{}) error {
+	s, err := snmpClient(host, community)
+	if err != nil {
+		return err
+	}
+	return s.Get(nameval...)
+}
+
 // snmp_subtree takes an oid and returns all data exactly one level below it. It
 // produces an error if there is more than one level below.
 func snmp_subtree(host, community, oid string) (map[string]interface{}, error) {
-	rows, err := snmp.Walk(host, community, oid)
+	rows, err := snmpWalk(host, community, oid)
 	if err != nil {
 		return nil, err
 	}
@@ -117,13 +227,13 @@ func snmpOidArrayToString(path []int) string {
 
 func snmp_oid(host, community, oid string) (*big.Int, error) {
 	v := new(big.Int)
-	err := snmp.Get(host, community, oid, &v)
+	err := snmpGet(host, community, oid, &v)
 	return v, err
 }
 
 func snmpOidString(host, community, oid string) (string, error) {
 	var v []byte
-	err := snmp.Get(host, community, oid, &v)
+	err := snmpGet(host, community, oid, &v)
 	return string(v), err
 }
 
@@ -148,6 +258,15 @@ func snmp_convertToFloat(v interface{}) (float64, error) {
 	}
 }
 
+// snmpEnums returns the enumerated values of the MIB object oid, if any.
+func snmpEnums(oid string) map[int]string {
+	obj, err := mib.Describe(oid)
+	if err != nil || obj.Syntax == nil {
+		return nil
+	}
+	return obj.Syntax.Enums
+}
+
 func combineOids(oid, base string) string {
 	if oid != "" && oid[0] == '.' {
 		return base + oid
@@ -156,6 +275,9 @@ func combineOids(oid, base string) string {
 }
 
 func GenericSnmp(cfg conf.SNMP, mib conf.MIB) (opentsdb.MultiDataPoint, error) {
+	if err := registerSNMPV3(&cfg); err != nil {
+		return nil, err
+	}
 	md := opentsdb.MultiDataPoint{}
 	baseOid := mib.BaseOid
 
@@ -204,15 +326,20 @@ func GenericSnmp(cfg conf.SNMP, mib conf.MIB) (opentsdb.MultiDataPoint, error) {
 	for _, tree := range mib.Trees {
 		treeOid := combineOids(tree.BaseOid, baseOid)
 		tagCache := make(map[string]map[string]interface{}) // tag key to map of values
+		tagEnums := make(map[string]map[int]string)         // tag key to enumerated values
 		for _, tag := range tree.Tags {
 			if tag.Oid == "idx" {
 				continue
 			}
-			vals, err := snmp_subtree(cfg.Host, cfg.Community, combineOids(tag.Oid, treeOid))
+			oid := combineOids(tag.Oid, treeOid)
+			vals, err := snmp_subtree(cfg.Host, cfg.Community, oid)
 			if err != nil {
 				return md, err
 			}
 			tagCache[tag.Key] = vals
+			if tag.Enum {
+				tagEnums[tag.Key] = snmpEnums(oid)
+			}
 		}
 		for _, metric := range tree.Metrics {
 			rate, unit, tagset, err := rateUnitTags(metric)
@@ -247,6 +374,10 @@ func GenericSnmp(cfg conf.SNMP, mib conf.MIB) (opentsdb.MultiDataPoint, error) {
 					}
 					if byteSlice, ok := tagVal.([]byte); ok {
 						tagVal = string(byteSlice)
+					} else if enums := tagEnums[tag.Key]; enums != nil {
+						if f, err := snmp_convertToFloat(tagVal); err == nil && enums[int(f)] != "" {
+							tagVal = enums[int(f)]
+						}
 					}
 					tagset[tag.Key] = fmt.Sprint(tagVal)
 				}
@@ -9,7 +9,6 @@ import (
 	"bosun.org/cmd/scollector/conf"
 	"bosun.org/metadata"
 	"bosun.org/opentsdb"
-	"bosun.org/snmp"
 )
 
 func SNMPCiscoBGP(cfg conf.SNMP) {
@@ -178,7 +177,7 @@ func c_snmp_ciscobgp(community, host string) (opentsdb.MultiDataPoint, error) {
 }
 
 func snmp_ip_tree(host, community, oid string) (map[string]interface{}, error) {
-	rows, err := snmp.Walk(host, community, oid)
+	rows, err := snmpWalk(host, community, oid)
 	if err != nil {
 		return nil, err
 	}
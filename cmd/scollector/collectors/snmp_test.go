@@ -0,0 +1,53 @@
+package collectors
+
+import (
+	"testing"
+
+	"bosun.org/cmd/scollector/conf"
+)
+
+func TestSNMPEnums(t *testing.T) {
+	for _, oid := range []string{"IF-MIB::ifOperStatus", combineOids(".8", ".1.3.6.1.2.1.2.2.1")} {
+		enums := snmpEnums(oid)
+		if enums[1] != "up" || enums[7] != "lowerLayerDown" {
+			t.Errorf("%s: unexpected enums %v", oid, enums)
+		}
+	}
+	if enums := snmpEnums("ifDescr"); enums != nil {
+		t.Errorf("ifDescr: unexpected enums %v", enums)
+	}
+}
+
+func TestSNMPV3Users(t *testing.T) {
+	cfgs := []conf.SNMP{
+		{Host: "127.0.0.1", User: "monitor", AuthProtocol: "SHA", AuthPassphrase: "password1"},
+		{Host: "127.0.0.1", User: "admin", AuthProtocol: "SHA", AuthPassphrase: "password2", PrivProtocol: "AES", PrivPassphrase: "password3"},
+		{Host: "127.0.0.1", User: "admin", AuthProtocol: "SHA", AuthPassphrase: "password2"},
+	}
+	seen := make(map[string]bool)
+	for i := range cfgs {
+		if err := registerSNMPV3(&cfgs[i]); err != nil {
+			t.Fatal(err)
+		}
+		if seen[cfgs[i].Community] {
+			t.Errorf("%s: duplicate key %s", cfgs[i].User, cfgs[i].Community)
+		}
+		seen[cfgs[i].Community] = true
+	}
+	for _, cfg := range cfgs {
+		c, err := snmpClient(cfg.Host, cfg.Community)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h := snmpV3Hosts[cfg.Host+" "+cfg.Community]; h == nil || h.clients[""] != c {
+			t.Errorf("%s: expected the client of the user", cfg.User)
+		}
+	}
+	c, err := snmpClient("127.0.0.1", cfgs[0].Community+"@10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != snmpV3Hosts["127.0.0.1 "+cfgs[0].Community].clients["vlan-10"] {
+		t.Errorf("expected the client of the vlan-10 context")
+	}
+}
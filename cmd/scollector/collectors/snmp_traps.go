@@ -0,0 +1,362 @@
+package collectors
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"bosun.org/annotate"
+	"bosun.org/cmd/scollector/conf"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+	"bosun.org/snmp"
+	"bosun.org/snmp/asn1"
+	"bosun.org/snmp/mib"
+)
+
+const (
+	descSNMPTraps         = "Number of SNMP traps received."
+	descSNMPTrapsPackets  = "Number of packets received by the SNMP trap listener."
+	descSNMPTrapsErrors   = "Number of SNMP trap packets dropped or annotations not sent."
+	snmpTrapsDefaultAddr  = ":162"
+	snmpTrapsCategory     = "snmp-trap"
+	snmpTrapsDefaultCount = "snmp.traps"
+
+	// snmpTrapsIdleFlushes is the number of flushes without traps after
+	// which a series is no longer sent. Traps are rare, so counters are kept
+	// much longer than those of StatsD to not reset them between traps.
+	snmpTrapsIdleFlushes = 240
+)
+
+// SNMPTraps adds a collector listening for SNMP traps and inform requests.
+func SNMPTraps(c conf.SNMPTraps) error {
+	s, err := newTrapServer(c)
+	if err != nil {
+		return err
+	}
+	collectors = append(collectors, &StreamCollector{
+		F:    s.run,
+		name: fmt.Sprintf("snmptraps-%s", s.addr),
+	})
+	return nil
+}
+
+type trapServer struct {
+	addr        string
+	listener    string // addr cleaned for use as a tag value
+	communities map[string]bool
+	annotateURL string
+	rules       []trapRule
+
+	sync.Mutex
+	counters map[string]*trapSeries
+	gauges   map[string]*trapSeries
+}
+
+type trapRule struct {
+	oid      asn1.ObjectIdentifier
+	metric   string
+	tags     map[string]asn1.ObjectIdentifier
+	values   map[string]asn1.ObjectIdentifier
+	annotate bool
+}
+
+type trapSeries struct {
+	metric string
+	tags   opentsdb.TagSet
+	value  float64
+	idle   int // flushes since the last trap
+}
+
+func newTrapServer(c conf.SNMPTraps) (*trapServer, error) {
+	s := &trapServer{
+		addr:        c.Listen,
+		annotateURL: strings.TrimSuffix(c.AnnotateURL, "/"),
+		counters:    make(map[string]*trapSeries),
+		gauges:      make(map[string]*trapSeries),
+	}
+	if s.addr == "" {
+		s.addr = snmpTrapsDefaultAddr
+	}
+	s.listener = opentsdb.MustReplace(s.addr, "_")
+	if len(c.Communities) > 0 {
+		s.communities = make(map[string]bool)
+		for _, community := range c.Communities {
+			s.communities[community] = true
+		}
+	}
+	lookup := func(name string) (asn1.ObjectIdentifier, error) {
+		oid, err := mib.Lookup(name)
+		if err != nil {
+			return nil, fmt.Errorf("snmptraps %s: %v", s.addr, err)
+		}
+		return oid, nil
+	}
+	for _, t := range c.Traps {
+		r := trapRule{
+			metric:   t.Metric,
+			tags:     make(map[string]asn1.ObjectIdentifier),
+			values:   make(map[string]asn1.ObjectIdentifier),
+			annotate: t.Annotate,
+		}
+		if r.metric == "" {
+			r.metric = snmpTrapsDefaultCount
+		}
+		if r.annotate && s.annotateURL == "" {
+			return nil, fmt.Errorf("snmptraps %s: %s: Annotate requires AnnotateURL", s.addr, t.OID)
+		}
+		var err error
+		if r.oid, err = lookup(t.OID); err != nil {
+			return nil, err
+		}
+		for k, name := range t.Tags {
+			if r.tags[k], err = lookup(name); err != nil {
+				return nil, err
+			}
+		}
+		for m, name := range t.Values {
+			if r.values[m], err = lookup(name); err != nil {
+				return nil, err
+			}
+		}
+		s.rules = append(s.rules, r)
+	}
+	return s, nil
+}
+
+func (s *trapServer) run() <-chan *opentsdb.MultiDataPoint {
+	ch := make(chan *opentsdb.MultiDataPoint, 1)
+	metadata.AddMetricMeta("scollector.snmptraps.packets", metadata.Counter, metadata.Packet, descSNMPTrapsPackets)
+	metadata.AddMetricMeta("scollector.snmptraps.errors", metadata.Counter, metadata.Error, descSNMPTrapsErrors)
+	go s.listen()
+	go func() {
+		for {
+			time.Sleep(DefaultFreq)
+			if md := s.flush(); len(md) > 0 {
+				ch <- &md
+			}
+		}
+	}()
+	return ch
+}
+
+func (s *trapServer) listen() {
+	conn, err := net.ListenPacket("udp", s.addr)
+	if err != nil {
+		slog.Errorf("snmptraps: %v", err)
+		return
+	}
+	buf := make([]byte, 65535)
+	var delay time.Duration
+	for {
+		n, from, err := conn.ReadFrom(buf)
+		if err != nil {
+			if delay = statsdBackoff(err, delay); delay == 0 {
+				slog.Errorf("snmptraps: %v", err)
+				return
+			}
+			slog.Errorf("snmptraps: %v; retrying in %v", err, delay)
+			time.Sleep(delay)
+			continue
+		}
+		delay = 0
+		s.count("packets", "")
+		resp := s.handle(buf[:n], from)
+		if resp != nil {
+			if _, err := conn.WriteTo(resp, from); err != nil {
+				slog.Errorf("snmptraps: %v", err)
+			}
+		}
+	}
+}
+
+// count increments one of the listener's self metrics.
+func (s *trapServer) count(metric, reason string) {
+	if collect.DisableDefaultCollectors {
+		return
+	}
+	tags := opentsdb.TagSet{"listener": s.listener}
+	if reason != "" {
+		tags["reason"] = reason
+	}
+	collect.Add("snmptraps."+metric, tags, 1)
+}
+
+// handle records the trap in buf and returns the response to send back for
+// inform requests.
+func (s *trapServer) handle(buf []byte, from net.Addr) []byte {
+	trap, err := snmp.ParseTrap(buf)
+	if err != nil {
+		s.count("errors", "parse")
+		if collect.Debug {
+			slog.Infof("snmptraps: %s: %v", from, err)
+		}
+		return nil
+	}
+	if s.communities != nil && !s.communities[trap.Community] {
+		s.count("errors", "community")
+		return nil
+	}
+	host := trap.Agent.String()
+	if trap.Agent == nil {
+		host, _, _ = net.SplitHostPort(from.String())
+	}
+	s.record(trap, host)
+	if !trap.Inform {
+		return nil
+	}
+	resp, err := trap.Response()
+	if err != nil {
+		slog.Errorf("snmptraps: %v", err)
+	}
+	return resp
+}
+
+// record counts trap and applies the first rule matching it.
+func (s *trapServer) record(trap *snmp.Trap, host string) {
+	rule := trapRule{metric: snmpTrapsDefaultCount}
+	for _, r := range s.rules {
+		if r.oid.Equal(trap.OID) {
+			rule = r
+			break
+		}
+	}
+	name := trapName(trap.OID)
+	tags := opentsdb.TagSet{
+		"host": opentsdb.MustReplace(host, "_"),
+		"trap": opentsdb.MustReplace(name, "_"),
+	}
+	for k, oid := range rule.tags {
+		if v := trapVariable(trap, oid); v != nil {
+			if val := opentsdb.MustReplace(trapValue(*v), "_"); val != "" {
+				tags[k] = val
+			}
+		}
+	}
+	key := rule.metric + tags.String()
+	s.Lock()
+	c := s.counters[key]
+	if c == nil {
+		c = &trapSeries{metric: rule.metric, tags: tags}
+		s.counters[key] = c
+	}
+	c.value++
+	c.idle = 0
+	for metric, oid := range rule.values {
+		v := trapVariable(trap, oid)
+		if v == nil {
+			continue
+		}
+		f, err := snmp_convertToFloat(v.Value)
+		if err != nil {
+			continue
+		}
+		key := metric + tags.String()
+		g := s.gauges[key]
+		if g == nil {
+			g = &trapSeries{metric: metric, tags: tags}
+			s.gauges[key] = g
+		}
+		g.value = f
+		g.idle = 0
+	}
+	s.Unlock()
+	if rule.annotate {
+		go s.annotate(trap, name, host)
+	}
+}
+
+// annotate sends an annotation describing trap to bosun.
+func (s *trapServer) annotate(trap *snmp.Trap, name, host string) {
+	msg := []string{name}
+	for _, v := range trap.Variables {
+		oid, ok := mib.Translate(v.OID)
+		if !ok {
+			oid = v.OID.String()
+		} else if i := strings.Index(oid, "::"); i >= 0 {
+			oid = oid[i+2:]
+		}
+		msg = append(msg, oid+"="+trapValue(v))
+	}
+	now := time.Now().UTC()
+	a := annotate.NewAnnotation("", now, now, "scollector", "", "scollector", host, snmpTrapsCategory, "", strings.Join(msg, " "))
+	c := annotate.NewClient(s.annotateURL)
+	if _, err := c.SendAnnotation(a); err != nil {
+		s.count("errors", "annotate")
+		slog.Errorf("snmptraps: annotation: %v", err)
+	}
+}
+
+// flush returns the cumulative trap counters and the last values of gauges.
+// Series are dropped after snmpTrapsIdleFlushes without traps, so that those
+// of senders which no longer send traps don't accumulate.
+func (s *trapServer) flush() opentsdb.MultiDataPoint {
+	var md opentsdb.MultiDataPoint
+	s.Lock()
+	defer s.Unlock()
+	for key, c := range s.counters {
+		Add(&md, c.metric, c.value, c.tags, metadata.Counter, metadata.Count, descSNMPTraps)
+		if c.idle++; c.idle > snmpTrapsIdleFlushes {
+			delete(s.counters, key)
+		}
+	}
+	for key, g := range s.gauges {
+		Add(&md, g.metric, g.value, g.tags, metadata.Gauge, metadata.None, "")
+		if g.idle++; g.idle > snmpTrapsIdleFlushes {
+			delete(s.gauges, key)
+		}
+	}
+	return md
+}
+
+// trapName returns the MIB name of a trap without its module, or its OID.
+func trapName(oid asn1.ObjectIdentifier) string {
+	name, ok := mib.Translate(oid)
+	if !ok {
+		return oid.String()
+	}
+	if i := strings.Index(name, "::"); i >= 0 {
+		name = name[i+2:]
+	}
+	return name
+}
+
+// trapVariable returns the first variable of trap that is an instance of the
+// object oid.
+func trapVariable(trap *snmp.Trap, oid asn1.ObjectIdentifier) *snmp.Variable {
+	for i, v := range trap.Variables {
+		if len(v.OID) >= len(oid) && v.OID[:len(oid)].Equal(oid) {
+			return &trap.Variables[i]
+		}
+	}
+	return nil
+}
+
+// trapValue formats the value of v, using the names of enumerated values.
+func trapValue(v snmp.Variable) string {
+	switch val := v.Value.(type) {
+	case []byte:
+		for _, c := range val {
+			if c < 0x20 || c > 0x7e {
+				return hex.EncodeToString(val)
+			}
+		}
+		return string(val)
+	case int64:
+		if name, ok := snmpEnums(v.OID.String())[int(val)]; ok {
+			return name
+		}
+		return fmt.Sprint(val)
+	case asn1.ObjectIdentifier:
+		return trapName(val)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(val)
+	}
+}
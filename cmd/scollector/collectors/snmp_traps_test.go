@@ -0,0 +1,160 @@
+package collectors
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/scollector/conf"
+	"bosun.org/host"
+	"bosun.org/opentsdb"
+	"bosun.org/snmp/asn1"
+	"bosun.org/util"
+)
+
+type testBinding struct {
+	Name  asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+func testTrap(t *testing.T, tag byte, community string, trap asn1.ObjectIdentifier, vars ...testBinding) []byte {
+	var pdu struct {
+		RequestID   int32
+		ErrorStatus int
+		ErrorIndex  int
+		Bindings    []testBinding
+	}
+	pdu.RequestID = 7
+	pdu.Bindings = []testBinding{
+		{asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 3, 0}, asn1.RawValue{Class: 1, Tag: 3, Bytes: []byte{1}}},
+		{asn1.ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 4, 1, 0}, testRawValue(t, trap)},
+	}
+	pdu.Bindings = append(pdu.Bindings, vars...)
+	b, err := asn1.Marshal(pdu)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[0] = 0xa0 | tag
+	var msg struct {
+		Version   int
+		Community []byte
+		Data      asn1.RawValue
+	}
+	msg.Version = 1
+	msg.Community = []byte(community)
+	msg.Data = asn1.RawValue{FullBytes: b}
+	if b, err = asn1.Marshal(msg); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func testRawValue(t *testing.T, v interface{}) asn1.RawValue {
+	b, err := asn1.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r asn1.RawValue
+	if _, err := asn1.Unmarshal(b, &r); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestSNMPTraps(t *testing.T) {
+	hm, err := host.NewManager(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	util.SetHostManager(hm)
+	s, err := newTrapServer(conf.SNMPTraps{
+		Communities: []string{"public"},
+		Traps: []conf.SNMPTrap{{
+			OID:    "IF-MIB::linkDown",
+			Metric: "snmp.link_down",
+			Tags:   map[string]string{"iface": "ifDescr", "status": "ifOperStatus"},
+			Values: map[string]string{"snmp.link_down.mtu": "ifMtu"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	linkDown := asn1.ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 5, 3}
+	vars := []testBinding{
+		{asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 3}, testRawValue(t, []byte("eth 2"))},
+		{asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 4, 3}, testRawValue(t, 1500)},
+		{asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 8, 3}, testRawValue(t, 2)},
+	}
+	from := &net.UDPAddr{IP: net.IP{192, 0, 2, 1}, Port: 1162}
+	for _, test := range []struct {
+		msg      []byte
+		response bool
+	}{
+		{testTrap(t, 7, "public", linkDown, vars...), false},
+		{testTrap(t, 6, "public", linkDown, vars...), true},
+		{testTrap(t, 7, "public", asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 0, 1}), false},
+		{testTrap(t, 7, "private", linkDown, vars...), false},
+		{[]byte("garbage"), false},
+	} {
+		if resp := s.handle(test.msg, from); (resp != nil) != test.response {
+			t.Errorf("unexpected response %x", resp)
+		}
+	}
+	md := s.flush()
+	tags := opentsdb.TagSet{"host": "192.0.2.1", "trap": "linkDown", "iface": "eth_2", "status": "down"}
+	expected := opentsdb.MultiDataPoint{
+		{Metric: "snmp.link_down", Value: float64(2), Tags: tags},
+		{Metric: "snmp.link_down.mtu", Value: float64(1500), Tags: tags},
+		{Metric: "snmp.traps", Value: float64(1), Tags: opentsdb.TagSet{"host": "192.0.2.1", "trap": "enterprises.99999.0.1"}},
+	}
+	for _, e := range expected {
+		if !mdContains(t, md, e) {
+			t.Errorf("md must contain %v", e)
+		}
+	}
+	if len(md) != len(expected) {
+		t.Errorf("expected %d datapoints, got %d: %v", len(expected), len(md), md)
+	}
+	// Series of senders that no longer send traps are dropped.
+	for i := 1; i <= snmpTrapsIdleFlushes; i++ {
+		s.flush()
+	}
+	s.handle(testTrap(t, 7, "public", linkDown, vars...), from)
+	md = s.flush()
+	if len(md) != 2 {
+		t.Errorf("expected only the series of the last trap after idle flushes, got %v", md)
+	}
+}
+
+func TestSNMPTrapsAnnotate(t *testing.T) {
+	trap := conf.SNMPTrap{OID: "IF-MIB::linkDown", Annotate: true}
+	if _, err := newTrapServer(conf.SNMPTraps{Traps: []conf.SNMPTrap{trap}}); err == nil {
+		t.Fatal("expected error for Annotate without AnnotateURL")
+	}
+	got := make(chan string, 1)
+	ht := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got <- r.URL.Path
+		fmt.Fprint(w, "{}")
+	}))
+	defer ht.Close()
+	s, err := newTrapServer(conf.SNMPTraps{
+		AnnotateURL: ht.URL + "/api/",
+		Traps:       []conf.SNMPTrap{trap},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	linkDown := asn1.ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 5, 3}
+	s.handle(testTrap(t, 7, "public", linkDown), &net.UDPAddr{IP: net.IP{192, 0, 2, 1}, Port: 1162})
+	select {
+	case path := <-got:
+		if path != "/api/annotation" {
+			t.Errorf("expected annotation at /api/annotation, got %s", path)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("annotation was not sent")
+	}
+}
@@ -0,0 +1,226 @@
+package collectors
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/scollector/conf"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+)
+
+// SQLQuery adds a collector running q.Query and sending the rows of its
+// result as datapoints.
+func SQLQuery(q conf.SQLQuery) error {
+	s, err := newSQLCollector(q)
+	if err != nil {
+		return err
+	}
+	collectors = append(collectors, &IntervalCollector{
+		F:        s.collect,
+		Interval: s.interval,
+		name:     fmt.Sprintf("sqlquery-%s", s.name),
+	})
+	return nil
+}
+
+type sqlCollector struct {
+	db       *sql.DB
+	name     string
+	query    string
+	prefix   string
+	interval time.Duration
+	timeout  time.Duration
+	values   map[string]string // column to metric name
+	tags     map[string]string // column to tag key
+	static   opentsdb.TagSet
+	rate     metadata.RateType
+	unit     metadata.Unit
+	desc     string
+}
+
+func newSQLCollector(q conf.SQLQuery) (*sqlCollector, error) {
+	s := &sqlCollector{
+		name:   q.Name,
+		query:  q.Query,
+		prefix: q.Prefix,
+		values: q.Values,
+		tags:   make(map[string]string),
+		static: q.StaticTags,
+		rate:   metadata.RateType(strings.ToLower(q.RateType)),
+		unit:   metadata.Unit(q.Unit),
+		desc:   q.Description,
+	}
+	if s.name == "" {
+		s.name = q.Driver
+	}
+	if q.Query == "" {
+		return nil, fmt.Errorf("sqlquery %s: empty Query", s.name)
+	}
+	var err error
+	if q.Interval != "" {
+		if s.interval, err = time.ParseDuration(q.Interval); err != nil {
+			return nil, fmt.Errorf("sqlquery %s: invalid Interval: %v", s.name, err)
+		}
+	}
+	s.timeout = s.interval
+	if s.timeout == 0 {
+		s.timeout = DefaultFreq
+	}
+	if q.Timeout != "" {
+		if s.timeout, err = time.ParseDuration(q.Timeout); err != nil {
+			return nil, fmt.Errorf("sqlquery %s: invalid Timeout: %v", s.name, err)
+		}
+	}
+	switch s.rate {
+	case metadata.Unknown:
+		s.rate = metadata.Gauge
+	case metadata.Gauge, metadata.Counter, metadata.Rate:
+	default:
+		return nil, fmt.Errorf("sqlquery %s: unknown RateType %q", s.name, q.RateType)
+	}
+	for key, col := range q.Tags {
+		if _, ok := q.Values[col]; ok {
+			return nil, fmt.Errorf("sqlquery %s: column %s is both a tag and a value", s.name, col)
+		}
+		s.tags[col] = key
+	}
+	// sql.Open only validates its arguments; connections are made by the
+	// first query.
+	if s.db, err = sql.Open(q.Driver, q.DSN); err != nil {
+		return nil, fmt.Errorf("sqlquery %s: %v (available drivers: %s)", s.name, err, strings.Join(sql.Drivers(), ", "))
+	}
+	s.db.SetMaxOpenConns(1)
+	return s, nil
+}
+
+func (s *sqlCollector) collect() (opentsdb.MultiDataPoint, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	rows, err := s.db.QueryContext(ctx, s.query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkColumns(cols); err != nil {
+		return nil, err
+	}
+	var md opentsdb.MultiDataPoint
+	var firstErr error
+	vals := make([]interface{}, len(cols))
+	ptrs := make([]interface{}, len(cols))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(ptrs...); err != nil {
+			return md, err
+		}
+		tags := s.static.Copy()
+		for i, col := range cols {
+			key, ok := s.tags[col]
+			if !ok || vals[i] == nil {
+				continue
+			}
+			if v := opentsdb.MustReplace(sqlString(vals[i]), "_"); v != "" {
+				tags[key] = v
+			}
+		}
+		for i, col := range cols {
+			metric, ok := s.values[col]
+			if _, tag := s.tags[col]; tag || (len(s.values) > 0 && !ok) || vals[i] == nil {
+				continue
+			}
+			if metric == "" {
+				metric = opentsdb.MustReplace(strings.ToLower(col), "_")
+			}
+			if s.prefix != "" {
+				metric = s.prefix + "." + metric
+			}
+			v, err := sqlFloat(vals[i])
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("column %s: %v", col, err)
+				}
+				continue
+			}
+			Add(&md, metric, v, tags, s.rate, s.unit, s.desc)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return md, err
+	}
+	return md, firstErr
+}
+
+// checkColumns returns an error if a tag or value column is missing from the
+// result columns cols.
+func (s *sqlCollector) checkColumns(cols []string) error {
+	have := make(map[string]bool)
+	for _, c := range cols {
+		have[c] = true
+	}
+	var missing []string
+	for c := range s.tags {
+		if !have[c] {
+			missing = append(missing, c)
+		}
+	}
+	for c := range s.values {
+		if !have[c] {
+			missing = append(missing, c)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing columns: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// sqlFloat converts a value scanned from a database/sql result to a float.
+// Drivers return numeric columns as []byte or string if they have no native
+// representation, like MySQL DECIMAL.
+func sqlFloat(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case []byte:
+		return strconv.ParseFloat(string(v), 64)
+	case string:
+		return strconv.ParseFloat(v, 64)
+	case time.Time:
+		return float64(v.Unix()), nil
+	default:
+		return 0, fmt.Errorf("cannot convert %T to float64", v)
+	}
+}
+
+func sqlString(v interface{}) string {
+	switch v := v.(type) {
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,6 @@
+// +build cgo
+
+package collectors
+
+// The SQLite driver is only available in scollector built with cgo.
+import _ "github.com/mattn/go-sqlite3"
@@ -0,0 +1,119 @@
+// +build cgo
+
+package collectors
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"bosun.org/cmd/scollector/conf"
+	"bosun.org/opentsdb"
+)
+
+func TestSQLQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sqlquery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dsn := filepath.Join(dir, "test.db")
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		"CREATE TABLE orders (region TEXT, status TEXT, total REAL)",
+		"INSERT INTO orders VALUES ('us east', 'open', 10), ('us east', 'open', 5.5), ('eu', 'closed', 1), (NULL, 'open', 2)",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	for _, test := range []struct {
+		query    conf.SQLQuery
+		expected opentsdb.MultiDataPoint
+	}{
+		{
+			conf.SQLQuery{
+				Query:  "SELECT region, status, count(*) AS n, sum(total) AS total FROM orders GROUP BY region, status",
+				Prefix: "shop.orders",
+				Values: map[string]string{"n": "count", "total": "total"},
+				Tags:   map[string]string{"region": "region", "state": "status"},
+			},
+			opentsdb.MultiDataPoint{
+				{Metric: "shop.orders.count", Value: float64(1), Tags: opentsdb.TagSet{"db": "shop", "state": "open"}},
+				{Metric: "shop.orders.total", Value: float64(2), Tags: opentsdb.TagSet{"db": "shop", "state": "open"}},
+				{Metric: "shop.orders.count", Value: float64(1), Tags: opentsdb.TagSet{"db": "shop", "region": "eu", "state": "closed"}},
+				{Metric: "shop.orders.total", Value: float64(1), Tags: opentsdb.TagSet{"db": "shop", "region": "eu", "state": "closed"}},
+				{Metric: "shop.orders.count", Value: float64(2), Tags: opentsdb.TagSet{"db": "shop", "region": "us_east", "state": "open"}},
+				{Metric: "shop.orders.total", Value: float64(15.5), Tags: opentsdb.TagSet{"db": "shop", "region": "us_east", "state": "open"}},
+			},
+		},
+		{
+			// Without Values, every column not used as a tag is a value.
+			conf.SQLQuery{
+				Query: "SELECT status, count(*) AS Open_Orders, max(total) AS max_total FROM orders WHERE status = 'open'",
+				Tags:  map[string]string{"status": "status"},
+			},
+			opentsdb.MultiDataPoint{
+				{Metric: "open_orders", Value: float64(3), Tags: opentsdb.TagSet{"db": "shop", "status": "open"}},
+				{Metric: "max_total", Value: float64(10), Tags: opentsdb.TagSet{"db": "shop", "status": "open"}},
+			},
+		},
+	} {
+		test.query.Driver = "sqlite3"
+		test.query.DSN = dsn
+		test.query.StaticTags = opentsdb.TagSet{"db": "shop", "host": ""}
+		q, err := newSQLCollector(test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		md, err := q.collect()
+		q.db.Close()
+		if err != nil {
+			t.Errorf("%s: %v", test.query.Query, err)
+			continue
+		}
+		for _, e := range test.expected {
+			if !mdContains(t, md, e) {
+				t.Errorf("md must contain %v", e)
+			}
+		}
+		if len(md) != len(test.expected) {
+			t.Errorf("expected %d datapoints, got %d: %v", len(test.expected), len(md), md)
+		}
+	}
+
+	q, err := newSQLCollector(conf.SQLQuery{
+		Driver: "sqlite3",
+		DSN:    dsn,
+		Query:  "SELECT region FROM orders",
+		Tags:   map[string]string{"status": "status"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.db.Close()
+	if _, err := q.collect(); err == nil || err.Error() != "missing columns: status" {
+		t.Errorf("expected missing columns error, got %v", err)
+	}
+}
+
+func TestSQLQueryConfErrors(t *testing.T) {
+	for _, c := range []conf.SQLQuery{
+		{Driver: "sqlite3"},
+		{Driver: "oracle", Query: "SELECT 1"},
+		{Driver: "sqlite3", Query: "SELECT 1", Interval: "1"},
+		{Driver: "sqlite3", Query: "SELECT 1", RateType: "histogram"},
+		{Driver: "sqlite3", Query: "SELECT 1 AS a", Values: map[string]string{"a": "a"}, Tags: map[string]string{"a": "a"}},
+	} {
+		if _, err := newSQLCollector(c); err == nil {
+			t.Errorf("expected error for %+v", c)
+		}
+	}
+}
@@ -0,0 +1,446 @@
+package collectors
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"bosun.org/cmd/scollector/conf"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+const (
+	descStatsdPackets     = "Number of packets (UDP) or lines (TCP) received by the StatsD listener."
+	descStatsdParseErrors = "Number of StatsD metric lines that could not be parsed."
+
+	// statsdGaugeIdleFlushes is the number of flushes without updates after
+	// which a gauge is no longer sent.
+	statsdGaugeIdleFlushes = 10
+
+	// statsdCounterExpiry is the default time without updates after which a
+	// counter is no longer sent. Sending counters for long keeps the rate of
+	// rarely updated counters from showing as resets.
+	statsdCounterExpiry = 24 * time.Hour
+)
+
+// StatsD adds a collector listening for StatsD and DogStatsD metrics.
+func StatsD(c conf.StatsD) error {
+	s, err := newStatsdServer(c)
+	if err != nil {
+		return err
+	}
+	collectors = append(collectors, &StreamCollector{
+		F:    s.run,
+		name: fmt.Sprintf("statsd-%s", s.name),
+	})
+	return nil
+}
+
+type statsdServer struct {
+	name        string
+	listener    string // name cleaned for use as a tag value
+	udp, tcp    string
+	interval    time.Duration
+	expiry      time.Duration // of counters
+	prefix      string
+	percentiles []float64
+	tags        opentsdb.TagSet
+	tagMap      map[string]string
+
+	sync.Mutex
+	counters map[string]*statsdCounter
+	gauges   map[string]*statsdGauge
+	samples  map[string]*statsdSamples
+	sets     map[string]*statsdSet
+}
+
+type statsdSeries struct {
+	metric string
+	tags   opentsdb.TagSet
+}
+
+type statsdCounter struct {
+	statsdSeries
+	value float64
+	idle  int // flushes since the last update
+}
+
+type statsdGauge struct {
+	statsdSeries
+	value float64
+	idle  int
+}
+
+type statsdSamples struct {
+	statsdSeries
+	timer  bool
+	count  float64
+	values []float64
+}
+
+type statsdSet struct {
+	statsdSeries
+	values map[string]bool
+}
+
+// statsdLine is a single parsed StatsD metric.
+type statsdLine struct {
+	metric string
+	value  string
+	typ    string
+	rate   float64
+	tags   opentsdb.TagSet
+}
+
+func newStatsdServer(c conf.StatsD) (*statsdServer, error) {
+	if c.UDP == "" && c.TCP == "" {
+		return nil, fmt.Errorf("statsd: one of UDP or TCP must be specified")
+	}
+	s := &statsdServer{
+		udp:         c.UDP,
+		tcp:         c.TCP,
+		expiry:      statsdCounterExpiry,
+		prefix:      c.Prefix,
+		percentiles: c.Percentiles,
+		tags:        c.Tags,
+		tagMap:      c.TagMap,
+		counters:    make(map[string]*statsdCounter),
+		gauges:      make(map[string]*statsdGauge),
+		samples:     make(map[string]*statsdSamples),
+		sets:        make(map[string]*statsdSet),
+	}
+	s.name = c.UDP
+	if s.name == "" {
+		s.name = c.TCP
+	}
+	s.listener = opentsdb.MustReplace(s.name, "_")
+	if c.FlushInterval != "" {
+		var err error
+		if s.interval, err = time.ParseDuration(c.FlushInterval); err != nil {
+			return nil, fmt.Errorf("statsd %s: invalid FlushInterval: %v", s.name, err)
+		}
+	}
+	if c.CounterExpiry != "" {
+		var err error
+		if s.expiry, err = time.ParseDuration(c.CounterExpiry); err != nil {
+			return nil, fmt.Errorf("statsd %s: invalid CounterExpiry: %v", s.name, err)
+		}
+	}
+	if len(s.percentiles) == 0 {
+		s.percentiles = []float64{90}
+	}
+	for _, p := range s.percentiles {
+		if p <= 0 || p > 100 {
+			return nil, fmt.Errorf("statsd %s: percentile %v must be in (0, 100]", s.name, p)
+		}
+	}
+	return s, nil
+}
+
+func (s *statsdServer) run() <-chan *opentsdb.MultiDataPoint {
+	ch := make(chan *opentsdb.MultiDataPoint, 1)
+	metadata.AddMetricMeta("scollector.statsd.packets", metadata.Counter, metadata.Packet, descStatsdPackets)
+	metadata.AddMetricMeta("scollector.statsd.parse_errors", metadata.Counter, metadata.Error, descStatsdParseErrors)
+	if s.udp != "" {
+		go s.listenUDP()
+	}
+	if s.tcp != "" {
+		go s.listenTCP()
+	}
+	go func() {
+		for {
+			time.Sleep(s.flushInterval())
+			if md := s.flush(); len(md) > 0 {
+				ch <- &md
+			}
+		}
+	}()
+	return ch
+}
+
+func (s *statsdServer) listenUDP() {
+	conn, err := net.ListenPacket("udp", s.udp)
+	if err != nil {
+		slog.Errorf("statsd: %v", err)
+		return
+	}
+	buf := make([]byte, 65535)
+	var delay time.Duration
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if delay = statsdBackoff(err, delay); delay == 0 {
+				slog.Errorf("statsd: %v", err)
+				return
+			}
+			slog.Errorf("statsd: %v; retrying in %v", err, delay)
+			time.Sleep(delay)
+			continue
+		}
+		delay = 0
+		s.count("packets", "udp")
+		for _, line := range strings.Split(string(buf[:n]), "\n") {
+			s.handle(line, "udp")
+		}
+	}
+}
+
+func (s *statsdServer) listenTCP() {
+	l, err := net.Listen("tcp", s.tcp)
+	if err != nil {
+		slog.Errorf("statsd: %v", err)
+		return
+	}
+	var delay time.Duration
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if delay = statsdBackoff(err, delay); delay == 0 {
+				slog.Errorf("statsd: %v", err)
+				return
+			}
+			slog.Errorf("statsd: %v; retrying in %v", err, delay)
+			time.Sleep(delay)
+			continue
+		}
+		delay = 0
+		go func(conn net.Conn) {
+			defer conn.Close()
+			scanner := bufio.NewScanner(conn)
+			for scanner.Scan() {
+				s.count("packets", "tcp")
+				s.handle(scanner.Text(), "tcp")
+			}
+		}(conn)
+	}
+}
+
+// statsdBackoff returns how long to wait after the read or accept error err,
+// doubling the previous delay up to a second like net/http does. It returns
+// 0 for errors that are not temporary, after which the listener stops.
+func statsdBackoff(err error, delay time.Duration) time.Duration {
+	if ne, ok := err.(net.Error); !ok || !ne.Temporary() {
+		return 0
+	}
+	if delay == 0 {
+		return 5 * time.Millisecond
+	}
+	if delay *= 2; delay > time.Second {
+		delay = time.Second
+	}
+	return delay
+}
+
+// count increments one of the listener's self metrics.
+func (s *statsdServer) count(metric, proto string) {
+	if collect.DisableDefaultCollectors {
+		return
+	}
+	collect.Add("statsd."+metric, opentsdb.TagSet{"listener": s.listener, "proto": proto}, 1)
+}
+
+// handle parses and aggregates a single line. DogStatsD events and service
+// checks are ignored.
+func (s *statsdServer) handle(line, proto string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "_e{") || strings.HasPrefix(line, "_sc|") {
+		return
+	}
+	l, err := s.parse(line)
+	if err != nil {
+		s.count("parse_errors", proto)
+		if collect.Debug {
+			slog.Infof("statsd: %v", err)
+		}
+		return
+	}
+	if err := s.aggregate(l); err != nil {
+		s.count("parse_errors", proto)
+		if collect.Debug {
+			slog.Infof("statsd: %v", err)
+		}
+	}
+}
+
+// parse parses a line in the format
+// metric.name:value|type[|@sample_rate][|#tag1:value1,tag2:value2].
+func (s *statsdServer) parse(line string) (*statsdLine, error) {
+	i := strings.LastIndex(line[:strings.IndexByte(line+"|", '|')], ":")
+	if i < 1 {
+		return nil, fmt.Errorf("missing value in %q", line)
+	}
+	fields := strings.Split(line[i+1:], "|")
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("missing type in %q", line)
+	}
+	metric := opentsdb.MustReplace(line[:i], "_")
+	if metric == "" {
+		return nil, fmt.Errorf("invalid metric name in %q", line)
+	}
+	if s.prefix != "" {
+		metric = s.prefix + "." + metric
+	}
+	l := &statsdLine{
+		metric: metric,
+		value:  fields[0],
+		typ:    fields[1],
+		rate:   1,
+		tags:   s.tags.Copy(),
+	}
+	for _, f := range fields[2:] {
+		switch {
+		case strings.HasPrefix(f, "@"):
+			r, err := strconv.ParseFloat(f[1:], 64)
+			if err != nil || r <= 0 || r > 1 {
+				return nil, fmt.Errorf("invalid sample rate in %q", line)
+			}
+			l.rate = r
+		case strings.HasPrefix(f, "#"):
+			for _, t := range strings.Split(f[1:], ",") {
+				s.addTag(l.tags, t)
+			}
+		}
+	}
+	return l, nil
+}
+
+// addTag maps a DogStatsD tag through TagMap and adds it to tags. Tags
+// without a value get the value "true".
+func (s *statsdServer) addTag(tags opentsdb.TagSet, t string) {
+	k, v := t, "true"
+	if i := strings.IndexByte(t, ':'); i >= 0 {
+		k, v = t[:i], t[i+1:]
+	}
+	if m, ok := s.tagMap[k]; ok {
+		k = m
+	}
+	k = opentsdb.MustReplace(k, "_")
+	v = opentsdb.MustReplace(v, "_")
+	if k == "" || v == "" {
+		return
+	}
+	tags[k] = v
+}
+
+func (s *statsdServer) aggregate(l *statsdLine) error {
+	series := statsdSeries{l.metric, l.tags}
+	key := l.metric + l.tags.String()
+	s.Lock()
+	defer s.Unlock()
+	if l.typ == "s" {
+		set := s.sets[key]
+		if set == nil {
+			set = &statsdSet{series, make(map[string]bool)}
+			s.sets[key] = set
+		}
+		set.values[l.value] = true
+		return nil
+	}
+	v, err := strconv.ParseFloat(l.value, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("invalid value %q for %s", l.value, l.metric)
+	}
+	switch l.typ {
+	case "c":
+		c := s.counters[key]
+		if c == nil {
+			c = &statsdCounter{statsdSeries: series}
+			s.counters[key] = c
+		}
+		c.value += v / l.rate
+		c.idle = 0
+	case "g":
+		g := s.gauges[key]
+		if g == nil {
+			g = &statsdGauge{statsdSeries: series}
+			s.gauges[key] = g
+		}
+		g.idle = 0
+		if strings.HasPrefix(l.value, "+") || strings.HasPrefix(l.value, "-") {
+			g.value += v
+			return nil
+		}
+		g.value = v
+	case "ms", "h", "d":
+		t := s.samples[key]
+		if t == nil {
+			t = &statsdSamples{statsdSeries: series, timer: l.typ == "ms"}
+			s.samples[key] = t
+		}
+		t.count += 1 / l.rate
+		t.values = append(t.values, v)
+	default:
+		return fmt.Errorf("unknown type %q for %s", l.typ, l.metric)
+	}
+	return nil
+}
+
+func (s *statsdServer) flushInterval() time.Duration {
+	if s.interval == 0 {
+		return DefaultFreq
+	}
+	return s.interval
+}
+
+// flush returns the aggregated datapoints for the interval. Counters are
+// cumulative and gauges keep their last value; timers, histograms and sets
+// are reset. Counters are dropped after flushes without updates for the
+// counter expiry, and gauges after statsdGaugeIdleFlushes of them, so that
+// series which are no longer sent don't accumulate.
+func (s *statsdServer) flush() opentsdb.MultiDataPoint {
+	var md opentsdb.MultiDataPoint
+	s.Lock()
+	defer s.Unlock()
+	counterIdleFlushes := int(s.expiry / s.flushInterval())
+	for key, c := range s.counters {
+		Add(&md, c.metric, c.value, c.tags, metadata.Counter, metadata.Count, "")
+		if c.idle++; c.idle > counterIdleFlushes {
+			delete(s.counters, key)
+		}
+	}
+	for key, g := range s.gauges {
+		Add(&md, g.metric, g.value, g.tags, metadata.Gauge, metadata.None, "")
+		if g.idle++; g.idle > statsdGaugeIdleFlushes {
+			delete(s.gauges, key)
+		}
+	}
+	for key, set := range s.sets {
+		Add(&md, set.metric, len(set.values), set.tags, metadata.Gauge, metadata.Item, "")
+		delete(s.sets, key)
+	}
+	for key, t := range s.samples {
+		unit := metadata.None
+		if t.timer {
+			unit = metadata.MilliSecond
+		}
+		sort.Float64s(t.values)
+		var sum float64
+		for _, v := range t.values {
+			sum += v
+		}
+		n := len(t.values)
+		Add(&md, t.metric+".count", t.count, t.tags, metadata.Gauge, metadata.Count, "")
+		Add(&md, t.metric+".min", t.values[0], t.tags, metadata.Gauge, unit, "")
+		Add(&md, t.metric+".max", t.values[n-1], t.tags, metadata.Gauge, unit, "")
+		Add(&md, t.metric+".mean", sum/float64(n), t.tags, metadata.Gauge, unit, "")
+		for _, p := range s.percentiles {
+			i := int(math.Ceil(p/100*float64(n))) - 1
+			if i < 0 {
+				i = 0
+			}
+			name := "p" + strings.Replace(strconv.FormatFloat(p, 'f', -1, 64), ".", "_", -1)
+			Add(&md, t.metric+"."+name, t.values[i], t.tags, metadata.Gauge, unit, "")
+		}
+		delete(s.samples, key)
+	}
+	return md
+}
@@ -0,0 +1,128 @@
+package collectors
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/cmd/scollector/conf"
+	"bosun.org/host"
+	"bosun.org/opentsdb"
+	"bosun.org/util"
+)
+
+func TestStatsdAggregate(t *testing.T) {
+	hm, err := host.NewManager(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	util.SetHostManager(hm)
+	s, err := newStatsdServer(conf.StatsD{
+		UDP:         ":8125",
+		Percentiles: []float64{50, 99.9},
+		Tags:        opentsdb.TagSet{"host": "app01"},
+		TagMap:      map[string]string{"env": "environment", "version": ""},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"page.views:1|c",
+		"page.views:2|c|@0.5",
+		"queue.size:10|g",
+		"queue.size:-3|g",
+		"req.time:20|ms|#env:prod,version:1.2",
+		"req.time:10|ms|#env:prod",
+		"req.time:30|ms|#env:prod",
+		"users:alice|s",
+		"users:bob|s",
+		"users:alice|s",
+		"_e{5,4}:title|text",
+		"bad.line|c",
+		"bad.value:abc|c",
+		"bad.type:1|x",
+	} {
+		s.handle(line, "udp")
+	}
+	md := s.flush()
+	expected := opentsdb.MultiDataPoint{
+		{Metric: "page.views", Value: float64(5), Tags: opentsdb.TagSet{"host": "app01"}},
+		{Metric: "queue.size", Value: float64(7), Tags: opentsdb.TagSet{"host": "app01"}},
+		{Metric: "req.time.count", Value: float64(3), Tags: opentsdb.TagSet{"host": "app01", "environment": "prod"}},
+		{Metric: "req.time.min", Value: float64(10), Tags: opentsdb.TagSet{"host": "app01", "environment": "prod"}},
+		{Metric: "req.time.max", Value: float64(30), Tags: opentsdb.TagSet{"host": "app01", "environment": "prod"}},
+		{Metric: "req.time.mean", Value: float64(20), Tags: opentsdb.TagSet{"host": "app01", "environment": "prod"}},
+		{Metric: "req.time.p50", Value: float64(20), Tags: opentsdb.TagSet{"host": "app01", "environment": "prod"}},
+		{Metric: "req.time.p99_9", Value: float64(30), Tags: opentsdb.TagSet{"host": "app01", "environment": "prod"}},
+		{Metric: "users", Value: 2, Tags: opentsdb.TagSet{"host": "app01"}},
+	}
+	for _, e := range expected {
+		if !mdContains(t, md, e) {
+			t.Errorf("md must contain %v", e)
+		}
+	}
+	if len(md) != len(expected) {
+		t.Errorf("expected %d datapoints, got %d: %v", len(expected), len(md), md)
+	}
+
+	// Counters and gauges persist, everything else is reset.
+	md = s.flush()
+	if len(md) != 2 {
+		t.Errorf("expected 2 datapoints after second flush, got %d: %v", len(md), md)
+	}
+
+	// The gauge is dropped after statsdGaugeIdleFlushes, while the counter is
+	// sent until it expires, so its rate doesn't show resets.
+	for i := 2; i <= statsdGaugeIdleFlushes; i++ {
+		s.flush()
+	}
+	md = s.flush()
+	if len(md) != 1 || md[0].Metric != "page.views" || md[0].Value != float64(5) {
+		t.Errorf("expected only the counter after idle flushes, got %v", md)
+	}
+}
+
+func TestStatsdCounterExpiry(t *testing.T) {
+	s, err := newStatsdServer(conf.StatsD{
+		UDP:           ":8125",
+		FlushInterval: "10s",
+		CounterExpiry: "30s",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.handle("page.views:2|c", "udp")
+	for i := 0; i < 4; i++ {
+		if md := s.flush(); len(md) != 1 || md[0].Value != float64(2) {
+			t.Fatalf("flush %d: expected the counter, got %v", i, md)
+		}
+	}
+	if md := s.flush(); len(md) != 0 {
+		t.Errorf("expected no datapoints after the counter expired, got %v", md)
+	}
+	s.handle("page.views:1|c", "udp")
+	if md := s.flush(); len(md) != 1 || md[0].Value != float64(1) {
+		t.Errorf("expected a restarted counter, got %v", md)
+	}
+	if _, err := newStatsdServer(conf.StatsD{UDP: ":8125", CounterExpiry: "1d"}); err == nil {
+		t.Error("expected error for an invalid CounterExpiry")
+	}
+}
+
+type statsdTestError struct{ temporary bool }
+
+func (e statsdTestError) Error() string   { return "test error" }
+func (e statsdTestError) Timeout() bool   { return false }
+func (e statsdTestError) Temporary() bool { return e.temporary }
+
+func TestStatsdBackoff(t *testing.T) {
+	var delay time.Duration
+	for i := 0; i < 20; i++ {
+		delay = statsdBackoff(statsdTestError{true}, delay)
+	}
+	if delay != time.Second {
+		t.Errorf("expected backoff capped at 1s, got %v", delay)
+	}
+	if delay = statsdBackoff(statsdTestError{false}, delay); delay != 0 {
+		t.Errorf("expected no retry after a permanent error, got %v", delay)
+	}
+}
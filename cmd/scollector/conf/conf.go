@@ -60,6 +60,9 @@ type Conf struct {
 	// SNMPTimeout is the number of seconds to wait for SNMP responses (default 30)
 	SNMPTimeout int
 
+	// MIBDirs are directories of MIB files to resolve symbolic SNMP names.
+	MIBDirs []string
+
 	// UseSWbemServicesClient specifies if the wmi package should use SWbemServices.
 	UseSWbemServicesClient bool
 
@@ -99,6 +102,10 @@ type Conf struct {
 	HbaseRegions           bool
 	Oracles                []Oracle
 	Fastly                 []Fastly
+	Prometheus             []Prometheus
+	StatsD                 []StatsD
+	SNMPTraps              []SNMPTraps
+	SQLQuery               []SQLQuery
 }
 
 type HAProxy struct {
@@ -180,6 +187,14 @@ type SNMP struct {
 	Community string
 	Host      string
 	MIBs      []string
+
+	// SNMPv3 is used instead of Community if User is set.
+	User           string
+	AuthProtocol   string // MD5, SHA, SHA224, SHA256, SHA384 or SHA512
+	AuthPassphrase string
+	PrivProtocol   string // DES or AES
+	PrivPassphrase string
+	Context        string
 }
 
 type MIB struct {
@@ -202,6 +217,9 @@ type MIBMetric struct {
 type MIBTag struct {
 	Key string
 	Oid string // If present will load from this oid. Use "idx" to populate with index of row instead of another oid.
+	// Enum uses the names of the enumerated values of Oid, like "up" for
+	// ifOperStatus 1, instead of the numbers.
+	Enum bool
 }
 
 type MIBTree struct {
@@ -210,6 +228,80 @@ type MIBTree struct {
 	Metrics []MIBMetric
 }
 
+// Prometheus is a target exposing metrics in the Prometheus text exposition
+// format that will be scraped and converted to OpenTSDB datapoints.
+type Prometheus struct {
+	URL      string          // the metrics endpoint, e.g. http://localhost:9100/metrics
+	Name     string          // used in the collector name, default is the host of URL
+	Interval string          // scrape interval, default is DefaultFreq
+	Prefix   string          // prepended to every metric name with a "."
+	Tags     opentsdb.TagSet // added to every datapoint, set host to override the host tag
+	Include  []string        // if not empty, only metric families matching one of these regexps are sent
+	Exclude  []string        // metric families matching one of these regexps are not sent
+	Relabel  []PrometheusRelabel
+}
+
+// PrometheusRelabel is a relabeling rule applied to the labels of every
+// sample in order. The metric name is available as the __name__ label.
+type PrometheusRelabel struct {
+	SourceLabels []string
+	Separator    string // default is ";"
+	Regex        string // default is "(.*)", always anchored
+	TargetLabel  string
+	Replacement  string // default is "$1"
+	Action       string // replace (default), keep, drop, labeldrop or labelkeep
+}
+
+// StatsD is a listener for the StatsD line protocol, including the DogStatsD
+// tag extension.
+type StatsD struct {
+	UDP           string            // UDP listen address, e.g. ":8125"
+	TCP           string            // TCP listen address, newline delimited
+	FlushInterval string            // aggregation interval, default is DefaultFreq
+	CounterExpiry string            // time without updates after which a counter is no longer sent, default is 24h
+	Prefix        string            // prepended to every metric name with a "."
+	Percentiles   []float64         // percentiles sent for timers and histograms, default is 90
+	Tags          opentsdb.TagSet   // added to every datapoint
+	TagMap        map[string]string // renames DogStatsD tag keys, an empty value drops the tag
+}
+
+// SNMPTraps is a listener for SNMPv1 and SNMPv2c traps and inform requests.
+type SNMPTraps struct {
+	Listen      string   // UDP listen address, default ":162"
+	Communities []string // accepted communities, any if empty
+	// AnnotateURL is the API root of the bosun or annotate server
+	// annotations are sent to, e.g. http://bosun/api.
+	AnnotateURL string
+	Traps       []SNMPTrap
+}
+
+// SNMPTrap maps a received trap to metrics and annotations.
+type SNMPTrap struct {
+	OID      string            // trap OID or name, e.g. "IF-MIB::linkDown"
+	Metric   string            // counter of the trap, default is snmp.traps
+	Tags     map[string]string // tag keys to the object whose variable is the tag value
+	Values   map[string]string // gauge metrics to the object whose variable is the value
+	Annotate bool              // send an annotation to bosun for each trap
+}
+
+// SQLQuery is a SQL query run every Interval whose result rows are sent as
+// datapoints, one per row and value column.
+type SQLQuery struct {
+	Name        string            // used in the collector name, default is the driver
+	Driver      string            // postgres, mysql or sqlite3
+	DSN         string            // data source name in the format of the driver
+	Interval    string            // query interval, default is DefaultFreq
+	Timeout     string            // query timeout, default is Interval
+	Query       string            // SELECT statement, queried with no arguments
+	Prefix      string            // prepended to every metric name with a "."
+	Values      map[string]string // value columns to metric names, default is every column not in Tags
+	Tags        map[string]string // tag keys to the column holding the tag value
+	StaticTags  opentsdb.TagSet   // added to every datapoint
+	RateType    string            // gauge (default), counter or rate
+	Unit        string            // metadata unit of the metrics
+	Description string            // metadata description of the metrics
+}
+
 type ProcessDotNet struct {
 	Name string
 }
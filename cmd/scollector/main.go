@@ -27,6 +27,7 @@ import (
 	"bosun.org/opentsdb"
 	"bosun.org/slog"
 	"bosun.org/snmp"
+	"bosun.org/snmp/mib"
 	"bosun.org/util"
 	"github.com/BurntSushi/toml"
 	"github.com/facebookgo/httpcontrol"
@@ -45,6 +46,8 @@ var (
 	flagConf            = flag.String("conf", "", "Location of configuration file. Defaults to scollector.toml in directory of the scollector executable.")
 	flagToToml          = flag.String("totoml", "", "Location of destination toml file to convert. Reads from value of -conf.")
 	flagNtlm            = flag.Bool("useNtlm", false, "Specifies to use NTLM authentication.")
+	flagLogLevel        = flag.String("loglevel", "", "Minimum level of logged messages: debug, info, warning or error. Defaults to debug with -d, else info.")
+	flagLogJSON         = flag.Bool("logjson", false, "Log JSON objects, one per line, instead of text.")
 
 	mains []func()
 )
@@ -71,6 +74,23 @@ func main() {
 	if *flagPrint || *flagDebug {
 		slog.Set(&slog.StdLog{Log: log.New(os.Stdout, "", log.LstdFlags)})
 	}
+	if *flagLogJSON {
+		w := os.Stderr
+		if *flagPrint || *flagDebug {
+			w = os.Stdout
+		}
+		slog.SetJSON(w)
+	}
+	if *flagLogLevel == "" && *flagDebug {
+		*flagLogLevel = "debug"
+	}
+	if *flagLogLevel != "" {
+		level, err := slog.ParseLevel(*flagLogLevel)
+		if err != nil {
+			slog.Fatal(err)
+		}
+		slog.SetLevel(level)
+	}
 	if *flagVersion {
 		fmt.Println(version.GetVersionInfo("scollector"))
 		os.Exit(0)
@@ -127,6 +147,9 @@ func main() {
 	if conf.SNMPTimeout > 0 {
 		snmp.Timeout = conf.SNMPTimeout
 	}
+	for _, dir := range conf.MIBDirs {
+		mib.Load(dir)
+	}
 	if conf.UseSWbemServicesClient {
 		conf.InitializeSWbemServices()
 	}
@@ -186,6 +209,18 @@ func main() {
 	for _, r := range conf.Riak {
 		check(collectors.Riak(r.URL))
 	}
+	for _, p := range conf.Prometheus {
+		check(collectors.Prometheus(p))
+	}
+	for _, s := range conf.StatsD {
+		check(collectors.StatsD(s))
+	}
+	for _, t := range conf.SNMPTraps {
+		check(collectors.SNMPTraps(t))
+	}
+	for _, q := range conf.SQLQuery {
+		check(collectors.SQLQuery(q))
+	}
 
 	for _, x := range conf.ExtraHop {
 		check(collectors.ExtraHop(x.Host, x.APIKey, x.FilterBy, x.FilterPercent, x.AdditionalMetrics, x.CertificateSubjectMatch, x.CertificateActivityGroup))
@@ -6,18 +6,28 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"strings"
 
 	"bosun.org/cmd/scollector/collectors"
 	"bosun.org/cmd/scollector/conf"
+	"bosun.org/snmp/mib"
 	"github.com/BurntSushi/toml"
 )
 
-var devMode = flag.Bool("dev", false, "Dev mode. Use html from file-system instead of embedded copy.")
+var (
+	devMode = flag.Bool("dev", false, "Dev mode. Use html from file-system instead of embedded copy.")
+	mibDirs = flag.String("mibdirs", "", "Comma separated directories of MIB files to resolve symbolic oids.")
+)
 
 // to embed static again: go:generate esc -modtime 0 -o=static.go -prefix=static static
 
 func main() {
 	flag.Parse()
+	if *mibDirs != "" {
+		for _, dir := range strings.Split(*mibDirs, ",") {
+			mib.Load(dir)
+		}
+	}
 	fs := FS(*devMode)
 	http.Handle("/", http.FileServer(fs))
 	http.HandleFunc("/test", TestMib)
@@ -192,37 +192,38 @@ var _escData = map[string]*_escFile{
 
 	"/index.html": {
 		local:   "static/index.html",
-		size:    5487,
+		size:    5880,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/7RX32/bOBJ+pv4KhigqCatKyLXXB0fWYbt7i+vtpgE22acgOFASI7OlSB1JOw2y+d8P
-/CFZsmzH2+JeEkv8Ps5wZvjNKD+rRaUfOwJXumVFkJt/kDdvcNctkeJt92PXIfOe4LoIclVJ2mmoZLVE
-K627RZateU2kqoQkn1UqZDN6kX5WqMgzR9rDVossw5/x17QRomEEd1SllWjtu4zRUmWYN2uG5WeVnadv
-0/P3/Yu0pXz/7kUQbLCELS0VXMJ/X199SjssFYmYqDC71kLihqQN0R81aSNkcCiOLyyJYaUvaQmXcD/Y
-r6M4CAY/RL1mJAp9qMIE3t7FQQBhWgmupWCMyCi85m330/AcJvB+zStNBY9eqUp0JIGvTDwS+MoYNgsx
-fApAAPxR4HK7kiqCZbWK4rSlZQDoPYxaWsZPAXDo6amxFqVdjy8CAJy11O3YXgTg2Rih9wah7BYegRm7
-dBE0KwEwmLMR+/XrIVivX1vMrX++s9tMLf0nrZjgJJriAmDMm539qwlTi98Erk0q3KJD9/6OfNme3Np6
-upGEqMXtXXJJtKSV+fk8OzotAwgB8Bv6NUlasSGOBpfbHLUJHoxQXpOvcAlxan9d3UetjaxJg1sr4Jtz
-kz0IjQWcqo7RirjFBJ5b9PPUMK7ruVVnE6fdWq2iJ7e+gAgl8IrWC/P/D061e/M71uTmsSP29c/E3QQq
-uF39BTNW4uqLoVn0DW7UAqHneObFDW7GLmjrgk4NwfvxK3ncOgH3byIJGe8yrquWlqlNkN/uA1bEH8d6
-dXuXwFHe4n0J2nUSN4me5cc73SdJ4+Zomjz8hFx5H3bOOHdgduDBlaOOzGgnuKSJ0vOAG0FJO6F0FGYG
-ESYjn+LU1r9aVxVRKhqoNdY4gUpjvVYJNKJPpEpgJfg9bZyX2ysqiVozPcis0pLyht4/+l34mrEE/i22
-nGdvkUgp5F+yN4rK1qDh+X2dig2q0bIXQiFa9v8MxcizbzsxAKASXAlGUiYah/fwXfRfjY7Cm/nNNEXL
-pwX7CbekL9Iz7mQZMyJ1hDhuCZTkv2sqSY2MhgNJ9FryQc3hIM6+icRPs6by9HxxAHzL7+Cff7oDyjZC
-VxsiHyTVBCL4A+TwB4j+geJJpxgxt61mlF9zEjBp6GrS/ZPd8t1xPz7M7weCBPJ4X+/i29vqlzh5OKKN
-h/qX7d27A0DY0jJ01yy+mCoCc+anZvZFe+Kwb93j6kOXHz/AT1c38JerPz79jIZ74wi2hROmyOGWf9ze
-HjFTKyznRVq+fweXsNQCR/uTZUecI1Eq37/rgwShuRajwTHzw20p6kcz+24ntyWaTm52En5bXH78kGer
-t0VgLsoC5pR3aw3NGL0MNfmqQ7NLK2rClqi/T6jIS5kVwb+E0idQDKyn/CTads2pfjyBN2B7sumwrlm/
-yPXNuGfmK/f3bXFN246RvjG7o+drBpV+ZGQZVoxguSiFXoVFkDNqtpWkI1gvUQspN+NW6smoJ90zgfWC
-kXt9ERZ5TTdFAPoh57infitUQOcnOOF0qT1XT3CD0wsMA+pDAcyABe2E9RKtH8UG6mQce4E8wg78yfj2
-An+EHfh2rnqJaEADIy/XWgturwKj1ZclGk/GUZuM8hmj4ne7mGeOZe6TyWaeMWp+r81XZU03+4rFIYO5
-vWEgjqamfqzrkZ2hPqHTTOgr01gblaDuS9CiUBGAE++E3r0RIF+9K2w882z1zjyb0wGwU/MaN8ak9lE1
-2mjH5uPGcJP+Sh5RAQ3+BOdw44rawGcRDIdR2c/HYSF30gSASxFwOdqTdfdBEGkXeKhxM2KbUPQdahuN
-vZIwC1DrwnOKJIBvFYVTYrgjC+AbhAF8jzSA7xYH8N3yAL5BIPYU3K5E6CMCYSpvKxHguzVCTxXC96nB
-3iFfjRzY4nb+WRHZVbH9di3TG9thOU2aXUfzARbFYXHzz+ubs7MRfo4ULbPIq8vfjuHsnBTFoY8ZcpdH
-0malUWEXj7Lx5jAZb45yOXk4ROXkYcsMckUYqfQe5AVssWwof2OfFud/775eIGNCrcTDEvlpEY3lzk6M
-Ru1yYa/AWE+iL8kmNprSE4unpy/Pz3nmoCaZzpV9xzGz8oHzzD0qDHoUnE4SAyopr01Z2Q8wZI6edZLY
-/2aotDOmblnxvwAAAP//ExdHwG8VAAA=
+H4sIAAAAAAAC/7RY3W7bOBq9pp6CIQa1hFFlZNPthS1r0c7sYLszSYpJehUEC0piZLYUqSUpJ4En774g
+KcmSpThOi72JbfEcfr88/JT4JBeZfqwIXOuSJV5sPiAv3uKqWiHFy+pDVSHznOA88WKVSVppqGS2Qmut
+q8V8XvOcSJUJSb6qSMii9yD6qlASzx1pgq0W8zn+ih+iQoiCEVxRFWWitM/mjKZqjnlRMyy/qvlpdBad
+vm8fRCXl07snnrfBEpY0VXAF/311eRFVWCriM5FhdqWFxAWJCqI/aVL6yOBQECwtiWGlz2kKV3Aa3Kyj
+wPM6P0ReM+LPmlTNQnhzG3gehFEmuJaCMSL92RUvq1+637MQ3tU801Rw/yeViYqE8CeTjxD+ZAybhQBu
+PeCBJhS42q1EimCZrf0gKmnqAXoH/ZKmwdYDDj2MGmuR2vVg6QHgrEVux3LpgSdjhN4ZhAq2OwRm7Nxl
+0Kx4wGBOeuw3b7pkvXljMTfN71u7zdDSf6KMCU78Ic4D4Mnt3DwaMLX4Q+DclMItOnTrb8+XXeTW1vZa
+EqIWN7fhOdGSZubr0yh0mnoQAtBs2KxJUooNcTS42tWoDHFnhPKcPMAVxJH9dnnnlzazpgxuLYFvT031
+IDQWcKQqRjPiFkN4Gix7kbTZzvOxVWcTR1Wt1v7WrS8gQiG8pPnCfH7hVLsnf2JNrh8rYh//StxJoILb
+1d8wYynOvhmaRV/jQi0QegpGXlzjou+Cti7oyBAaP34njzsn4PQmkpD+Lv2+Kmka2QI1233EijThWK9u
+bkPYq1swVaB9J3ER6lF9GqfbImlcHCxTAz+iVo0PezGOHRgF3Lly0JER7QiXNFF6nHAjKFEllPZnc4OY
+hT2fgsj2v6qzjCjld9QcaxxCpbGuVQiN6BOpQpgJfkcL5+XuiEqiaqY7mVVaUl7Qu8dmF14zFsK/BZbz
+1FgkUgr5KnsQThg0vGZfp2KdapTshVSIkv0/U9Hz7PsiBgBkgivBSMRE4fANfB/92uwovBmfTNO0fNiw
+F7gkbZOecIsCmBGpfcRxSaAk/62pJDkyGg4k0bXknZpD/2R4iQTb0aWyfVo+A77ht/Cvv1yAsvTR5YbI
+e0k1gQj+DDn8GaJ/oGBwU/SYu6umV18TCRhc6Gpw+4f77bvnfvA8vx0IQsiDqbuL705rs8TJ/QFtfO7+
+snf3/gAwK2k6c8csWA4VgTnzQzNT2R44fBuMug+df/oILy6v4W+XXy5+RSEcEOwVTpgiz1/5h+1NiJla
+Yzlu0vT9O7iCqRbYny6WHXEOZCl9/65NEoTmWPQGx3kz3KYifzSz725yW6Hh5GYn4bPk/NPHeL4+Szxz
+UBYwpryqNTRj9GqmyYOemV1KkRO2Qu15Qkmcynni/UsofQTFwFrKL6Isa0714xG8DtuSry7OP2/O4BdF
+5BF0A2uZH2q9hp+l0CIT7AiuwbdwlEBHx0pVa4nVfpoqrNS9kPnkJh2pdeWzpJvXuGLwPVcc/bWu2E1G
+rphmIA/6qFJYZEs0o46bml5kNlNRy4zX7u9ZckXLipF2QnI9GNcMKv3IyGqWMYLlIhV6PUu8mFGzrSQV
+wXqFSki5mXujhoxa0h0TWC8YudPLWRLndJN4oJ02D3vabIUS6PwER0QX2bhagptgX2AYUJsKYCZdaEfd
+l2jtTNxRB3PxC+QetuMP5ugX+D1sx7cD7ktEA+oYcVprLbgBZIxm31ao/4ril2GvngFK/rSL8dyxjLCZ
+asZzRs332rze53Qz1SwO6Y3tdW8m/tDUhzzv2en6E7rLCzadaaz1WlC3LWhRKPHAkWdC758IEK/fJTaf
+8Xz9zvw20QGw1/MaF8akbrJqLin7/nLYGC6i38kjSqDBH+EcLlxTG/gog7PunaV5UZklcq9MALgSAVcj
+MFkFu4FLPNS46LFNKtpRYZeNSUkAYEoU9FGSAL5XFMDrZQF8hzCAH5EG8MPiAH5YHsB3CAR4WSL0AYEw
+nbeTCPDDGqGHCtHcU52953w1cuDrzj8rIvsqNm3XMhtjeyynSaPjqInSfjBLrv95dX1y0sOPkaJkFnl5
+/schnB1Y/WDW5Ay5wyNpsdYosYsH2XjzPBlvDnI5uX+Oysn9junFijCS6QnkEpZYFpS/tb8Wp3+vHpbI
+mFBrcb9CzdiO+nJnR3ejdrGwR6CvJ/63cBMYTWmJyXb77ekpnjuoKaZzZSoc89LyTDxjjxKD7iWnksSA
+UsrzFWrehJEJfV5JYj/NdG+HffOP7v8NAPtIUOP4FgAA
 `,
 	},
 
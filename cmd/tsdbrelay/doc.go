@@ -17,6 +17,33 @@ To enable this, supply a redis server with the `-redis` flag, and send counter d
 
 tsdbrelay can "denormalize"" metrics in order to decrease metric cardinality for better query performance on metrics with a lot of tags. For example `-denormalize=os.cpu__host` will create an additional data point for `os.cpu{host=web01}` into `__web01.os.cpu{host=web01}` as well.
 
+tsdbrelay accepts OpenTelemetry OTLP/HTTP metrics exports (protobuf or JSON, optionally gzipped) on /v1/metrics. Gauges, sums and explicit bucket histograms are converted to OpenTSDB data points and then relayed exactly like a put to /api/put, including to bosun, secondary relays and denormalization. Resource attributes in the -otlpattributes list (default service.name and host.name), then scope attributes in the list, then data point attributes become tags, with invalid characters replaced by "_"; the other resource and scope attributes, like process.pid, are dropped; the host.name resource attribute is used as the host tag if no host attribute is present. Histograms become name.bucket (cumulative, with an le tag), name.count and name.sum. Metric descriptions and units are sent as bosun metadata.
+
+tsdbrelay can rewrite and filter data points before relaying them with an ordered list of processors read from the TOML file given by `-rules`. Each processor may be restricted to data points matching a Metric regular expression and Tags (tag key to value regular expression), and has one of the types drop, rename_metric, add_tag, drop_tag, rename_tag, map_tag, cardinality or sample. The tsdbrelay.rules.matched and tsdbrelay.rules.dropped counters are sent with a rule tag for each processor. Puts relayed from another tsdbrelay were already processed by it and are relayed unchanged. See https://godoc.org/bosun.org/cmd/tsdbrelay/processor for the fields of each type. For example:
+
+	[[Processor]]
+	  Name = "drop-dev"
+	  Type = "drop"
+	  [Processor.Tags]
+	    env = "^dev$"
+
+	[[Processor]]
+	  Type = "rename_metric"
+	  Metric = "^legacy\\.(.*)"
+	  Replacement = "app.$1"
+
+	[[Processor]]
+	  Type = "map_tag"
+	  Tag = "dc"
+	  [Processor.Map]
+	    ny-1 = "ny"
+
+	[[Processor]]
+	  Name = "cap-request-id"
+	  Type = "cardinality"
+	  Metric = "^http\\."
+	  Limit = 5000
+
 Usage:
 	tsdbrelay [-l listen-address] [-b bosun-server] -t tsdb-server
 
@@ -28,7 +55,11 @@ The flags are:
 	-l=":4242"
 		Listen address.
 	-v=false
-	    Enable verbose logging
+	    Enable verbose logging; same as -loglevel debug
+	-loglevel="info"
+		Minimum level of logged messages: debug, info, warning or error.
+	-logjson=false
+		Log JSON objects, one per line, to stderr instead of text.
 	-r=""
 		Additional relays to send data to, comma seperated. Intended for secondary data center replication. Only response from primary tsdb server wil be relayed to clients.
 		Examples: hostA:port,https://hostB:port,hostC#data-only,https://hostD:8080#bosun-index,https://hostE:8080#metadata-only
@@ -38,6 +69,10 @@ The flags are:
 		Redis database number to use
 	-denormalize=""
 		List of metrics to denormalize. Comma seperated list of `metric__tagname__tagname` rules. Will be translated to `__tagvalue.tagvalue.metric`
+	-rules=""
+		TOML file of processors to rewrite and filter data points before relaying.
+	-otlpattributes="service.name,host.name"
+		Comma separated list of the OTLP resource and scope attributes converted to tags. The others are dropped.
 
 */
 package main
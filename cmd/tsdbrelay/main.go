@@ -22,6 +22,8 @@ import (
 	version "bosun.org/_version"
 
 	"bosun.org/cmd/tsdbrelay/denormalize"
+	"bosun.org/cmd/tsdbrelay/otlp"
+	"bosun.org/cmd/tsdbrelay/processor"
 	"bosun.org/collect"
 	"bosun.org/metadata"
 	"bosun.org/opentsdb"
@@ -34,10 +36,14 @@ var (
 	bosunServer      = flag.String("b", "bosun", "Target Bosun server. Can specify port with host:port.")
 	secondaryRelays  = flag.String("r", "", "Additional relays to send data to. Intended for secondary data center replication. Only response from primary tsdb server wil be relayed to clients.")
 	tsdbServer       = flag.String("t", "", "Target OpenTSDB server. Can specify port with host:port.")
-	logVerbose       = flag.Bool("v", false, "enable verbose logging")
+	logVerbose       = flag.Bool("v", false, "enable verbose logging; same as -loglevel debug")
+	logLevel         = flag.String("loglevel", "info", "minimum level of logged messages: debug, info, warning or error")
+	logJSON          = flag.Bool("logjson", false, "log JSON objects, one per line, to stderr instead of text")
 	hostnameOverride = flag.String("hostname", "", "Override the own hostname. Especially useful when running in a container.")
 	useFullHostname  = flag.Bool("useFullHostname", false, "Whether to use the fully qualified hostname")
 	toDenormalize    = flag.String("denormalize", "", "List of metrics to denormalize. Comma seperated list of `metric__tagname__tagname` rules. Will be translated to `__tagvalue.tagvalue.metric`")
+	rulesFile        = flag.String("rules", "", "TOML file of processors to rewrite and filter data points before relaying.")
+	otlpAttributes   = flag.String("otlpattributes", strings.Join(otlp.DefaultAttributes, ","), "Comma separated list of the OTLP resource and scope attributes converted to tags. The others are dropped.")
 	flagVersion      = flag.Bool("version", false, "Prints the version and exits.")
 
 	redisHost = flag.String("redis", "", "redis host for aggregating external counters")
@@ -50,6 +56,8 @@ var (
 
 	denormalizationRules map[string]*denormalize.DenormalizationRule
 
+	pipeline *processor.Pipeline
+
 	relayDataUrls     []string
 	relayMetadataUrls []string
 
@@ -93,6 +101,17 @@ func main() {
 		fmt.Println(version.GetVersionInfo("tsdbrelay"))
 		os.Exit(0)
 	}
+	if *logJSON {
+		slog.SetJSON(os.Stderr)
+	}
+	if *logVerbose {
+		*logLevel = "debug"
+	}
+	level, err := slog.ParseLevel(*logLevel)
+	if err != nil {
+		slog.Fatal(err)
+	}
+	slog.SetLevel(level)
 	if *bosunServer == "" || *tsdbServer == "" {
 		slog.Fatal("must specify both bosun and tsdb server")
 	}
@@ -108,6 +127,15 @@ func main() {
 		}
 	}
 
+	if *rulesFile != "" {
+		var err error
+		pipeline, err = processor.Load(*rulesFile)
+		if err != nil {
+			slog.Fatal(err)
+		}
+		slog.Infoln("processing data points with rules from", *rulesFile)
+	}
+
 	util.InitHostManager(*hostnameOverride, *useFullHostname)
 
 	tsdbURL, err := parseHost(*tsdbServer, "", true)
@@ -161,6 +189,9 @@ func main() {
 	http.HandleFunc("/api/metadata/put", func(w http.ResponseWriter, r *http.Request) {
 		rp.relayMetadata(w, r)
 	})
+	http.HandleFunc("/v1/metrics", func(w http.ResponseWriter, r *http.Request) {
+		rp.relayOTLP(w, r)
+	})
 	http.Handle("/", tsdbProxy)
 
 	collectUrl := &url.URL{
@@ -181,19 +212,33 @@ func main() {
 	collect.Add("metadata.error", tags, 0)
 	collect.Add("additional.puts.relayed", tags, 0)
 	collect.Add("additional.puts.error", tags, 0)
+	collect.Add("otlp.requests", tags, 0)
+	collect.Add("otlp.error", tags, 0)
+	collect.Add("otlp.datapoints", tags, 0)
+	collect.Add("otlp.dropped", tags, 0)
+	if pipeline != nil {
+		for _, name := range pipeline.Names() {
+			collect.Add("rules.matched", opentsdb.TagSet{"rule": name}, 0)
+			collect.Add("rules.dropped", opentsdb.TagSet{"rule": name}, 0)
+		}
+		metadata.AddMetricMeta("tsdbrelay.rules.matched", metadata.Counter, metadata.Count, "Number of data points matched by each processor rule")
+		metadata.AddMetricMeta("tsdbrelay.rules.dropped", metadata.Counter, metadata.Count, "Number of data points dropped by each processor rule")
+	}
 	metadata.AddMetricMeta("tsdbrelay.puts.relayed", metadata.Counter, metadata.Count, "Number of successful puts relayed to opentsdb target")
 	metadata.AddMetricMeta("tsdbrelay.puts.error", metadata.Counter, metadata.Count, "Number of puts that could not be relayed to opentsdb target")
 	metadata.AddMetricMeta("tsdbrelay.metadata.relayed", metadata.Counter, metadata.Count, "Number of successful metadata puts relayed to bosun target")
 	metadata.AddMetricMeta("tsdbrelay.metadata.error", metadata.Counter, metadata.Count, "Number of metadata puts that could not be relayed to bosun target")
 	metadata.AddMetricMeta("tsdbrelay.additional.puts.relayed", metadata.Counter, metadata.Count, "Number of successful puts relayed to additional targets")
 	metadata.AddMetricMeta("tsdbrelay.additional.puts.error", metadata.Counter, metadata.Count, "Number of puts that could not be relayed to additional targets")
+	metadata.AddMetricMeta("tsdbrelay.otlp.requests", metadata.Counter, metadata.Count, "Number of OTLP metrics export requests received")
+	metadata.AddMetricMeta("tsdbrelay.otlp.error", metadata.Counter, metadata.Count, "Number of OTLP metrics export requests that could not be decoded or relayed")
+	metadata.AddMetricMeta("tsdbrelay.otlp.datapoints", metadata.Counter, metadata.Count, "Number of data points converted from OTLP metrics exports")
+	metadata.AddMetricMeta("tsdbrelay.otlp.dropped", metadata.Counter, metadata.Count, "Number of OTLP data points that could not be converted")
 	slog.Fatal(http.ListenAndServe(*listenAddr, nil))
 }
 
 func verbose(format string, a ...interface{}) {
-	if *logVerbose {
-		slog.Infof(format, a...)
-	}
+	slog.Debugf(format, a...)
 }
 
 type relayProxy struct {
@@ -232,23 +277,27 @@ var (
 
 func (rp *relayProxy) relayPut(responseWriter http.ResponseWriter, r *http.Request, parse bool) {
 	isRelayed := r.Header.Get(relayHeader) != ""
+	log := slog.With("remote", r.RemoteAddr, "relayed", isRelayed)
+	if !isRelayed && parse && pipeline != nil && !processPut(responseWriter, r) {
+		return
+	}
 	reader := &passthru{ReadCloser: r.Body}
 	r.Body = reader
 	w := &relayWriter{ResponseWriter: responseWriter}
 	rp.TSDBProxy.ServeHTTP(w, r)
 	if w.code/100 != 2 {
-		verbose("relayPut got status %d", w.code)
+		log.With("status", w.code).Debug("relayPut got bad status")
 		collect.Add("puts.error", tags, 1)
 		return
 	}
-	verbose("relayed to tsdb")
+	log.Debug("relayed to tsdb")
 	collect.Add("puts.relayed", tags, 1)
 	// Send to bosun in a separate go routine so we can end the source's request.
 	go func() {
 		body := bytes.NewBuffer(reader.buf.Bytes())
 		req, err := http.NewRequest(r.Method, bosunIndexURL, body)
 		if err != nil {
-			verbose("bosun connect error: %v", err)
+			log.With("target", "bosun").Debugf("connect error: %v", err)
 			return
 		}
 		if access := r.Header.Get(accessHeader); access != "" {
@@ -256,13 +305,13 @@ func (rp *relayProxy) relayPut(responseWriter http.ResponseWriter, r *http.Reque
 		}
 		resp, err := http.DefaultClient.Do(req)
 		if err != nil {
-			verbose("bosun relay error: %v", err)
+			log.With("target", "bosun").Debugf("relay error: %v", err)
 			return
 		}
 		// Drain up to 512 bytes and close the body to let the Transport reuse the connection
 		io.CopyN(ioutil.Discard, resp.Body, 512)
 		resp.Body.Close()
-		verbose("bosun relay success")
+		log.With("target", "bosun", "status", resp.StatusCode).Debug("relay success")
 	}()
 	// Parse and denormalize datapoints
 	if !isRelayed && parse && denormalizationRules != nil {
@@ -275,7 +324,7 @@ func (rp *relayProxy) relayPut(responseWriter http.ResponseWriter, r *http.Reque
 				body := bytes.NewBuffer(reader.buf.Bytes())
 				req, err := http.NewRequest(r.Method, relayURL, body)
 				if err != nil {
-					verbose("%s connect error: %v", relayURL, err)
+					log.With("target", relayURL).Debugf("connect error: %v", err)
 					collect.Add("additional.puts.error", tags, 1)
 					continue
 				}
@@ -291,20 +340,89 @@ func (rp *relayProxy) relayPut(responseWriter http.ResponseWriter, r *http.Reque
 				req.Header.Add(relayHeader, myHost)
 				resp, err := http.DefaultClient.Do(req)
 				if err != nil {
-					verbose("secondary relay error: %v", err)
+					log.With("target", relayURL).Debugf("secondary relay error: %v", err)
 					collect.Add("additional.puts.error", tags, 1)
 					continue
 				}
 				// Drain up to 512 bytes and close the body to let the Transport reuse the connection
 				io.CopyN(ioutil.Discard, resp.Body, 512)
 				resp.Body.Close()
-				verbose("secondary relay success")
+				log.With("target", relayURL, "status", resp.StatusCode).Debug("secondary relay success")
 				collect.Add("additional.puts.relayed", tags, 1)
 			}
 		}()
 	}
 }
 
+// processPut runs the processor pipeline over the data points in r and
+// replaces its body with the result. It returns false if a response has
+// already been written, either because the body could not be decoded or
+// because every data point was dropped.
+func processPut(w http.ResponseWriter, r *http.Request) bool {
+	var body io.Reader = r.Body
+	if r.Header.Get(encHeader) == "gzip" {
+		gReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			verbose("error making gzip reader: %v", err)
+			collect.Add("puts.error", tags, 1)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return false
+		}
+		body = gReader
+	}
+	b, err := ioutil.ReadAll(body)
+	r.Body.Close()
+	if err != nil {
+		verbose("error reading data points: %v", err)
+		collect.Add("puts.error", tags, 1)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	var dps []*opentsdb.DataPoint
+	if err := json.Unmarshal(b, &dps); err != nil {
+		var dp opentsdb.DataPoint
+		if err := json.Unmarshal(b, &dp); err != nil {
+			verbose("error decoding data points: %v", err)
+			collect.Add("puts.error", tags, 1)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return false
+		}
+		dps = []*opentsdb.DataPoint{&dp}
+	}
+	processed := dps[:0]
+	for _, dp := range pipeline.Process(dps) {
+		if err := dp.Clean(); err != nil {
+			verbose("dropping processed data point: %v", err)
+			continue
+		}
+		processed = append(processed, dp)
+	}
+	if len(processed) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return false
+	}
+	buf := &bytes.Buffer{}
+	gWriter := gzip.NewWriter(buf)
+	if err := json.NewEncoder(gWriter).Encode(processed); err != nil {
+		verbose("error encoding processed data points: %v", err)
+		collect.Add("puts.error", tags, 1)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	if err := gWriter.Close(); err != nil {
+		verbose("error zipping processed data points: %v", err)
+		collect.Add("puts.error", tags, 1)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	r.Body = ioutil.NopCloser(buf)
+	r.ContentLength = int64(buf.Len())
+	r.Header.Set(encHeader, "gzip")
+	r.Header.Set(typeHeader, "application/json")
+	r.Header.Del("Content-Length")
+	return true
+}
+
 func (rp *relayProxy) denormalize(body io.Reader) {
 	gReader, err := gzip.NewReader(body)
 	if err != nil {
@@ -357,6 +475,92 @@ func (rp *relayProxy) denormalize(body io.Reader) {
 	verbose("relayed %d denormalized data points. Tsdb response: %d", len(relayDps), responseWriter.Code)
 }
 
+// relayOTLP accepts an OTLP/HTTP metrics export, converts it to OpenTSDB data
+// points and relays them like a put.
+func (rp *relayProxy) relayOTLP(responseWriter http.ResponseWriter, r *http.Request) {
+	collect.Add("otlp.requests", tags, 1)
+	fail := func(code int, format string, a ...interface{}) {
+		verbose("otlp: "+format, a...)
+		collect.Add("otlp.error", tags, 1)
+		http.Error(responseWriter, fmt.Sprintf(format, a...), code)
+	}
+	if r.Method != "POST" {
+		fail(http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+		return
+	}
+	var body io.Reader = r.Body
+	if r.Header.Get(encHeader) == "gzip" {
+		gReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			fail(http.StatusBadRequest, "error making gzip reader: %v", err)
+			return
+		}
+		body = gReader
+	}
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		fail(http.StatusBadRequest, "error reading body: %v", err)
+		return
+	}
+	contentType := r.Header.Get(typeHeader)
+	export, err := otlp.Decode(contentType, b)
+	if err != nil {
+		fail(http.StatusBadRequest, "error decoding export: %v", err)
+		return
+	}
+	converted := export.Convert(strings.Split(*otlpAttributes, ","))
+	collect.Add("otlp.dropped", tags, int64(converted.Dropped))
+	for _, m := range converted.Meta {
+		metadata.AddMeta(m.Metric, nil, "rate", m.Rate, false)
+		if m.Unit != metadata.None {
+			metadata.AddMeta(m.Metric, nil, "unit", m.Unit, false)
+		}
+		if m.Desc != "" {
+			metadata.AddMeta(m.Metric, nil, "desc", m.Desc, false)
+		}
+	}
+	if len(converted.DataPoints) > 0 {
+		buf := &bytes.Buffer{}
+		gWriter := gzip.NewWriter(buf)
+		if err := json.NewEncoder(gWriter).Encode(converted.DataPoints); err != nil {
+			fail(http.StatusInternalServerError, "error encoding data points: %v", err)
+			return
+		}
+		if err := gWriter.Close(); err != nil {
+			fail(http.StatusInternalServerError, "error zipping data points: %v", err)
+			return
+		}
+		req, err := http.NewRequest("POST", "/api/put", buf)
+		if err != nil {
+			fail(http.StatusInternalServerError, "error creating put request: %v", err)
+			return
+		}
+		req.Header.Set(typeHeader, "application/json")
+		req.Header.Set(encHeader, "gzip")
+		if access := r.Header.Get(accessHeader); access != "" {
+			req.Header.Set(accessHeader, access)
+		}
+		w := httptest.NewRecorder()
+		rp.relayPut(w, req, true)
+		if w.Code/100 != 2 {
+			fail(http.StatusBadGateway, "tsdb responded with status %d", w.Code)
+			return
+		}
+		collect.Add("otlp.datapoints", tags, int64(len(converted.DataPoints)))
+		verbose("relayed %d data points from OTLP export", len(converted.DataPoints))
+	}
+	// An empty ExportMetricsServiceResponse is encoded as zero bytes in
+	// protobuf and {} in JSON.
+	if strings.HasPrefix(contentType, otlp.ContentTypeJSON) {
+		responseWriter.Header().Set(typeHeader, otlp.ContentTypeJSON)
+		responseWriter.WriteHeader(http.StatusOK)
+		responseWriter.Write([]byte("{}"))
+		return
+	}
+	responseWriter.Header().Set(typeHeader, otlp.ContentTypeProtobuf)
+	responseWriter.WriteHeader(http.StatusOK)
+}
+
 func (rp *relayProxy) relayMetadata(responseWriter http.ResponseWriter, r *http.Request) {
 	reader := &passthru{ReadCloser: r.Body}
 	r.Body = reader
@@ -0,0 +1,372 @@
+// Package otlp converts OpenTelemetry OTLP/HTTP metrics exports into OpenTSDB
+// data points.
+//
+// Only the parts of the OTLP metrics data model that map onto OpenTSDB are
+// decoded: gauges, sums and explicit bucket histograms. Other metric types are
+// counted as dropped.
+package otlp // import "bosun.org/cmd/tsdbrelay/otlp"
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+)
+
+// Content types accepted by Decode.
+const (
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+// Aggregation temporality of sums and histograms.
+const (
+	TemporalityUnspecified = 0
+	TemporalityDelta       = 1
+	TemporalityCumulative  = 2
+)
+
+// ExportMetricsServiceRequest is the body of an OTLP metrics export.
+type ExportMetricsServiceRequest struct {
+	ResourceMetrics []ResourceMetrics `json:"resourceMetrics"`
+}
+
+type ResourceMetrics struct {
+	Resource     Resource       `json:"resource"`
+	ScopeMetrics []ScopeMetrics `json:"scopeMetrics"`
+}
+
+type Resource struct {
+	Attributes []KeyValue `json:"attributes"`
+}
+
+type ScopeMetrics struct {
+	Scope   InstrumentationScope `json:"scope"`
+	Metrics []Metric             `json:"metrics"`
+}
+
+type InstrumentationScope struct {
+	Name       string     `json:"name"`
+	Version    string     `json:"version"`
+	Attributes []KeyValue `json:"attributes"`
+}
+
+// Metric is a single OTLP metric. At most one of Gauge, Sum and Histogram is
+// set; all are nil for unsupported types.
+type Metric struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Unit        string     `json:"unit"`
+	Gauge       *Gauge     `json:"gauge"`
+	Sum         *Sum       `json:"sum"`
+	Histogram   *Histogram `json:"histogram"`
+}
+
+type Gauge struct {
+	DataPoints []NumberDataPoint `json:"dataPoints"`
+}
+
+type Sum struct {
+	DataPoints             []NumberDataPoint `json:"dataPoints"`
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+}
+
+type Histogram struct {
+	DataPoints             []HistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                  `json:"aggregationTemporality"`
+}
+
+type NumberDataPoint struct {
+	Attributes   []KeyValue `json:"attributes"`
+	TimeUnixNano Uint64     `json:"timeUnixNano"`
+	AsDouble     *float64   `json:"asDouble"`
+	AsInt        *Int64     `json:"asInt"`
+}
+
+type HistogramDataPoint struct {
+	Attributes     []KeyValue `json:"attributes"`
+	TimeUnixNano   Uint64     `json:"timeUnixNano"`
+	Count          Uint64     `json:"count"`
+	Sum            *float64   `json:"sum"`
+	BucketCounts   []Uint64   `json:"bucketCounts"`
+	ExplicitBounds []float64  `json:"explicitBounds"`
+}
+
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// AnyValue holds an attribute value. Array, key/value list and bytes values
+// are not representable as tags and are ignored.
+type AnyValue struct {
+	StringValue *string  `json:"stringValue"`
+	BoolValue   *bool    `json:"boolValue"`
+	IntValue    *Int64   `json:"intValue"`
+	DoubleValue *float64 `json:"doubleValue"`
+}
+
+// Uint64 is a uint64 that, as required by the OTLP JSON encoding, may be
+// given as either a JSON number or a decimal string.
+type Uint64 uint64
+
+func (u *Uint64) UnmarshalJSON(b []byte) error {
+	v, err := strconv.ParseUint(strings.Trim(string(b), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+	*u = Uint64(v)
+	return nil
+}
+
+// Int64 is the signed equivalent of Uint64.
+type Int64 int64
+
+func (i *Int64) UnmarshalJSON(b []byte) error {
+	v, err := strconv.ParseInt(strings.Trim(string(b), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = Int64(v)
+	return nil
+}
+
+// String returns the value formatted for use as a tag value, or "" if it has
+// no scalar representation.
+func (v AnyValue) String() string {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return strconv.FormatBool(*v.BoolValue)
+	case v.IntValue != nil:
+		return strconv.FormatInt(int64(*v.IntValue), 10)
+	case v.DoubleValue != nil:
+		return strconv.FormatFloat(*v.DoubleValue, 'f', -1, 64)
+	}
+	return ""
+}
+
+// Decode decodes an export request encoded according to contentType.
+func Decode(contentType string, body []byte) (*ExportMetricsServiceRequest, error) {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, err
+	}
+	req := &ExportMetricsServiceRequest{}
+	switch mt {
+	case ContentTypeProtobuf:
+		err = req.unmarshalProto(body)
+	case ContentTypeJSON:
+		err = json.Unmarshal(body, req)
+	default:
+		return nil, fmt.Errorf("unsupported content type %s", mt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// MetricMeta is the metadata of a converted metric.
+type MetricMeta struct {
+	Metric string
+	Rate   metadata.RateType
+	Unit   metadata.Unit
+	Desc   string
+}
+
+// Converted is the result of converting an export request.
+type Converted struct {
+	DataPoints []*opentsdb.DataPoint
+	Meta       []MetricMeta
+	// Dropped is the number of OTLP data points that could not be converted.
+	Dropped int
+}
+
+// DefaultAttributes are the resource and scope attributes converted to tags
+// by default. SDKs add many more, like process.pid or telemetry.sdk.version,
+// which would go past the tag limit of OpenTSDB or create a series per
+// process.
+var DefaultAttributes = []string{"service.name", "host.name"}
+
+// Convert converts r to OpenTSDB data points. Tags are built from the
+// resource attributes, then the scope attributes, then the point attributes,
+// each overriding the previous. Only the resource and scope attributes in
+// attributes are used, the others are dropped. Metric names and tags are
+// cleaned, replacing invalid characters with "_". If no host tag is present,
+// the host.name resource attribute is used. Histograms are converted to
+// name.bucket with an le tag holding cumulative counts, name.count and
+// name.sum.
+func (r *ExportMetricsServiceRequest) Convert(attributes []string) *Converted {
+	c := &Converted{}
+	now := time.Now().Unix()
+	allowed := make(map[string]bool)
+	for _, a := range attributes {
+		allowed[a] = true
+	}
+	for _, rm := range r.ResourceMetrics {
+		resourceTags := opentsdb.TagSet{}
+		addAttributes(resourceTags, filterAttributes(rm.Resource.Attributes, allowed))
+		if _, ok := resourceTags["host"]; !ok {
+			for _, kv := range rm.Resource.Attributes {
+				if kv.Key == "host.name" {
+					addTag(resourceTags, "host", kv.Value.String())
+				}
+			}
+		}
+		for _, sm := range rm.ScopeMetrics {
+			scopeTags := resourceTags.Copy()
+			addAttributes(scopeTags, filterAttributes(sm.Scope.Attributes, allowed))
+			for _, m := range sm.Metrics {
+				c.convertMetric(m, scopeTags, now)
+			}
+		}
+	}
+	return c
+}
+
+func (c *Converted) convertMetric(m Metric, tags opentsdb.TagSet, now int64) {
+	name, err := opentsdb.Replace(m.Name, "_")
+	if err != nil || name == "" {
+		c.Dropped += m.points()
+		return
+	}
+	add := func(metric string, ts Uint64, value interface{}, attrs []KeyValue) {
+		t := tags.Copy()
+		addAttributes(t, attrs)
+		dp := &opentsdb.DataPoint{
+			Metric:    metric,
+			Timestamp: now,
+			Value:     value,
+			Tags:      t,
+		}
+		if ts != 0 {
+			dp.Timestamp = int64(ts) / int64(time.Second)
+		}
+		if len(t) == 0 || !dp.Valid() {
+			c.Dropped++
+			return
+		}
+		c.DataPoints = append(c.DataPoints, dp)
+	}
+	meta := func(metric string, rate metadata.RateType) {
+		c.Meta = append(c.Meta, MetricMeta{
+			Metric: metric,
+			Rate:   rate,
+			Unit:   metadata.Unit(m.Unit),
+			Desc:   m.Description,
+		})
+	}
+	switch {
+	case m.Gauge != nil:
+		meta(name, metadata.Gauge)
+		for _, p := range m.Gauge.DataPoints {
+			if v, ok := p.value(); ok {
+				add(name, p.TimeUnixNano, v, p.Attributes)
+			} else {
+				c.Dropped++
+			}
+		}
+	case m.Sum != nil:
+		if m.Sum.IsMonotonic && m.Sum.AggregationTemporality == TemporalityCumulative {
+			meta(name, metadata.Counter)
+		} else {
+			meta(name, metadata.Gauge)
+		}
+		for _, p := range m.Sum.DataPoints {
+			if v, ok := p.value(); ok {
+				add(name, p.TimeUnixNano, v, p.Attributes)
+			} else {
+				c.Dropped++
+			}
+		}
+	case m.Histogram != nil:
+		rate := metadata.RateType(metadata.Gauge)
+		if m.Histogram.AggregationTemporality == TemporalityCumulative {
+			rate = metadata.Counter
+		}
+		meta(name+".bucket", rate)
+		meta(name+".count", rate)
+		meta(name+".sum", rate)
+		for _, p := range m.Histogram.DataPoints {
+			if len(p.BucketCounts) != 0 && len(p.BucketCounts) != len(p.ExplicitBounds)+1 {
+				c.Dropped++
+				continue
+			}
+			var cumulative uint64
+			for i, n := range p.BucketCounts {
+				cumulative += uint64(n)
+				le := "inf"
+				if i < len(p.ExplicitBounds) {
+					le = strconv.FormatFloat(p.ExplicitBounds[i], 'f', -1, 64)
+				}
+				attrs := append(p.Attributes[:len(p.Attributes):len(p.Attributes)], KeyValue{Key: "le", Value: AnyValue{StringValue: &le}})
+				add(name+".bucket", p.TimeUnixNano, cumulative, attrs)
+			}
+			add(name+".count", p.TimeUnixNano, uint64(p.Count), p.Attributes)
+			if p.Sum != nil && !math.IsNaN(*p.Sum) && !math.IsInf(*p.Sum, 0) {
+				add(name+".sum", p.TimeUnixNano, *p.Sum, p.Attributes)
+			}
+		}
+	default:
+		c.Dropped += m.points()
+	}
+}
+
+// points returns the number of data points in m, used to count drops.
+func (m Metric) points() int {
+	switch {
+	case m.Gauge != nil:
+		return len(m.Gauge.DataPoints)
+	case m.Sum != nil:
+		return len(m.Sum.DataPoints)
+	case m.Histogram != nil:
+		return len(m.Histogram.DataPoints)
+	}
+	return 1
+}
+
+func (p NumberDataPoint) value() (interface{}, bool) {
+	switch {
+	case p.AsInt != nil:
+		return int64(*p.AsInt), true
+	case p.AsDouble != nil && !math.IsNaN(*p.AsDouble) && !math.IsInf(*p.AsDouble, 0):
+		return *p.AsDouble, true
+	}
+	return nil, false
+}
+
+// filterAttributes returns the attributes with keys in allowed.
+func filterAttributes(attrs []KeyValue, allowed map[string]bool) []KeyValue {
+	var filtered []KeyValue
+	for _, kv := range attrs {
+		if allowed[kv.Key] {
+			filtered = append(filtered, kv)
+		}
+	}
+	return filtered
+}
+
+func addAttributes(tags opentsdb.TagSet, attrs []KeyValue) {
+	for _, kv := range attrs {
+		addTag(tags, kv.Key, kv.Value.String())
+	}
+}
+
+// addTag cleans k and v and sets them in tags if neither is empty.
+func addTag(tags opentsdb.TagSet, k, v string) {
+	k = opentsdb.MustReplace(k, "_")
+	v = opentsdb.MustReplace(v, "_")
+	if k == "" || v == "" {
+		return
+	}
+	tags[k] = v
+}
@@ -0,0 +1,157 @@
+package otlp
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"bosun.org/opentsdb"
+)
+
+const testJSON = `{
+  "resourceMetrics": [{
+    "resource": {"attributes": [
+      {"key": "service.name", "value": {"stringValue": "checkout api"}},
+      {"key": "host.name", "value": {"stringValue": "web01"}},
+      {"key": "process.pid", "value": {"intValue": 1234}}
+    ]},
+    "scopeMetrics": [{
+      "scope": {"name": "io.opentelemetry.http", "attributes": [{"key": "lib", "value": {"stringValue": "otel"}}]},
+      "metrics": [
+        {"name": "http.server.requests", "unit": "1", "description": "Requests served.",
+         "sum": {"aggregationTemporality": 2, "isMonotonic": true, "dataPoints": [
+           {"timeUnixNano": "1600000000000000000", "asInt": "42", "attributes": [{"key": "code", "value": {"intValue": 200}}]}
+         ]}},
+        {"name": "process.memory", "gauge": {"dataPoints": [{"timeUnixNano": 1600000000000000000, "asDouble": 1.5}]}},
+        {"name": "http.server.duration", "histogram": {"aggregationTemporality": 2, "dataPoints": [
+          {"timeUnixNano": "1600000000000000000", "count": "6", "sum": 12.5, "bucketCounts": ["1", "2", "3"], "explicitBounds": [0.5, 1]}
+        ]}},
+        {"name": "unsupported.summary", "summary": {"dataPoints": [{}]}}
+      ]
+    }]
+  }]
+}`
+
+func TestConvertJSON(t *testing.T) {
+	req, err := Decode("application/json; charset=utf-8", []byte(testJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := req.Convert(DefaultAttributes)
+	base := opentsdb.TagSet{"service.name": "checkout_api", "host.name": "web01", "host": "web01"}
+	expected := []*opentsdb.DataPoint{
+		{Metric: "http.server.requests", Value: int64(42), Tags: base.Copy().Merge(opentsdb.TagSet{"code": "200"})},
+		{Metric: "process.memory", Value: 1.5, Tags: base},
+		{Metric: "http.server.duration.bucket", Value: uint64(1), Tags: base.Copy().Merge(opentsdb.TagSet{"le": "0.5"})},
+		{Metric: "http.server.duration.bucket", Value: uint64(3), Tags: base.Copy().Merge(opentsdb.TagSet{"le": "1"})},
+		{Metric: "http.server.duration.bucket", Value: uint64(6), Tags: base.Copy().Merge(opentsdb.TagSet{"le": "inf"})},
+		{Metric: "http.server.duration.count", Value: uint64(6), Tags: base},
+		{Metric: "http.server.duration.sum", Value: 12.5, Tags: base},
+	}
+	checkDataPoints(t, c.DataPoints, expected)
+	if c.Dropped != 1 {
+		t.Errorf("expected 1 dropped point, got %d", c.Dropped)
+	}
+	if len(c.Meta) == 0 || c.Meta[0].Metric != "http.server.requests" || c.Meta[0].Rate != "counter" || c.Meta[0].Desc != "Requests served." {
+		t.Errorf("unexpected metadata %+v", c.Meta)
+	}
+}
+
+func TestConvertProtobuf(t *testing.T) {
+	kv := pbMessage(pbBytes(1, []byte("region")), pbBytes(2, pbMessage(pbBytes(1, []byte("us-east")))))
+	point := pbMessage(
+		pbFixed64(3, 1600000000000000000),
+		pbFixed64(4, math.Float64bits(0.25)),
+		pbBytes(7, pbMessage(pbBytes(1, []byte("cpu")), pbBytes(2, pbMessage(pbVarint(3, 2))))),
+	)
+	hist := pbMessage(
+		pbFixed64(3, 1600000000000000000),
+		pbFixed64(4, 3),
+		pbBytes(6, pbMessage(pbUint64s(1, 2)...)),
+		pbBytes(7, pbMessage(pbUint64s(math.Float64bits(10))...)),
+	)
+	metrics := pbMessage(
+		pbBytes(1, pbMessage(pbBytes(1, kv))),
+		pbBytes(2, pbMessage(
+			pbBytes(2, pbMessage(pbBytes(1, []byte("system.cpu.utilization")), pbBytes(5, pbMessage(pbBytes(1, point))))),
+			pbBytes(2, pbMessage(pbBytes(1, []byte("rpc.latency")), pbBytes(9, pbMessage(pbBytes(1, hist), pbVarint(2, 2))))),
+		)),
+	)
+	req, err := Decode(ContentTypeProtobuf, pbMessage(pbBytes(1, metrics)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := req.Convert([]string{"region"})
+	expected := []*opentsdb.DataPoint{
+		{Metric: "system.cpu.utilization", Value: 0.25, Tags: opentsdb.TagSet{"region": "us-east", "cpu": "2"}},
+		{Metric: "rpc.latency.bucket", Value: uint64(1), Tags: opentsdb.TagSet{"region": "us-east", "le": "10"}},
+		{Metric: "rpc.latency.bucket", Value: uint64(3), Tags: opentsdb.TagSet{"region": "us-east", "le": "inf"}},
+		{Metric: "rpc.latency.count", Value: uint64(3), Tags: opentsdb.TagSet{"region": "us-east"}},
+	}
+	checkDataPoints(t, c.DataPoints, expected)
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	if _, err := Decode(ContentTypeProtobuf, []byte{0x0a, 0x05, 0x01}); err == nil {
+		t.Fatal("expected error decoding truncated message")
+	}
+	if _, err := Decode("text/plain", nil); err == nil {
+		t.Fatal("expected error for unsupported content type")
+	}
+}
+
+func checkDataPoints(t *testing.T, got, expected []*opentsdb.DataPoint) {
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d data points, got %d: %v", len(expected), len(got), got)
+	}
+	for i, e := range expected {
+		g := got[i]
+		if g.Metric != e.Metric || g.Value != e.Value || !g.Tags.Equal(e.Tags) || g.Timestamp != 1600000000 {
+			t.Errorf("data point %d: expected %v %v %v, got %v %v %v at %d", i, e.Metric, e.Value, e.Tags, g.Metric, g.Value, g.Tags, g.Timestamp)
+		}
+	}
+}
+
+func pbMessage(fields ...[]byte) []byte {
+	var b []byte
+	for _, f := range fields {
+		b = append(b, f...)
+	}
+	return b
+}
+
+func pbUvarint(v uint64) []byte {
+	b := make([]byte, binary.MaxVarintLen64)
+	return b[:binary.PutUvarint(b, v)]
+}
+
+func pbUint64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, v)
+	return b
+}
+
+func pbKey(num, wire int) []byte {
+	return pbUvarint(uint64(num<<3 | wire))
+}
+
+func pbBytes(num int, data []byte) []byte {
+	return pbMessage(pbKey(num, wireBytes), pbUvarint(uint64(len(data))), data)
+}
+
+func pbVarint(num int, v uint64) []byte {
+	return pbMessage(pbKey(num, wireVarint), pbUvarint(v))
+}
+
+func pbFixed64(num int, v uint64) []byte {
+	return pbMessage(pbKey(num, wireFixed64), pbUint64(v))
+}
+
+// pbUint64s returns the packed encoding of vs.
+func pbUint64s(vs ...uint64) [][]byte {
+	b := make([][]byte, len(vs))
+	for i, v := range vs {
+		b[i] = pbUint64(v)
+	}
+	return b
+}
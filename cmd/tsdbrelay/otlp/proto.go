@@ -0,0 +1,321 @@
+package otlp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// This file decodes the subset of the OTLP protobuf messages described by the
+// types in otlp.go directly from the wire format. Field numbers are from
+// opentelemetry/proto/metrics/v1/metrics.proto and
+// opentelemetry/proto/common/v1/common.proto. Unknown fields are skipped.
+
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errTruncated = errors.New("otlp: truncated protobuf message")
+
+// pbField is a single decoded field. For wireBytes fields data holds the
+// contents, otherwise v holds the raw value.
+type pbField struct {
+	num  int
+	wire int
+	v    uint64
+	data []byte
+}
+
+// pbRange calls f for each field in b.
+func pbRange(b []byte, f func(pbField) error) error {
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errTruncated
+		}
+		b = b[n:]
+		fld := pbField{num: int(key >> 3), wire: int(key & 7)}
+		switch fld.wire {
+		case wireVarint:
+			fld.v, n = binary.Uvarint(b)
+			if n <= 0 {
+				return errTruncated
+			}
+			b = b[n:]
+		case wireFixed64:
+			if len(b) < 8 {
+				return errTruncated
+			}
+			fld.v = binary.LittleEndian.Uint64(b)
+			b = b[8:]
+		case wireFixed32:
+			if len(b) < 4 {
+				return errTruncated
+			}
+			fld.v = uint64(binary.LittleEndian.Uint32(b))
+			b = b[4:]
+		case wireBytes:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < l {
+				return errTruncated
+			}
+			fld.data = b[n : n+int(l)]
+			b = b[n+int(l):]
+		default:
+			return fmt.Errorf("otlp: unsupported protobuf wire type %d", fld.wire)
+		}
+		if err := f(fld); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fixed64s returns the values of a repeated fixed64 or double field, which
+// may be packed or not.
+func (f pbField) fixed64s() ([]uint64, error) {
+	switch f.wire {
+	case wireFixed64:
+		return []uint64{f.v}, nil
+	case wireBytes:
+		if len(f.data)%8 != 0 {
+			return nil, errTruncated
+		}
+		vs := make([]uint64, len(f.data)/8)
+		for i := range vs {
+			vs[i] = binary.LittleEndian.Uint64(f.data[i*8:])
+		}
+		return vs, nil
+	}
+	return nil, fmt.Errorf("otlp: field %d: unexpected wire type %d", f.num, f.wire)
+}
+
+func (f pbField) float64() *float64 {
+	v := math.Float64frombits(f.v)
+	return &v
+}
+
+func (r *ExportMetricsServiceRequest) unmarshalProto(b []byte) error {
+	return pbRange(b, func(f pbField) error {
+		if f.num == 1 && f.wire == wireBytes {
+			var rm ResourceMetrics
+			if err := rm.unmarshalProto(f.data); err != nil {
+				return err
+			}
+			r.ResourceMetrics = append(r.ResourceMetrics, rm)
+		}
+		return nil
+	})
+}
+
+func (rm *ResourceMetrics) unmarshalProto(b []byte) error {
+	return pbRange(b, func(f pbField) error {
+		if f.wire != wireBytes {
+			return nil
+		}
+		switch f.num {
+		case 1:
+			return pbRange(f.data, func(f pbField) error {
+				if f.num == 1 && f.wire == wireBytes {
+					return appendKeyValue(&rm.Resource.Attributes, f.data)
+				}
+				return nil
+			})
+		case 2:
+			var sm ScopeMetrics
+			if err := sm.unmarshalProto(f.data); err != nil {
+				return err
+			}
+			rm.ScopeMetrics = append(rm.ScopeMetrics, sm)
+		}
+		return nil
+	})
+}
+
+func (sm *ScopeMetrics) unmarshalProto(b []byte) error {
+	return pbRange(b, func(f pbField) error {
+		if f.wire != wireBytes {
+			return nil
+		}
+		switch f.num {
+		case 1:
+			return sm.Scope.unmarshalProto(f.data)
+		case 2:
+			var m Metric
+			if err := m.unmarshalProto(f.data); err != nil {
+				return err
+			}
+			sm.Metrics = append(sm.Metrics, m)
+		}
+		return nil
+	})
+}
+
+func (s *InstrumentationScope) unmarshalProto(b []byte) error {
+	return pbRange(b, func(f pbField) error {
+		if f.wire != wireBytes {
+			return nil
+		}
+		switch f.num {
+		case 1:
+			s.Name = string(f.data)
+		case 2:
+			s.Version = string(f.data)
+		case 3:
+			return appendKeyValue(&s.Attributes, f.data)
+		}
+		return nil
+	})
+}
+
+func (m *Metric) unmarshalProto(b []byte) error {
+	return pbRange(b, func(f pbField) error {
+		if f.wire != wireBytes {
+			return nil
+		}
+		switch f.num {
+		case 1:
+			m.Name = string(f.data)
+		case 2:
+			m.Description = string(f.data)
+		case 3:
+			m.Unit = string(f.data)
+		case 5:
+			m.Gauge = &Gauge{}
+			return pbRange(f.data, func(f pbField) error {
+				if f.num == 1 && f.wire == wireBytes {
+					return appendNumberDataPoint(&m.Gauge.DataPoints, f.data)
+				}
+				return nil
+			})
+		case 7:
+			m.Sum = &Sum{}
+			return pbRange(f.data, func(f pbField) error {
+				switch {
+				case f.num == 1 && f.wire == wireBytes:
+					return appendNumberDataPoint(&m.Sum.DataPoints, f.data)
+				case f.num == 2 && f.wire == wireVarint:
+					m.Sum.AggregationTemporality = int(f.v)
+				case f.num == 3 && f.wire == wireVarint:
+					m.Sum.IsMonotonic = f.v != 0
+				}
+				return nil
+			})
+		case 9:
+			m.Histogram = &Histogram{}
+			return pbRange(f.data, func(f pbField) error {
+				switch {
+				case f.num == 1 && f.wire == wireBytes:
+					var p HistogramDataPoint
+					if err := p.unmarshalProto(f.data); err != nil {
+						return err
+					}
+					m.Histogram.DataPoints = append(m.Histogram.DataPoints, p)
+				case f.num == 2 && f.wire == wireVarint:
+					m.Histogram.AggregationTemporality = int(f.v)
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+func appendNumberDataPoint(ps *[]NumberDataPoint, b []byte) error {
+	var p NumberDataPoint
+	err := pbRange(b, func(f pbField) error {
+		switch {
+		case f.num == 3 && f.wire == wireFixed64:
+			p.TimeUnixNano = Uint64(f.v)
+		case f.num == 4 && f.wire == wireFixed64:
+			p.AsDouble = f.float64()
+		case f.num == 6 && f.wire == wireFixed64:
+			v := Int64(f.v)
+			p.AsInt = &v
+		case f.num == 7 && f.wire == wireBytes:
+			return appendKeyValue(&p.Attributes, f.data)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*ps = append(*ps, p)
+	return nil
+}
+
+func (p *HistogramDataPoint) unmarshalProto(b []byte) error {
+	return pbRange(b, func(f pbField) error {
+		switch {
+		case f.num == 3 && f.wire == wireFixed64:
+			p.TimeUnixNano = Uint64(f.v)
+		case f.num == 4 && f.wire == wireFixed64:
+			p.Count = Uint64(f.v)
+		case f.num == 5 && f.wire == wireFixed64:
+			p.Sum = f.float64()
+		case f.num == 6:
+			vs, err := f.fixed64s()
+			if err != nil {
+				return err
+			}
+			for _, v := range vs {
+				p.BucketCounts = append(p.BucketCounts, Uint64(v))
+			}
+		case f.num == 7:
+			vs, err := f.fixed64s()
+			if err != nil {
+				return err
+			}
+			for _, v := range vs {
+				p.ExplicitBounds = append(p.ExplicitBounds, math.Float64frombits(v))
+			}
+		case f.num == 9 && f.wire == wireBytes:
+			return appendKeyValue(&p.Attributes, f.data)
+		}
+		return nil
+	})
+}
+
+func appendKeyValue(kvs *[]KeyValue, b []byte) error {
+	var kv KeyValue
+	err := pbRange(b, func(f pbField) error {
+		if f.wire != wireBytes {
+			return nil
+		}
+		switch f.num {
+		case 1:
+			kv.Key = string(f.data)
+		case 2:
+			return kv.Value.unmarshalProto(f.data)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*kvs = append(*kvs, kv)
+	return nil
+}
+
+func (v *AnyValue) unmarshalProto(b []byte) error {
+	return pbRange(b, func(f pbField) error {
+		switch {
+		case f.num == 1 && f.wire == wireBytes:
+			s := string(f.data)
+			v.StringValue = &s
+		case f.num == 2 && f.wire == wireVarint:
+			b := f.v != 0
+			v.BoolValue = &b
+		case f.num == 3 && f.wire == wireVarint:
+			i := Int64(f.v)
+			v.IntValue = &i
+		case f.num == 4 && f.wire == wireFixed64:
+			v.DoubleValue = f.float64()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,268 @@
+// Package processor implements an ordered pipeline of data point rewriting and
+// filtering rules for tsdbrelay.
+package processor // import "bosun.org/cmd/tsdbrelay/processor"
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sync"
+	"time"
+
+	"bosun.org/collect"
+	"bosun.org/opentsdb"
+	"github.com/BurntSushi/toml"
+)
+
+// Config is the format of the rules file.
+type Config struct {
+	Processor []Rule
+}
+
+// Rule configures a single processor. Metric and Tags restrict which data
+// points the rule applies to; the remaining fields depend on Type:
+//
+//	drop           drops matching data points
+//	rename_metric  replaces Metric matches in the metric name with Replacement
+//	add_tag        sets Tag to Value
+//	drop_tag       removes Tag
+//	rename_tag     renames Tag to NewTag
+//	map_tag        replaces the value of Tag using Map, values not in Map are kept
+//	cardinality    drops data points of new series once a metric has Limit
+//	               series, forgetting seen series every Window (default 1h)
+//	sample         keeps a random Rate (0 to 1) fraction of data points
+type Rule struct {
+	Name        string
+	Type        string
+	Metric      string            // regexp matched against the metric name
+	Tags        map[string]string // tag key to regexp matched against its value
+	Replacement string
+	Tag         string
+	NewTag      string
+	Value       string
+	Map         map[string]string
+	Limit       int
+	Window      string
+	Rate        float64
+}
+
+// Pipeline is an ordered list of processors.
+type Pipeline struct {
+	processors []*processor
+}
+
+type processor struct {
+	name   string
+	typ    string
+	metric *regexp.Regexp
+	tags   map[string]*regexp.Regexp
+	rule   Rule
+
+	// cardinality state
+	sync.Mutex
+	window time.Duration
+	reset  time.Time
+	series map[string]map[string]bool
+}
+
+// Load reads a TOML rules file.
+func Load(path string) (*Pipeline, error) {
+	var c Config
+	md, err := toml.DecodeFile(path, &c)
+	if err != nil {
+		return nil, err
+	}
+	if u := md.Undecoded(); len(u) > 0 {
+		return nil, fmt.Errorf("extra keys in %s: %v", path, u)
+	}
+	return New(c)
+}
+
+// New validates c and builds its pipeline.
+func New(c Config) (*Pipeline, error) {
+	p := &Pipeline{}
+	for i, r := range c.Processor {
+		proc := &processor{
+			name: r.Name,
+			typ:  r.Type,
+			rule: r,
+			tags: make(map[string]*regexp.Regexp),
+		}
+		if proc.name == "" {
+			proc.name = fmt.Sprintf("%d-%s", i, r.Type)
+		}
+		proc.name = opentsdb.MustReplace(proc.name, "_")
+		errf := func(format string, a ...interface{}) error {
+			return fmt.Errorf("processor %s: %s", proc.name, fmt.Sprintf(format, a...))
+		}
+		var err error
+		if r.Metric != "" {
+			if proc.metric, err = regexp.Compile(r.Metric); err != nil {
+				return nil, errf("%v", err)
+			}
+		}
+		for k, v := range r.Tags {
+			if proc.tags[k], err = regexp.Compile(v); err != nil {
+				return nil, errf("tag %s: %v", k, err)
+			}
+		}
+		switch r.Type {
+		case "drop":
+		case "rename_metric":
+			if proc.metric == nil {
+				return nil, errf("rename_metric requires Metric")
+			}
+		case "add_tag":
+			if r.Tag == "" || r.Value == "" {
+				return nil, errf("add_tag requires Tag and Value")
+			}
+		case "drop_tag":
+			if r.Tag == "" {
+				return nil, errf("drop_tag requires Tag")
+			}
+		case "rename_tag":
+			if r.Tag == "" || r.NewTag == "" {
+				return nil, errf("rename_tag requires Tag and NewTag")
+			}
+		case "map_tag":
+			if r.Tag == "" || len(r.Map) == 0 {
+				return nil, errf("map_tag requires Tag and Map")
+			}
+		case "cardinality":
+			if r.Limit <= 0 {
+				return nil, errf("cardinality requires a positive Limit")
+			}
+			proc.window = time.Hour
+			if r.Window != "" {
+				if proc.window, err = time.ParseDuration(r.Window); err != nil {
+					return nil, errf("invalid Window: %v", err)
+				}
+			}
+			proc.series = make(map[string]map[string]bool)
+		case "sample":
+			if r.Rate <= 0 || r.Rate > 1 {
+				return nil, errf("sample requires a Rate in (0, 1]")
+			}
+		default:
+			return nil, errf("unknown type %q", r.Type)
+		}
+		p.processors = append(p.processors, proc)
+	}
+	return p, nil
+}
+
+// Process runs every processor over dps in order and returns the data points
+// that were not dropped. Data points are modified in place. Each processor
+// counts the data points it matched and dropped in the tsdbrelay.rules.matched
+// and tsdbrelay.rules.dropped counters.
+func (p *Pipeline) Process(dps []*opentsdb.DataPoint) []*opentsdb.DataPoint {
+	for _, proc := range p.processors {
+		var matched, dropped int64
+		kept := dps[:0]
+		for _, dp := range dps {
+			if !proc.matches(dp) {
+				kept = append(kept, dp)
+				continue
+			}
+			matched++
+			if proc.apply(dp) {
+				kept = append(kept, dp)
+			} else {
+				dropped++
+			}
+		}
+		dps = kept
+		if matched > 0 {
+			collect.Add("rules.matched", opentsdb.TagSet{"rule": proc.name}, matched)
+		}
+		if dropped > 0 {
+			collect.Add("rules.dropped", opentsdb.TagSet{"rule": proc.name}, dropped)
+		}
+	}
+	return dps
+}
+
+// Names returns the processor names, as used in the rule tag of counters.
+func (p *Pipeline) Names() []string {
+	names := make([]string, len(p.processors))
+	for i, proc := range p.processors {
+		names[i] = proc.name
+	}
+	return names
+}
+
+func (proc *processor) matches(dp *opentsdb.DataPoint) bool {
+	if proc.metric != nil && !proc.metric.MatchString(dp.Metric) {
+		return false
+	}
+	for k, re := range proc.tags {
+		v, ok := dp.Tags[k]
+		if !ok || !re.MatchString(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// apply runs the processor on dp, returning false if dp should be dropped.
+func (proc *processor) apply(dp *opentsdb.DataPoint) bool {
+	r := proc.rule
+	switch proc.typ {
+	case "drop":
+		return false
+	case "rename_metric":
+		m := opentsdb.MustReplace(proc.metric.ReplaceAllString(dp.Metric, r.Replacement), "_")
+		if m == "" {
+			return false
+		}
+		dp.Metric = m
+	case "add_tag":
+		if dp.Tags == nil {
+			dp.Tags = make(opentsdb.TagSet)
+		}
+		dp.Tags[r.Tag] = r.Value
+	case "drop_tag":
+		delete(dp.Tags, r.Tag)
+	case "rename_tag":
+		if v, ok := dp.Tags[r.Tag]; ok {
+			delete(dp.Tags, r.Tag)
+			dp.Tags[r.NewTag] = v
+		}
+	case "map_tag":
+		if v, ok := dp.Tags[r.Tag]; ok {
+			if m, ok := r.Map[v]; ok {
+				dp.Tags[r.Tag] = m
+			}
+		}
+	case "cardinality":
+		return proc.admit(dp)
+	case "sample":
+		return rand.Float64() < r.Rate
+	}
+	return true
+}
+
+// admit records the series of dp and returns false if it is new and its
+// metric is already at the limit.
+func (proc *processor) admit(dp *opentsdb.DataPoint) bool {
+	proc.Lock()
+	defer proc.Unlock()
+	if now := time.Now(); now.After(proc.reset) {
+		proc.series = make(map[string]map[string]bool)
+		proc.reset = now.Add(proc.window)
+	}
+	series := proc.series[dp.Metric]
+	if series == nil {
+		series = make(map[string]bool)
+		proc.series[dp.Metric] = series
+	}
+	key := dp.Tags.Tags()
+	if series[key] {
+		return true
+	}
+	if len(series) >= proc.rule.Limit {
+		return false
+	}
+	series[key] = true
+	return true
+}
@@ -0,0 +1,92 @@
+package processor
+
+import (
+	"testing"
+
+	"bosun.org/host"
+	"bosun.org/opentsdb"
+	"bosun.org/util"
+)
+
+func init() {
+	hm, err := host.NewManager(false)
+	if err != nil {
+		panic(err)
+	}
+	util.SetHostManager(hm)
+}
+
+func TestPipeline(t *testing.T) {
+	p, err := New(Config{Processor: []Rule{
+		{Type: "drop", Tags: map[string]string{"env": "^dev$"}},
+		{Type: "rename_metric", Metric: `^legacy\.(.*)`, Replacement: "app.$1"},
+		{Type: "add_tag", Metric: `^app\.`, Tag: "team", Value: "web"},
+		{Type: "drop_tag", Tag: "pid"},
+		{Type: "rename_tag", Tag: "hostname", NewTag: "host"},
+		{Type: "map_tag", Tag: "dc", Map: map[string]string{"ny-1": "ny"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dps := []*opentsdb.DataPoint{
+		{Metric: "legacy.requests", Tags: opentsdb.TagSet{"hostname": "web01", "pid": "123", "dc": "ny-1"}},
+		{Metric: "legacy.requests", Tags: opentsdb.TagSet{"host": "web02", "env": "dev"}},
+		{Metric: "os.cpu", Tags: opentsdb.TagSet{"host": "web03", "dc": "ld"}},
+	}
+	out := p.Process(dps)
+	if len(out) != 2 {
+		t.Fatalf("expected 2 data points, got %d", len(out))
+	}
+	if out[0].Metric != "app.requests" {
+		t.Errorf("expected metric app.requests, got %s", out[0].Metric)
+	}
+	expected := opentsdb.TagSet{"host": "web01", "dc": "ny", "team": "web"}
+	if !out[0].Tags.Equal(expected) {
+		t.Errorf("expected tags %v, got %v", expected, out[0].Tags)
+	}
+	expected = opentsdb.TagSet{"host": "web03", "dc": "ld"}
+	if out[1].Metric != "os.cpu" || !out[1].Tags.Equal(expected) {
+		t.Errorf("expected os.cpu%v unchanged, got %s%v", expected, out[1].Metric, out[1].Tags)
+	}
+}
+
+func TestCardinality(t *testing.T) {
+	p, err := New(Config{Processor: []Rule{{Type: "cardinality", Limit: 2}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dps []*opentsdb.DataPoint
+	for _, id := range []string{"a", "b", "c", "a", "b", "d"} {
+		dps = append(dps, &opentsdb.DataPoint{Metric: "m", Tags: opentsdb.TagSet{"id": id}})
+	}
+	dps = append(dps, &opentsdb.DataPoint{Metric: "other", Tags: opentsdb.TagSet{"id": "c"}})
+	out := p.Process(dps)
+	var got []string
+	for _, dp := range out {
+		got = append(got, dp.Metric+"-"+dp.Tags["id"])
+	}
+	expected := []string{"m-a", "m-b", "m-a", "m-b", "other-c"}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+	}
+}
+
+func TestInvalidRules(t *testing.T) {
+	for _, r := range []Rule{
+		{Type: "unknown"},
+		{Type: "rename_metric"},
+		{Type: "add_tag", Tag: "a"},
+		{Type: "cardinality"},
+		{Type: "sample", Rate: 2},
+		{Type: "drop", Metric: "("},
+	} {
+		if _, err := New(Config{Processor: []Rule{r}}); err == nil {
+			t.Errorf("expected error for %+v", r)
+		}
+	}
+}
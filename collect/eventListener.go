@@ -45,7 +45,7 @@ func HandleCounterPut(server string, database int) http.HandlerFunc {
 				return
 			}
 			if _, err = conn.Do("HINCRBY", RedisCountersKey, mts, i); err != nil {
-				slog.Errorf("Error incrementing counter %s by %d. %s", mts, i, err)
+				slog.With("counter", mts, "increment", i).Errorf("Error incrementing counter. %s", err)
 				http.Error(w, err.Error(), 500)
 				return
 			}
@@ -61,7 +61,7 @@ func Flush() {
 		sending := queue[:i]
 		queue = queue[i:]
 		if Debug {
-			slog.Infof("sending: %d, remaining: %d", i, len(queue))
+			slog.With("sending", i, "remaining", len(queue)).Info("sending batch")
 		}
 		sendBatch(sending)
 	}
@@ -78,7 +78,7 @@ func send() {
 			sending := queue[:i]
 			queue = queue[i:]
 			if Debug {
-				slog.Infof("sending: %d, remaining: %d", i, len(queue))
+				slog.With("sending", i, "remaining", len(queue)).Info("sending batch")
 			}
 			qlock.Unlock()
 			if DisableDefaultCollectors == false {
@@ -115,19 +115,18 @@ func sendBatch(batch []*opentsdb.DataPoint) {
 	Add("collect.post.count", Tags, 1)
 	// Some problem with connecting to the server; retry later.
 	if err != nil || resp.StatusCode != http.StatusNoContent {
+		log := slog.With("datapoints", len(batch))
 		if err != nil {
 			Add("collect.post.error", Tags, 1)
-			slog.Error(err)
+			log.Errorf("post: %v", err)
 		} else if resp.StatusCode != http.StatusNoContent {
 			Add("collect.post.bad_status", Tags, 1)
-			slog.Errorln(resp.Status)
+			log = log.With("status", resp.StatusCode)
 			body, err := ioutil.ReadAll(resp.Body)
 			if err != nil {
-				slog.Error(err)
-			}
-			if len(body) > 0 {
-				slog.Error(string(body))
+				log.Errorf("reading response: %v", err)
 			}
+			log.Errorf("post: bad status: %s", body)
 		}
 		restored := 0
 		for _, msg := range batch {
@@ -136,7 +135,7 @@ func sendBatch(batch []*opentsdb.DataPoint) {
 		}
 		d := time.Second * 5
 		Add("collect.post.restore", Tags, int64(restored))
-		slog.Infof("restored %d, sleeping %s", restored, d)
+		log.Infof("restored %d, sleeping %s", restored, d)
 		time.Sleep(d)
 		return
 	}
@@ -149,7 +148,7 @@ func sendBatch(batch []*opentsdb.DataPoint) {
 
 func recordSent(num int) {
 	if Debug {
-		slog.Infoln("sent", num)
+		slog.With("sent", num).Info("sent batch")
 	}
 	slock.Lock()
 	sent += int64(num)
@@ -16,7 +16,7 @@ require (
 	github.com/StackExchange/wmi v0.0.0-20180725035823-b12b22c5341f
 	github.com/ajstarks/svgo v0.0.0-20151117013546-fd2151ebabde
 	github.com/alicebob/gopher-json v0.0.0-20180125190556-5a6b3ba71ee6 // indirect
-	github.com/alicebob/miniredis v2.5.0+incompatible // indirect
+	github.com/alicebob/miniredis v2.5.0+incompatible
 	github.com/andybalholm/cascadia v0.0.0-20150730174459-3ad29d1ad1c4 // indirect
 	github.com/aws/aws-sdk-go v1.31.12
 	github.com/aymerick/douceur v0.2.1-0.20150827151352-7176f1467381
@@ -35,6 +35,7 @@ require (
 	github.com/facebookgo/subset v0.0.0-20150612182917-8dac2c3c4870 // indirect
 	github.com/garyburd/redigo v0.0.0-20151219232044-836b6e58b335
 	github.com/glendc/gopher-json v0.0.0-20170414221815-dc4743023d0c // indirect
+	github.com/go-sql-driver/mysql v1.6.0
 	github.com/gocarina/gocsv v0.0.0-20190927101021-3ecffd272576 // indirect
 	github.com/godbus/dbus v4.0.1-0.20160727174541-7a8c533d28e8+incompatible // indirect
 	github.com/golang/freetype v0.0.0-20150924013838-f29eb116deb3 // indirect
@@ -56,8 +57,10 @@ require (
 	github.com/kardianos/osext v0.0.0-20160811001526-c2c54e542fb7
 	github.com/kylebrandt/boolq v0.0.0-20160608164548-f869a7265c7e
 	github.com/kylebrandt/gohop v0.0.0-20160712175359-f6dbeb38f6df
+	github.com/lib/pq v1.10.9
 	github.com/llgcode/draw2d v0.0.0-20151105134336-9ffe0e7eb54c // indirect
 	github.com/llgcode/ps v0.0.0-20150911083025-f1443b32eedb // indirect
+	github.com/mattn/go-sqlite3 v1.14.6
 	github.com/mhenderson-so/azure-ea-billing v0.0.0-20160829175629-4fd1a790460c
 	github.com/mitchellh/go-homedir v1.0.0 // indirect
 	github.com/mjibson/esc v0.1.0
@@ -67,8 +70,10 @@ require (
 	github.com/pkg/errors v0.9.1
 	github.com/pmezard/go-difflib v1.0.0
 	github.com/prometheus/client_golang v0.9.3-0.20190106165022-d2ead2588477
+	github.com/prometheus/client_model v0.0.0-20180712105110-5c3871d89910
 	github.com/prometheus/common v0.1.0
 	github.com/prometheus/prometheus v1.8.2-0.20190115164134-b639fe140c1f
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/ryanuber/go-glob v0.0.0-20160226084822-572520ed46db
 	github.com/siddontang/go v0.0.0-20150505004501-b151716326d7 // indirect
 	github.com/siddontang/goredis v0.0.0-20150324035039-760763f78400 // indirect
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// AlertCost is the cost of checking an alert since bosun started. Times are
+// in seconds.
+type AlertCost struct {
+	Alert       string
+	Checks      int64
+	Time        float64 // Total time spent checking the alert
+	MeanTime    float64
+	MaxTime     float64
+	LastTime    float64
+	LastCheck   time.Time
+	Results     int64 // Total number of results of the alert's expressions
+	LastResults int
+	// Backends are the queries of the checks by query type, like opentsdb
+	// or graphite.
+	Backends map[string]*QueryCost
+}
+
+// QueryCost is the cost of the queries to a backend.
+type QueryCost struct {
+	Queries   int64
+	CacheHits int64
+	Time      float64 // Total seconds spent waiting for queries, including cache hits
+}
+
+// Add adds the queries of q to c.
+func (c *QueryCost) Add(q *QueryCost) {
+	c.Queries += q.Queries
+	c.CacheHits += q.CacheHits
+	c.Time += q.Time
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// Dashboard is a saved, named collection of panels. The values of its
+// variables replace $name in the text of its panels.
+type Dashboard struct {
+	Name      string
+	Title     string `json:",omitempty"`
+	Variables []*DashboardVariable
+	Panels    []*DashboardPanel
+	// User and Modified are of the last save of the dashboard.
+	User     string
+	Modified time.Time
+}
+
+// DashboardVariable is a template variable of a dashboard whose values are
+// the values of a tag key, of Metric if it is set.
+type DashboardVariable struct {
+	Name    string
+	TagKey  string
+	Metric  string `json:",omitempty"`
+	Default string `json:",omitempty"`
+}
+
+// The types of dashboard panels.
+const (
+	PanelExpr      = "expr"      // graph of the results of Expr
+	PanelTable     = "table"     // table of the results of Expr
+	PanelQuery     = "query"     // graph of the OpenTSDB request Query, like the Graph page
+	PanelIncidents = "incidents" // open incidents matching the incident filter Filter
+	PanelHost      = "host"      // host view of Host
+)
+
+// DashboardPanel is a panel of a dashboard. Only the fields of its type are
+// set.
+type DashboardPanel struct {
+	Title  string `json:",omitempty"`
+	Type   string
+	Expr   string `json:",omitempty"`
+	Query  string `json:",omitempty"`
+	Filter string `json:",omitempty"`
+	Host   string `json:",omitempty"`
+	// Width is the width of the panel in columns of 12, defaulting to 12.
+	Width int `json:",omitempty"`
+}
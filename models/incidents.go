@@ -44,6 +44,7 @@ type IncidentState struct {
 
 	PreviousIds []int64 // A list to the previous IncidentIds for the same alert key (alertname+tagset)
 	NextId      int64   // The id of the next Incident Id for the same alert key, only added once a future incident has been created
+	ProblemId   int64   `json:",omitempty"` // The id of the problem the incident was correlated into, if any
 
 	// set of notifications we have already sent alerts to during the lifetime of the incident
 	Notifications []string
@@ -152,6 +153,17 @@ func (a EventsByTime) Len() int           { return len(a) }
 func (a EventsByTime) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a EventsByTime) Less(i, j int) bool { return a[i].Time.Before(a[j].Time) }
 
+// PendingAlert is an alert key that is abnormal, but has not been abnormal
+// for the pending duration of its alert yet, so no incident is open for it.
+type PendingAlert struct {
+	AlertKey    AlertKey
+	Alert       string
+	Status      Status // Status of the last check
+	WorstStatus Status
+	Since       time.Time // Time of the first abnormal check
+	LastCheck   time.Time
+}
+
 // custom float type to support json marshalling of NaN
 type Float float64
 
@@ -0,0 +1,70 @@
+package models
+
+import "time"
+
+// Problem is a group of concurrent incidents, possibly of different alerts,
+// that were correlated by a problem rule, like the incidents caused by a
+// failed switch.
+type Problem struct {
+	Id    int64
+	Rule  string // Name of the problem rule that correlated the incidents
+	Start time.Time
+	Last  time.Time // Start of the last incident added to the problem
+	End   *time.Time
+	Open  bool
+
+	IncidentIds []int64
+	// Alerts are the names of the alerts of the incidents.
+	Alerts []string
+	// TagValues are the values of the tags of the rule in the alert keys of
+	// the incidents, by tag key.
+	TagValues map[string][]string
+
+	WorstStatus Status
+	// NotifiedStatus is the worst status the problem notification was sent
+	// for.
+	NotifiedStatus Status
+}
+
+// Add adds the incident st, which started at t, to p.
+func (p *Problem) Add(st *IncidentState, t time.Time, tags []string) {
+	p.IncidentIds = append(p.IncidentIds, st.Id)
+	if t.After(p.Last) {
+		p.Last = t
+	}
+	if !p.HasAlert(st.Alert) {
+		p.Alerts = append(p.Alerts, st.Alert)
+	}
+	if p.TagValues == nil {
+		p.TagValues = make(map[string][]string)
+	}
+	group := st.AlertKey.Group()
+	for _, k := range tags {
+		if v, ok := group[k]; ok && !p.HasTagValue(k, v) {
+			p.TagValues[k] = append(p.TagValues[k], v)
+		}
+	}
+	if st.WorstStatus > p.WorstStatus {
+		p.WorstStatus = st.WorstStatus
+	}
+}
+
+// HasAlert returns if p has an incident of the alert name.
+func (p *Problem) HasAlert(name string) bool {
+	for _, a := range p.Alerts {
+		if a == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTagValue returns if p has an incident with the tag k=v.
+func (p *Problem) HasTagValue(k, v string) bool {
+	for _, pv := range p.TagValues[k] {
+		if pv == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// A TokenBucket is the state of a rate limit, which held Tokens at Time. A
+// bucket with a zero Time has never been used and is full.
+type TokenBucket struct {
+	Tokens float64
+	Time   time.Time
+}
+
+// Refill adds the tokens of the time since b.Time up to now to b, which
+// holds up to count tokens and refills at count tokens per per.
+func (b *TokenBucket) Refill(count int, per time.Duration, now time.Time) {
+	if b.Time.IsZero() {
+		b.Tokens = float64(count)
+	} else if d := now.Sub(b.Time); d > 0 {
+		b.Tokens += float64(count) * float64(d) / float64(per)
+	}
+	if b.Tokens > float64(count) {
+		b.Tokens = float64(count)
+	}
+	if now.After(b.Time) {
+		b.Time = now
+	}
+}
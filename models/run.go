@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// AlertRun is the result of a check of an alert. The counts are of the
+// alert keys of the alert by their status after the check.
+type AlertRun struct {
+	Time        time.Time
+	Duration    float64 // Seconds spent evaluating the alert's expressions
+	Normal      int
+	Warning     int
+	Critical    int
+	Unknown     int
+	Unevaluated int
+	Error       string `json:",omitempty"`
+}
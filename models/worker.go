@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// Worker is a bosun process checking a shard of the alerts when alert
+// checks are sharded across processes.
+type Worker struct {
+	Name      string
+	Started   time.Time
+	LastSeen  time.Time // Time of the last heartbeat
+	Alerts    int       // Number of alerts assigned to the worker
+	Checks    int64     // Number of alert checks run since Started
+	CheckTime float64   // Total seconds spent checking alerts since Started
+	LastCheck time.Time
+}
+
+// Alive returns if w has sent a heartbeat within timeout of now.
+func (w *Worker) Alive(now time.Time, timeout time.Duration) bool {
+	return now.Sub(w.LastSeen) <= timeout
+}
@@ -0,0 +1,160 @@
+package slog
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Entry is a set of fields attached to the messages logged with it.
+type Entry struct {
+	fields []Field
+}
+
+// With returns an Entry with the fields kv, given as alternating keys and
+// values. Keys are formatted with fmt.Sprint.
+func With(kv ...interface{}) *Entry {
+	return (&Entry{}).With(kv...)
+}
+
+// With returns a copy of e with the fields kv added.
+func (e *Entry) With(kv ...interface{}) *Entry {
+	fields := make([]Field, len(e.fields), len(e.fields)+(len(kv)+1)/2)
+	copy(fields, e.fields)
+	for i := 0; i < len(kv); i += 2 {
+		f := Field{Key: fmt.Sprint(kv[i])}
+		if i+1 < len(kv) {
+			f.Value = kv[i+1]
+		} else {
+			f.Key, f.Value = "!BADKEY", kv[i]
+		}
+		fields = append(fields, f)
+	}
+	return &Entry{fields: fields}
+}
+
+// Fields returns the fields of e.
+func (e *Entry) Fields() []Field {
+	return e.fields
+}
+
+// Debug logs a debug message.
+func (e *Entry) Debug(v ...interface{}) {
+	output(DebugLevel, e.fields, v...)
+}
+
+// Debugf logs a debug message.
+func (e *Entry) Debugf(format string, v ...interface{}) {
+	outputf(DebugLevel, e.fields, format, v...)
+}
+
+// Debugln logs a debug message.
+func (e *Entry) Debugln(v ...interface{}) {
+	outputln(DebugLevel, e.fields, v...)
+}
+
+// Info logs an info message.
+func (e *Entry) Info(v ...interface{}) {
+	output(InfoLevel, e.fields, v...)
+}
+
+// Infof logs an info message.
+func (e *Entry) Infof(format string, v ...interface{}) {
+	outputf(InfoLevel, e.fields, format, v...)
+}
+
+// Infoln logs an info message.
+func (e *Entry) Infoln(v ...interface{}) {
+	outputln(InfoLevel, e.fields, v...)
+}
+
+// Warning logs a warning message.
+func (e *Entry) Warning(v ...interface{}) {
+	output(WarningLevel, e.fields, v...)
+}
+
+// Warningf logs a warning message.
+func (e *Entry) Warningf(format string, v ...interface{}) {
+	outputf(WarningLevel, e.fields, format, v...)
+}
+
+// Warningln logs a warning message.
+func (e *Entry) Warningln(v ...interface{}) {
+	outputln(WarningLevel, e.fields, v...)
+}
+
+// Error logs an error message.
+func (e *Entry) Error(v ...interface{}) {
+	output(ErrorLevel, e.fields, v...)
+}
+
+// Errorf logs an error message.
+func (e *Entry) Errorf(format string, v ...interface{}) {
+	outputf(ErrorLevel, e.fields, format, v...)
+}
+
+// Errorln logs an error message.
+func (e *Entry) Errorln(v ...interface{}) {
+	outputln(ErrorLevel, e.fields, v...)
+}
+
+// Fatal logs a fatal message and calls os.Exit(1).
+func (e *Entry) Fatal(v ...interface{}) {
+	output(FatalLevel, e.fields, v...)
+	os.Exit(1)
+}
+
+// Fatalf logs a fatal message and calls os.Exit(1).
+func (e *Entry) Fatalf(format string, v ...interface{}) {
+	outputf(FatalLevel, e.fields, format, v...)
+	os.Exit(1)
+}
+
+// Fatalln logs a fatal message and calls os.Exit(1).
+func (e *Entry) Fatalln(v ...interface{}) {
+	outputln(FatalLevel, e.fields, v...)
+	os.Exit(1)
+}
+
+// FormatFields formats fields as space separated key=value pairs. Values
+// containing spaces, quotes or equal signs are quoted.
+func FormatFields(fields []Field) string {
+	var b strings.Builder
+	for i, f := range fields {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(quoteField(f.Key))
+		b.WriteByte('=')
+		b.WriteString(quoteField(fieldString(f.Value)))
+	}
+	return b.String()
+}
+
+func fieldString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func quoteField(s string) string {
+	if s == "" {
+		return `""`
+	}
+	if strings.IndexFunc(s, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == 0x7f
+	}) >= 0 {
+		return strconv.Quote(s)
+	}
+	return s
+}
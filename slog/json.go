@@ -0,0 +1,91 @@
+package slog
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// JSONLog logs messages to W as JSON objects, one per line, with the keys
+// time, level and msg followed by the fields of the message.
+type JSONLog struct {
+	W io.Writer
+
+	mu sync.Mutex
+}
+
+// SetJSON configures slog to log JSON objects to w.
+func SetJSON(w io.Writer) {
+	Set(&JSONLog{W: w})
+}
+
+// Log logs msg with fields.
+func (j *JSONLog) Log(l Level, msg string, fields []Field) {
+	var b bytes.Buffer
+	b.WriteString(`{"time":`)
+	writeJSON(&b, time.Now().UTC().Format(time.RFC3339Nano))
+	b.WriteString(`,"level":`)
+	writeJSON(&b, l.String())
+	b.WriteString(`,"msg":`)
+	writeJSON(&b, msg)
+	for _, f := range fields {
+		switch f.Key {
+		case "time", "level", "msg":
+			f.Key = "field." + f.Key
+		}
+		b.WriteByte(',')
+		writeJSON(&b, f.Key)
+		b.WriteByte(':')
+		switch v := f.Value.(type) {
+		case error:
+			writeJSON(&b, v.Error())
+		default:
+			writeJSON(&b, v)
+		}
+	}
+	b.WriteString("}\n")
+	j.mu.Lock()
+	j.W.Write(b.Bytes())
+	j.mu.Unlock()
+	if l == FatalLevel {
+		os.Exit(1)
+	}
+}
+
+// writeJSON writes the JSON encoding of v to b, or of its string
+// representation if v cannot be encoded.
+func writeJSON(b *bytes.Buffer, v interface{}) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		buf, _ = json.Marshal(fieldString(v))
+	}
+	b.Write(buf)
+}
+
+// Fatal logs a fatal message and calls os.Exit(1).
+func (j *JSONLog) Fatal(v string) {
+	j.Log(FatalLevel, rmNl(v), nil)
+}
+
+// Error logs an error message.
+func (j *JSONLog) Error(v string) {
+	j.Log(ErrorLevel, rmNl(v), nil)
+}
+
+// Info logs an info message.
+func (j *JSONLog) Info(v string) {
+	j.Log(InfoLevel, rmNl(v), nil)
+}
+
+// Warning logs a warning message.
+func (j *JSONLog) Warning(v string) {
+	j.Log(WarningLevel, rmNl(v), nil)
+}
+
+// Debug logs a debug message.
+func (j *JSONLog) Debug(v string) {
+	j.Log(DebugLevel, rmNl(v), nil)
+}
@@ -3,6 +3,14 @@
 // using the log package of the standard library, but can easily be used with
 // other logging backends. Thus, we can use syslog on unicies and the event log
 // on windows.
+//
+// Messages have a level and may carry key/value fields, attached with With:
+//
+//	slog.With("alert", a.Name, "host", host).Errorf("check failed: %v", err)
+//
+// Loggers implementing FieldLogger, like JSONLog, receive the fields
+// separately from the message. Other loggers receive them appended to the
+// message as key=value pairs.
 package slog // import "bosun.org/slog"
 
 import (
@@ -13,6 +21,7 @@ import (
 	"runtime"
 	"runtime/debug"
 	"strings"
+	"sync/atomic"
 )
 
 var (
@@ -28,6 +37,78 @@ type Logger interface {
 	Fatal(v string)
 }
 
+// FieldLogger is a Logger that receives the fields of messages separately.
+type FieldLogger interface {
+	Logger
+	Log(l Level, msg string, fields []Field)
+}
+
+// debugLogger is implemented by loggers with a debug level. Debug messages are
+// logged as info messages by other loggers.
+type debugLogger interface {
+	Debug(v string)
+}
+
+// Field is a key/value pair attached to a message.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// Level is the severity of a message.
+type Level int32
+
+// Levels in increasing order of severity.
+const (
+	DebugLevel Level = iota
+	InfoLevel
+	WarningLevel
+	ErrorLevel
+	FatalLevel
+)
+
+var levelNames = []string{"debug", "info", "warning", "error", "fatal"}
+
+func (l Level) String() string {
+	if l < DebugLevel || l > FatalLevel {
+		return fmt.Sprintf("level(%d)", int32(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel returns the level named s: debug, info, warning, error or
+// fatal.
+func ParseLevel(s string) (Level, error) {
+	for i, name := range levelNames {
+		if strings.EqualFold(s, name) {
+			return Level(i), nil
+		}
+	}
+	if strings.EqualFold(s, "warn") {
+		return WarningLevel, nil
+	}
+	return 0, fmt.Errorf("slog: unknown level %q", s)
+}
+
+var minLevel = int32(InfoLevel)
+
+// SetLevel sets the minimum level of logged messages. The default is
+// InfoLevel.
+func SetLevel(l Level) {
+	atomic.StoreInt32(&minLevel, int32(l))
+}
+
+// GetLevel returns the minimum level of logged messages.
+func GetLevel() Level {
+	return Level(atomic.LoadInt32(&minLevel))
+}
+
+// Enabled returns true if messages of level l are logged. Use it to avoid
+// building expensive messages or fields.
+func Enabled(l Level) bool {
+	return l >= GetLevel()
+}
+
 // StdLog logs to a log.Logger.
 type StdLog struct {
 	Log *log.Logger
@@ -53,6 +134,11 @@ func (s *StdLog) Warning(v string) {
 	s.Log.Println("warning:", rmNl(v))
 }
 
+// Debug logs a debug message.
+func (s *StdLog) Debug(v string) {
+	s.Log.Println("debug:", rmNl(v))
+}
+
 func rmNl(v string) string {
 	if strings.HasSuffix(v, "\n") {
 		v = v[:len(v)-1]
@@ -67,89 +153,137 @@ func Set(l Logger) {
 	logging = l
 }
 
+// Debug logs a debug message.
+func Debug(v ...interface{}) {
+	output(DebugLevel, nil, v...)
+}
+
+// Debugf logs a debug message.
+func Debugf(format string, v ...interface{}) {
+	outputf(DebugLevel, nil, format, v...)
+}
+
+// Debugln logs a debug message.
+func Debugln(v ...interface{}) {
+	outputln(DebugLevel, nil, v...)
+}
+
 // Info logs an info message.
 func Info(v ...interface{}) {
-	output(logging.Info, v...)
+	output(InfoLevel, nil, v...)
 }
 
 // Infof logs an info message.
 func Infof(format string, v ...interface{}) {
-	outputf(logging.Info, format, v...)
+	outputf(InfoLevel, nil, format, v...)
 }
 
 // Infoln logs an info message.
 func Infoln(v ...interface{}) {
-	outputln(logging.Info, v...)
+	outputln(InfoLevel, nil, v...)
 }
 
 // Warning logs a warning message.
 func Warning(v ...interface{}) {
-	output(logging.Warning, v...)
+	output(WarningLevel, nil, v...)
 }
 
 // Warningf logs a warning message.
 func Warningf(format string, v ...interface{}) {
-	outputf(logging.Warning, format, v...)
+	outputf(WarningLevel, nil, format, v...)
 }
 
 // Warningln logs a warning message.
 func Warningln(v ...interface{}) {
-	outputln(logging.Warning, v...)
+	outputln(WarningLevel, nil, v...)
 }
 
 // Error logs an error message.
 func Error(v ...interface{}) {
-	output(logging.Error, v...)
+	output(ErrorLevel, nil, v...)
 }
 
 // Errorf logs an error message.
 func Errorf(format string, v ...interface{}) {
-	outputf(logging.Error, format, v...)
+	outputf(ErrorLevel, nil, format, v...)
 }
 
 // Errorln logs an error message.
 func Errorln(v ...interface{}) {
-	outputln(logging.Error, v...)
+	outputln(ErrorLevel, nil, v...)
 }
 
 // Fatal logs a fatal message and calls os.Exit(1).
 func Fatal(v ...interface{}) {
-	output(logging.Fatal, v...)
+	output(FatalLevel, nil, v...)
 	// Call os.Exit here just in case the logging package we are using doesn't.
 	os.Exit(1)
 }
 
 // Fatalf logs a fatal message and calls os.Exit(1).
 func Fatalf(format string, v ...interface{}) {
-	outputf(logging.Fatal, format, v...)
+	outputf(FatalLevel, nil, format, v...)
 	os.Exit(1)
 }
 
 // Fatalln logs a fatal message and calls os.Exit(1).
 func Fatalln(v ...interface{}) {
-	outputln(logging.Fatal, v...)
+	outputln(FatalLevel, nil, v...)
 	os.Exit(1)
 }
 
-func out(f func(string), s string) {
+func out(l Level, fields []Field, s string) {
+	if !Enabled(l) {
+		return
+	}
+	var caller string
 	if LogLineNumber {
 		if _, filename, line, ok := runtime.Caller(3); ok {
-			s = fmt.Sprintf("%s:%d: %v", filepath.Base(filename), line, s)
+			caller = fmt.Sprintf("%s:%d", filepath.Base(filename), line)
+		}
+	}
+	logger := logging
+	if fl, ok := logger.(FieldLogger); ok {
+		if caller != "" {
+			fields = append([]Field{{"caller", caller}}, fields...)
+		}
+		fl.Log(l, rmNl(s), fields)
+		return
+	}
+	if caller != "" {
+		s = fmt.Sprintf("%s: %v", caller, s)
+	}
+	if len(fields) > 0 {
+		s = rmNl(s) + " " + FormatFields(fields)
+	}
+	switch l {
+	case DebugLevel:
+		if dl, ok := logger.(debugLogger); ok {
+			dl.Debug(s)
+		} else {
+			logger.Info(s)
 		}
+	case InfoLevel:
+		logger.Info(s)
+	case WarningLevel:
+		logger.Warning(s)
+	case ErrorLevel:
+		logger.Error(s)
+	default:
+		logger.Fatal(s)
 	}
-	f(s)
 }
 
-func output(f func(string), v ...interface{}) {
-	out(f, fmt.Sprint(v...))
+func output(l Level, fields []Field, v ...interface{}) {
+	out(l, fields, fmt.Sprint(v...))
 }
 
-func outputf(f func(string), format string, v ...interface{}) {
-	out(f, fmt.Sprintf(format, v...))
+func outputf(l Level, fields []Field, format string, v ...interface{}) {
+	out(l, fields, fmt.Sprintf(format, v...))
 }
 
-func outputln(f func(string), v ...interface{}) {
-	out(f, fmt.Sprintln(v...))
+func outputln(l Level, fields []Field, v ...interface{}) {
+	out(l, fields, fmt.Sprintln(v...))
 }
 
 type wrappedError struct {
@@ -0,0 +1,81 @@
+package slog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+)
+
+func TestStdLogFields(t *testing.T) {
+	var buf bytes.Buffer
+	defer func(l Logger, lvl Level, line bool) {
+		Set(l)
+		SetLevel(lvl)
+		LogLineNumber = line
+	}(logging, GetLevel(), LogLineNumber)
+	Set(&StdLog{Log: log.New(&buf, "", 0)})
+	LogLineNumber = false
+
+	Debugf("hidden %d", 1)
+	With("alert", "os.cpu", "host", "ny web01", "err", errors.New(`bad "value"`)).Errorf("check %s", "failed")
+	With("odd").Infoln("done")
+	SetLevel(DebugLevel)
+	Debug("shown")
+	expected := `error: check failed alert=os.cpu host="ny web01" err="bad \"value\""
+info: done !BADKEY=odd
+debug: shown
+`
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestJSONLog(t *testing.T) {
+	var buf bytes.Buffer
+	defer func(l Logger, lvl Level) {
+		Set(l)
+		SetLevel(lvl)
+	}(logging, GetLevel())
+	SetJSON(&buf)
+	SetLevel(WarningLevel)
+
+	e := With("alert", "os.cpu")
+	e.Info("hidden")
+	e.With("incident", int64(42), "msg", "x").Warningf("slow\n")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected one line, got %q", buf.String())
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &m); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range map[string]interface{}{
+		"level":     "warning",
+		"msg":       "slow",
+		"alert":     "os.cpu",
+		"incident":  float64(42),
+		"field.msg": "x",
+	} {
+		if m[k] != v {
+			t.Errorf("%s: expected %v, got %v", k, v, m[k])
+		}
+	}
+	if c, _ := m["caller"].(string); !strings.HasPrefix(c, "slog_test.go:") {
+		t.Errorf("unexpected caller %v", m["caller"])
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for s, l := range map[string]Level{"debug": DebugLevel, "INFO": InfoLevel, "warn": WarningLevel, "error": ErrorLevel} {
+		if got, err := ParseLevel(s); err != nil || got != l {
+			t.Errorf("%s: expected %v, got %v, %v", s, l, got, err)
+		}
+	}
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Errorf("expected error")
+	}
+}
@@ -32,3 +32,7 @@ func (e *eventLog) Warning(v string) {
 func (e *eventLog) Error(v string) {
 	e.l.Error(e.id, fmt.Sprintf("error: %s", v))
 }
+
+func (e *eventLog) Debug(v string) {
+	e.l.Info(e.id, fmt.Sprintf("debug: %s", v))
+}
@@ -0,0 +1,600 @@
+package mib
+
+// builtin holds the modules that most MIBs import from, so that MIBs can be
+// used without a net-snmp installation, and the common objects of the system
+// and interfaces groups. Macro definitions and descriptions are omitted. Full
+// versions of these modules in a MIB directory replace them.
+var builtin = []string{
+	`
+SNMPv2-SMI DEFINITIONS ::= BEGIN
+
+org            OBJECT IDENTIFIER ::= { iso 3 }
+dod            OBJECT IDENTIFIER ::= { org 6 }
+internet       OBJECT IDENTIFIER ::= { dod 1 }
+directory      OBJECT IDENTIFIER ::= { internet 1 }
+mgmt           OBJECT IDENTIFIER ::= { internet 2 }
+mib-2          OBJECT IDENTIFIER ::= { mgmt 1 }
+transmission   OBJECT IDENTIFIER ::= { mib-2 10 }
+experimental   OBJECT IDENTIFIER ::= { internet 3 }
+private        OBJECT IDENTIFIER ::= { internet 4 }
+enterprises    OBJECT IDENTIFIER ::= { private 1 }
+security       OBJECT IDENTIFIER ::= { internet 5 }
+snmpV2         OBJECT IDENTIFIER ::= { internet 6 }
+snmpDomains    OBJECT IDENTIFIER ::= { snmpV2 1 }
+snmpProxys     OBJECT IDENTIFIER ::= { snmpV2 2 }
+snmpModules    OBJECT IDENTIFIER ::= { snmpV2 3 }
+
+zeroDotZero OBJECT-IDENTITY
+    STATUS     current
+    ::= { 0 0 }
+
+ObjectName ::= OBJECT IDENTIFIER
+NotificationName ::= OBJECT IDENTIFIER
+Integer32 ::= INTEGER (-2147483648..2147483647)
+IpAddress ::= [APPLICATION 0] IMPLICIT OCTET STRING (SIZE (4))
+Counter32 ::= [APPLICATION 1] IMPLICIT INTEGER (0..4294967295)
+Gauge32 ::= [APPLICATION 2] IMPLICIT INTEGER (0..4294967295)
+Unsigned32 ::= [APPLICATION 2] IMPLICIT INTEGER (0..4294967295)
+TimeTicks ::= [APPLICATION 3] IMPLICIT INTEGER (0..4294967295)
+Opaque ::= [APPLICATION 4] IMPLICIT OCTET STRING
+Counter64 ::= [APPLICATION 6] IMPLICIT INTEGER (0..18446744073709551615)
+
+END
+`, `
+RFC1155-SMI DEFINITIONS ::= BEGIN
+
+internet      OBJECT IDENTIFIER ::= { iso org(3) dod(6) 1 }
+directory     OBJECT IDENTIFIER ::= { internet 1 }
+mgmt          OBJECT IDENTIFIER ::= { internet 2 }
+experimental  OBJECT IDENTIFIER ::= { internet 3 }
+private       OBJECT IDENTIFIER ::= { internet 4 }
+enterprises   OBJECT IDENTIFIER ::= { private 1 }
+
+ObjectName ::= OBJECT IDENTIFIER
+NetworkAddress ::= CHOICE { internet IpAddress }
+IpAddress ::= [APPLICATION 0] IMPLICIT OCTET STRING (SIZE (4))
+Counter ::= [APPLICATION 1] IMPLICIT INTEGER (0..4294967295)
+Gauge ::= [APPLICATION 2] IMPLICIT INTEGER (0..4294967295)
+TimeTicks ::= [APPLICATION 3] IMPLICIT INTEGER (0..4294967295)
+Opaque ::= [APPLICATION 4] IMPLICIT OCTET STRING
+
+END
+`, `
+SNMPv2-CONF DEFINITIONS ::= BEGIN
+END
+`, `
+RFC-1212 DEFINITIONS ::= BEGIN
+END
+`, `
+RFC-1215 DEFINITIONS ::= BEGIN
+END
+`, `
+SNMPv2-TC DEFINITIONS ::= BEGIN
+
+IMPORTS
+    TimeTicks FROM SNMPv2-SMI;
+
+DisplayString ::= TEXTUAL-CONVENTION
+    DISPLAY-HINT "255a"
+    STATUS       current
+    SYNTAX       OCTET STRING (SIZE (0..255))
+
+PhysAddress ::= TEXTUAL-CONVENTION
+    DISPLAY-HINT "1x:"
+    STATUS       current
+    SYNTAX       OCTET STRING
+
+MacAddress ::= TEXTUAL-CONVENTION
+    DISPLAY-HINT "1x:"
+    STATUS       current
+    SYNTAX       OCTET STRING (SIZE (6))
+
+TruthValue ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       INTEGER { true(1), false(2) }
+
+TestAndIncr ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       INTEGER (0..2147483647)
+
+AutonomousType ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       OBJECT IDENTIFIER
+
+InstancePointer ::= TEXTUAL-CONVENTION
+    STATUS       obsolete
+    SYNTAX       OBJECT IDENTIFIER
+
+VariablePointer ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       OBJECT IDENTIFIER
+
+RowPointer ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       OBJECT IDENTIFIER
+
+RowStatus ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       INTEGER {
+                     active(1),
+                     notInService(2),
+                     notReady(3),
+                     createAndGo(4),
+                     createAndWait(5),
+                     destroy(6)
+                 }
+
+TimeStamp ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       TimeTicks
+
+TimeInterval ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       INTEGER (0..2147483647)
+
+DateAndTime ::= TEXTUAL-CONVENTION
+    DISPLAY-HINT "2d-1d-1d,1d:1d:1d.1d,1a1d:1d"
+    STATUS       current
+    SYNTAX       OCTET STRING (SIZE (8 | 11))
+
+StorageType ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       INTEGER {
+                     other(1),
+                     volatile(2),
+                     nonVolatile(3),
+                     permanent(4),
+                     readOnly(5)
+                 }
+
+TDomain ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       OBJECT IDENTIFIER
+
+TAddress ::= TEXTUAL-CONVENTION
+    STATUS       current
+    SYNTAX       OCTET STRING (SIZE (1..255))
+
+END
+`, `
+SNMPv2-MIB DEFINITIONS ::= BEGIN
+
+IMPORTS
+    MODULE-IDENTITY, OBJECT-TYPE, NOTIFICATION-TYPE,
+    TimeTicks, mib-2, snmpModules
+        FROM SNMPv2-SMI
+    DisplayString
+        FROM SNMPv2-TC;
+
+snmpMIB MODULE-IDENTITY
+    LAST-UPDATED "200210160000Z"
+    ORGANIZATION "IETF SNMPv3 Working Group"
+    CONTACT-INFO ""
+    DESCRIPTION  "The MIB module for SNMP entities."
+    ::= { snmpModules 1 }
+
+snmpMIBObjects OBJECT IDENTIFIER ::= { snmpMIB 1 }
+
+system OBJECT IDENTIFIER ::= { mib-2 1 }
+
+sysDescr OBJECT-TYPE
+    SYNTAX      DisplayString (SIZE (0..255))
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { system 1 }
+
+sysObjectID OBJECT-TYPE
+    SYNTAX      OBJECT IDENTIFIER
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { system 2 }
+
+sysUpTime OBJECT-TYPE
+    SYNTAX      TimeTicks
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { system 3 }
+
+sysContact OBJECT-TYPE
+    SYNTAX      DisplayString (SIZE (0..255))
+    MAX-ACCESS  read-write
+    STATUS      current
+    ::= { system 4 }
+
+sysName OBJECT-TYPE
+    SYNTAX      DisplayString (SIZE (0..255))
+    MAX-ACCESS  read-write
+    STATUS      current
+    ::= { system 5 }
+
+sysLocation OBJECT-TYPE
+    SYNTAX      DisplayString (SIZE (0..255))
+    MAX-ACCESS  read-write
+    STATUS      current
+    ::= { system 6 }
+
+sysServices OBJECT-TYPE
+    SYNTAX      INTEGER (0..127)
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { system 7 }
+
+snmp OBJECT IDENTIFIER ::= { mib-2 11 }
+
+snmpTrap OBJECT IDENTIFIER ::= { snmpMIBObjects 4 }
+
+snmpTrapOID OBJECT-TYPE
+    SYNTAX      OBJECT IDENTIFIER
+    MAX-ACCESS  accessible-for-notify
+    STATUS      current
+    ::= { snmpTrap 1 }
+
+snmpTrapEnterprise OBJECT-TYPE
+    SYNTAX      OBJECT IDENTIFIER
+    MAX-ACCESS  accessible-for-notify
+    STATUS      current
+    ::= { snmpTrap 3 }
+
+snmpTraps OBJECT IDENTIFIER ::= { snmpMIBObjects 5 }
+
+coldStart NOTIFICATION-TYPE
+    STATUS  current
+    ::= { snmpTraps 1 }
+
+warmStart NOTIFICATION-TYPE
+    STATUS  current
+    ::= { snmpTraps 2 }
+
+authenticationFailure NOTIFICATION-TYPE
+    STATUS  current
+    ::= { snmpTraps 5 }
+
+END
+`, `
+IF-MIB DEFINITIONS ::= BEGIN
+
+IMPORTS
+    MODULE-IDENTITY, OBJECT-TYPE, Counter32, Gauge32, Counter64,
+    Integer32, TimeTicks, mib-2, NOTIFICATION-TYPE
+        FROM SNMPv2-SMI
+    TEXTUAL-CONVENTION, DisplayString, PhysAddress, TruthValue,
+    TimeStamp
+        FROM SNMPv2-TC
+    snmpTraps
+        FROM SNMPv2-MIB
+    IANAifType
+        FROM IANAifType-MIB;
+
+ifMIB MODULE-IDENTITY
+    LAST-UPDATED "200006140000Z"
+    ORGANIZATION "IETF Interfaces MIB Working Group"
+    CONTACT-INFO ""
+    DESCRIPTION  "The MIB module to describe generic objects for network
+                 interface sub-layers."
+    ::= { mib-2 31 }
+
+ifMIBObjects OBJECT IDENTIFIER ::= { ifMIB 1 }
+
+interfaces OBJECT IDENTIFIER ::= { mib-2 2 }
+
+InterfaceIndex ::= TEXTUAL-CONVENTION
+    DISPLAY-HINT "d"
+    STATUS       current
+    SYNTAX       Integer32 (1..2147483647)
+
+ifNumber OBJECT-TYPE
+    SYNTAX      Integer32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { interfaces 1 }
+
+ifTable OBJECT-TYPE
+    SYNTAX      SEQUENCE OF IfEntry
+    MAX-ACCESS  not-accessible
+    STATUS      current
+    ::= { interfaces 2 }
+
+ifEntry OBJECT-TYPE
+    SYNTAX      IfEntry
+    MAX-ACCESS  not-accessible
+    STATUS      current
+    INDEX       { ifIndex }
+    ::= { ifTable 1 }
+
+IfEntry ::= SEQUENCE {
+    ifIndex InterfaceIndex, ifDescr DisplayString, ifType IANAifType,
+    ifMtu Integer32, ifSpeed Gauge32, ifPhysAddress PhysAddress,
+    ifAdminStatus INTEGER, ifOperStatus INTEGER, ifLastChange TimeTicks,
+    ifInOctets Counter32, ifInUcastPkts Counter32,
+    ifInNUcastPkts Counter32, ifInDiscards Counter32,
+    ifInErrors Counter32, ifInUnknownProtos Counter32,
+    ifOutOctets Counter32, ifOutUcastPkts Counter32,
+    ifOutNUcastPkts Counter32, ifOutDiscards Counter32,
+    ifOutErrors Counter32
+}
+
+ifIndex OBJECT-TYPE
+    SYNTAX      InterfaceIndex
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 1 }
+
+ifDescr OBJECT-TYPE
+    SYNTAX      DisplayString (SIZE (0..255))
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 2 }
+
+ifType OBJECT-TYPE
+    SYNTAX      IANAifType
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 3 }
+
+ifMtu OBJECT-TYPE
+    SYNTAX      Integer32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 4 }
+
+ifSpeed OBJECT-TYPE
+    SYNTAX      Gauge32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 5 }
+
+ifPhysAddress OBJECT-TYPE
+    SYNTAX      PhysAddress
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 6 }
+
+ifAdminStatus OBJECT-TYPE
+    SYNTAX  INTEGER {
+                up(1),
+                down(2),
+                testing(3)
+            }
+    MAX-ACCESS  read-write
+    STATUS      current
+    ::= { ifEntry 7 }
+
+ifOperStatus OBJECT-TYPE
+    SYNTAX  INTEGER {
+                up(1),
+                down(2),
+                testing(3),
+                unknown(4),
+                dormant(5),
+                notPresent(6),
+                lowerLayerDown(7)
+            }
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 8 }
+
+ifLastChange OBJECT-TYPE
+    SYNTAX      TimeTicks
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 9 }
+
+ifInOctets OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 10 }
+
+ifInUcastPkts OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 11 }
+
+ifInNUcastPkts OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      deprecated
+    ::= { ifEntry 12 }
+
+ifInDiscards OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 13 }
+
+ifInErrors OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 14 }
+
+ifInUnknownProtos OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 15 }
+
+ifOutOctets OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 16 }
+
+ifOutUcastPkts OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 17 }
+
+ifOutNUcastPkts OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      deprecated
+    ::= { ifEntry 18 }
+
+ifOutDiscards OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 19 }
+
+ifOutErrors OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifEntry 20 }
+
+ifXTable OBJECT-TYPE
+    SYNTAX      SEQUENCE OF IfXEntry
+    MAX-ACCESS  not-accessible
+    STATUS      current
+    ::= { ifMIBObjects 1 }
+
+ifXEntry OBJECT-TYPE
+    SYNTAX      IfXEntry
+    MAX-ACCESS  not-accessible
+    STATUS      current
+    AUGMENTS    { ifEntry }
+    ::= { ifXTable 1 }
+
+IfXEntry ::= SEQUENCE {
+    ifName DisplayString, ifInMulticastPkts Counter32,
+    ifInBroadcastPkts Counter32, ifOutMulticastPkts Counter32,
+    ifOutBroadcastPkts Counter32, ifHCInOctets Counter64,
+    ifHCInUcastPkts Counter64, ifHCInMulticastPkts Counter64,
+    ifHCInBroadcastPkts Counter64, ifHCOutOctets Counter64,
+    ifHCOutUcastPkts Counter64, ifHCOutMulticastPkts Counter64,
+    ifHCOutBroadcastPkts Counter64, ifLinkUpDownTrapEnable INTEGER,
+    ifHighSpeed Gauge32, ifPromiscuousMode TruthValue,
+    ifConnectorPresent TruthValue, ifAlias DisplayString,
+    ifCounterDiscontinuityTime TimeStamp
+}
+
+ifName OBJECT-TYPE
+    SYNTAX      DisplayString
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 1 }
+
+ifInMulticastPkts OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 2 }
+
+ifInBroadcastPkts OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 3 }
+
+ifOutMulticastPkts OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 4 }
+
+ifOutBroadcastPkts OBJECT-TYPE
+    SYNTAX      Counter32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 5 }
+
+ifHCInOctets OBJECT-TYPE
+    SYNTAX      Counter64
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 6 }
+
+ifHCInUcastPkts OBJECT-TYPE
+    SYNTAX      Counter64
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 7 }
+
+ifHCInMulticastPkts OBJECT-TYPE
+    SYNTAX      Counter64
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 8 }
+
+ifHCInBroadcastPkts OBJECT-TYPE
+    SYNTAX      Counter64
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 9 }
+
+ifHCOutOctets OBJECT-TYPE
+    SYNTAX      Counter64
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 10 }
+
+ifHCOutUcastPkts OBJECT-TYPE
+    SYNTAX      Counter64
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 11 }
+
+ifHCOutMulticastPkts OBJECT-TYPE
+    SYNTAX      Counter64
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 12 }
+
+ifHCOutBroadcastPkts OBJECT-TYPE
+    SYNTAX      Counter64
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 13 }
+
+ifLinkUpDownTrapEnable OBJECT-TYPE
+    SYNTAX      INTEGER { enabled(1), disabled(2) }
+    MAX-ACCESS  read-write
+    STATUS      current
+    ::= { ifXEntry 14 }
+
+ifHighSpeed OBJECT-TYPE
+    SYNTAX      Gauge32
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 15 }
+
+ifPromiscuousMode OBJECT-TYPE
+    SYNTAX      TruthValue
+    MAX-ACCESS  read-write
+    STATUS      current
+    ::= { ifXEntry 16 }
+
+ifConnectorPresent OBJECT-TYPE
+    SYNTAX      TruthValue
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 17 }
+
+ifAlias OBJECT-TYPE
+    SYNTAX      DisplayString (SIZE(0..64))
+    MAX-ACCESS  read-write
+    STATUS      current
+    ::= { ifXEntry 18 }
+
+ifCounterDiscontinuityTime OBJECT-TYPE
+    SYNTAX      TimeStamp
+    MAX-ACCESS  read-only
+    STATUS      current
+    ::= { ifXEntry 19 }
+
+linkDown NOTIFICATION-TYPE
+    OBJECTS { ifIndex, ifAdminStatus, ifOperStatus }
+    STATUS  current
+    ::= { snmpTraps 3 }
+
+linkUp NOTIFICATION-TYPE
+    OBJECTS { ifIndex, ifAdminStatus, ifOperStatus }
+    STATUS  current
+    ::= { snmpTraps 4 }
+
+END
+`,
+}
@@ -2,9 +2,11 @@
 package mib
 
 import (
-	"bytes"
 	"fmt"
-	"os/exec"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -12,19 +14,59 @@ import (
 	"bosun.org/snmp/asn1"
 )
 
-var mibDir = ""
+// systemDirs are the directories of the standard system MIBs, which are
+// always loaded if they exist. They are overridden by the MIBDIRS
+// environment variable, a colon separated list of directories.
+var systemDirs = []string{
+	"/usr/share/snmp/mibs",
+	"/usr/local/share/snmp/mibs",
+}
 
 // Load registers an additional directory with MIB files. The standard
 // system MIBs are always pre-loaded.
 func Load(dir string) {
-	if mibDir == "" {
-		mibDir = dir
-	} else {
-		mibDir += ":" + dir
-	}
+	reg.Lock()
+	defer reg.Unlock()
+	reg.dirs = append(reg.dirs, dir)
+	reg.indexed = false
+	cache.Lock()
+	cache.lookup = make(map[string]asn1.ObjectIdentifier)
+	cache.Unlock()
+}
+
+// Object is an object defined by a MIB module.
+type Object struct {
+	Module string
+	Name   string
+	OID    asn1.ObjectIdentifier
+	// Kind is the macro defining the object, for example OBJECT-TYPE or
+	// NOTIFICATION-TYPE, or OBJECT IDENTIFIER for plain OID assignments.
+	Kind string
+	// Syntax is the syntax of an OBJECT-TYPE, otherwise nil.
+	Syntax *Type
+}
+
+// Type is the syntax of an object.
+type Type struct {
+	// Name is the textual convention or type of the syntax, for example
+	// DisplayString or INTEGER.
+	Name string
+	// Base is the SMI base type, for example OCTET STRING or Counter32. It
+	// is empty if the type could not be resolved.
+	Base string
+	// DisplayHint is the DISPLAY-HINT of the textual convention, if any.
+	DisplayHint string
+	// Enums maps the values of an enumerated INTEGER, or the bit positions of
+	// BITS, to their names.
+	Enums map[int]string
+
+	// ref is the name of the type the syntax refers to.
+	ref      string
+	resolved bool
 }
 
-// Lookup looks up the given object prefix using the snmptranslate utility.
+// Lookup looks up the given object prefix, for example
+// "SNMPv2-MIB::sysName.0", "ifDescr" or "1.3.6.1.2.1.1.5.0".
 func Lookup(prefix string) (asn1.ObjectIdentifier, error) {
 	cache.Lock()
 	if oid, ok := cache.lookup[prefix]; ok {
@@ -32,33 +74,13 @@ func Lookup(prefix string) (asn1.ObjectIdentifier, error) {
 		return oid, nil
 	}
 	cache.Unlock()
-	if oid, err := parseOID(prefix); err == nil {
-		cache.Lock()
-		cache.lookup[prefix] = oid
-		cache.Unlock()
-		return oid, nil
-	}
-	cmd := exec.Command(
-		"snmptranslate",
-		"-Le",
-		"-M", "+"+mibDir,
-		"-m", "all",
-		"-On",
-		prefix,
-	)
-	stdout := new(bytes.Buffer)
-	stderr := new(bytes.Buffer)
-	cmd.Stdout = stdout
-	cmd.Stderr = stderr
-	if err := cmd.Run(); err != nil {
-		return nil, fmt.Errorf("snmp: Lookup(%q): %q: %s", prefix, cmd.Args, err)
-	}
-	if stderr.Len() != 0 {
-		return nil, fmt.Errorf("snmp: Lookup(%q): %q: %s", prefix, cmd.Args, stderr)
-	}
-	oid, err := parseOID(strings.TrimSpace(stdout.String()))
+	oid, err := parseOID(prefix)
 	if err != nil {
-		return nil, err
+		obj, suffix, lerr := describe(prefix)
+		if lerr != nil {
+			return nil, fmt.Errorf("snmp: Lookup(%q): %v", prefix, lerr)
+		}
+		oid = append(append(asn1.ObjectIdentifier{}, obj.OID...), suffix...)
 	}
 	cache.Lock()
 	cache.lookup[prefix] = oid
@@ -66,20 +88,301 @@ func Lookup(prefix string) (asn1.ObjectIdentifier, error) {
 	return oid, nil
 }
 
+// Describe returns the object named by name, which is like the prefix of
+// Lookup. Instance suffixes are ignored, so numeric OIDs of any instance of an
+// object, for example "1.3.6.1.2.1.2.2.1.8.3", return the object.
+func Describe(name string) (*Object, error) {
+	obj, _, err := describe(name)
+	if err != nil {
+		return nil, fmt.Errorf("snmp: Describe(%q): %v", name, err)
+	}
+	return obj, nil
+}
+
+// Translate returns the module qualified name of oid, for example
+// "IF-MIB::ifDescr.2". It returns false if no prefix of oid is known.
+func Translate(oid asn1.ObjectIdentifier) (string, bool) {
+	reg.Lock()
+	defer reg.Unlock()
+	reg.index()
+	obj, suffix := reg.find(oid)
+	if obj == nil {
+		return "", false
+	}
+	s := obj.Module + "::" + obj.Name
+	for _, id := range suffix {
+		s += "." + strconv.Itoa(id)
+	}
+	return s, true
+}
+
+func describe(name string) (*Object, []int, error) {
+	reg.Lock()
+	defer reg.Unlock()
+	reg.index()
+	if oid, err := parseOID(name); err == nil {
+		obj, suffix := reg.find(oid)
+		if obj == nil {
+			return nil, nil, fmt.Errorf("unknown object")
+		}
+		return obj, suffix, nil
+	}
+	mod := ""
+	if i := strings.Index(name, "::"); i >= 0 {
+		mod, name = name[:i], name[i+2:]
+		if reg.modules[mod] == nil {
+			return nil, nil, fmt.Errorf("unknown module %q", mod)
+		}
+	}
+	var suffix []int
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		s, err := parseOID(name[i+1:])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid instance %q", name[i+1:])
+		}
+		name, suffix = name[:i], s
+	}
+	var obj *Object
+	if mod != "" {
+		obj = reg.objects[mod+"::"+name]
+	} else {
+		obj = reg.names[name]
+	}
+	if obj == nil {
+		return nil, nil, fmt.Errorf("unknown object %q", name)
+	}
+	return obj, suffix, nil
+}
+
 func init() {
 	cache.lookup = make(map[string]asn1.ObjectIdentifier)
+	if dirs := os.Getenv("MIBDIRS"); dirs != "" {
+		systemDirs = filepath.SplitList(dirs)
+	}
 }
 
-// cache avoids excessive use of snmptranslate.
+// cache avoids repeated parsing of names.
 var cache struct {
 	lookup map[string]asn1.ObjectIdentifier
 	sync.Mutex
 }
 
+// reg holds the modules of the built-in and loaded MIBs.
+var reg registry
+
+type registry struct {
+	sync.Mutex
+	dirs    []string
+	indexed bool
+
+	modules map[string]*module
+	// objects maps module qualified names to objects.
+	objects map[string]*Object
+	// names maps names to objects of the first module defining them.
+	names map[string]*Object
+	// oids maps OID strings to objects.
+	oids map[string]*Object
+}
+
+// index parses the built-in modules and the files of the MIB directories, and
+// resolves the OIDs and types of their objects. Modules of files override
+// built-in modules of the same name. Files that are not MIB modules are
+// ignored. r must be locked.
+func (r *registry) index() {
+	if r.indexed {
+		return
+	}
+	r.indexed = true
+	r.modules = make(map[string]*module)
+	for _, src := range builtin {
+		mods, err := parseModules(src)
+		if err != nil {
+			panic(fmt.Errorf("mib: built-in module: %v", err))
+		}
+		r.add(mods)
+	}
+	for _, dir := range append(append([]string{}, systemDirs...), r.dirs...) {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, fi := range files {
+			if fi.IsDir() || strings.HasPrefix(fi.Name(), ".") {
+				continue
+			}
+			b, err := ioutil.ReadFile(filepath.Join(dir, fi.Name()))
+			if err != nil {
+				continue
+			}
+			if mods, err := parseModules(string(b)); err == nil {
+				r.add(mods)
+			}
+		}
+	}
+	r.objects = make(map[string]*Object)
+	r.names = make(map[string]*Object)
+	r.oids = make(map[string]*Object)
+	for _, m := range r.sortedModules() {
+		for _, name := range m.order {
+			n := m.nodes[name]
+			oid, err := r.resolveNode(m, n, 0)
+			if err != nil {
+				continue
+			}
+			obj := &Object{
+				Module: m.name,
+				Name:   name,
+				OID:    asn1.ObjectIdentifier(oid),
+				Kind:   n.kind,
+			}
+			if n.syntax != nil {
+				r.resolveType(m, n.syntax, 0)
+				t := *n.syntax
+				obj.Syntax = &t
+			}
+			r.objects[m.name+"::"+name] = obj
+			if _, ok := r.names[name]; !ok {
+				r.names[name] = obj
+			}
+			if k := obj.OID.String(); r.oids[k] == nil {
+				r.oids[k] = obj
+			}
+		}
+	}
+}
+
+func (r *registry) add(mods []*module) {
+	for _, m := range mods {
+		r.modules[m.name] = m
+	}
+}
+
+func (r *registry) sortedModules() []*module {
+	mods := make([]*module, 0, len(r.modules))
+	for _, m := range r.modules {
+		mods = append(mods, m)
+	}
+	sort.Slice(mods, func(i, j int) bool { return mods[i].name < mods[j].name })
+	return mods
+}
+
+// roots are the OID roots defined by ASN.1 itself.
+var roots = map[string]int{
+	"ccitt":           0,
+	"iso":             1,
+	"joint-iso-ccitt": 2,
+}
+
+// maxDepth limits the resolution of symbols referring to each other.
+const maxDepth = 64
+
+// symbol returns the module defining the symbol name used in m, preferring
+// definitions of m, then imports, then any module. MIBs frequently use
+// symbols they do not import.
+func (r *registry) symbol(m *module, name string, defined func(*module) bool) *module {
+	if defined(m) {
+		return m
+	}
+	if im := r.modules[m.imports[name]]; im != nil && defined(im) {
+		return im
+	}
+	for _, im := range r.sortedModules() {
+		if defined(im) {
+			return im
+		}
+	}
+	return nil
+}
+
+func (r *registry) resolveNode(m *module, n *node, depth int) ([]int, error) {
+	if n.oid != nil {
+		return n.oid, nil
+	}
+	if n.resolving || depth > maxDepth {
+		return nil, fmt.Errorf("%s: recursive OID definition", m.name)
+	}
+	var parent []int
+	if n.parent != "" {
+		if id, ok := roots[n.parent]; ok && m.nodes[n.parent] == nil {
+			parent = []int{id}
+		} else {
+			pm := r.symbol(m, n.parent, func(m *module) bool { return m.nodes[n.parent] != nil })
+			if pm == nil {
+				return nil, fmt.Errorf("%s: unknown symbol %q", m.name, n.parent)
+			}
+			n.resolving = true
+			var err error
+			parent, err = r.resolveNode(pm, pm.nodes[n.parent], depth+1)
+			n.resolving = false
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	n.oid = append(append([]int{}, parent...), n.ids...)
+	return n.oid, nil
+}
+
+// baseTypes are the types that are not resolved further.
+var baseTypes = map[string]bool{
+	"INTEGER":           true,
+	"OCTET STRING":      true,
+	"OBJECT IDENTIFIER": true,
+	"BITS":              true,
+	"Integer32":         true,
+	"Unsigned32":        true,
+	"Counter32":         true,
+	"Counter64":         true,
+	"Gauge32":           true,
+	"TimeTicks":         true,
+	"IpAddress":         true,
+	"Opaque":            true,
+	"Counter":           true,
+	"Gauge":             true,
+	"NetworkAddress":    true,
+}
+
+// resolveType sets the base type of t, and inherits the enumerated values and
+// display hint of the textual convention it refers to.
+func (r *registry) resolveType(m *module, t *Type, depth int) {
+	if t.resolved || depth > maxDepth {
+		return
+	}
+	t.resolved = true
+	if baseTypes[t.ref] || strings.HasPrefix(t.ref, "SEQUENCE") || t.ref == "CHOICE" {
+		t.Base = t.ref
+		return
+	}
+	tm := r.symbol(m, t.ref, func(m *module) bool { return m.types[t.ref] != nil })
+	if tm == nil {
+		return
+	}
+	def := tm.types[t.ref]
+	r.resolveType(tm, def, depth+1)
+	t.Base = def.Base
+	if t.Enums == nil {
+		t.Enums = def.Enums
+	}
+	if t.DisplayHint == "" {
+		t.DisplayHint = def.DisplayHint
+	}
+}
+
+// find returns the object with the longest prefix of oid, and the remaining
+// instance suffix. r must be locked.
+func (r *registry) find(oid asn1.ObjectIdentifier) (*Object, []int) {
+	for i := len(oid); i > 0; i-- {
+		if obj := r.oids[oid[:i].String()]; obj != nil {
+			return obj, oid[i:]
+		}
+	}
+	return nil, nil
+}
+
 // parseOID parses the string-encoded OID, for example the
 // string "1.3.6.1.2.1.1.5.0" becomes sysName.0
 func parseOID(s string) (oid asn1.ObjectIdentifier, err error) {
-	if s[0] == '.' {
+	if s != "" && s[0] == '.' {
 		s = s[1:]
 	}
 	var n int
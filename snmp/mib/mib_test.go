@@ -1,9 +1,10 @@
-// +build ignore
-
 package mib
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -17,8 +18,13 @@ type LookupTest struct {
 
 var lookupTests = []LookupTest{
 	{"SNMPv2-MIB::sysName.0", asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 5, 0}},
+	{"sysName.0", asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 5, 0}},
 	{"1.3.6.1.2.1.1.5.0", asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 5, 0}},
 	{".1.3.6.1.2.1.1.5.0", asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 5, 0}},
+	{"IF-MIB::ifHCInOctets", asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 31, 1, 1, 1, 6}},
+	{"ifDescr.3", asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 3}},
+	{"linkDown", asn1.ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 5, 3}},
+	{"RFC1155-SMI::enterprises", asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1}},
 }
 
 func TestLookup(t *testing.T) {
@@ -42,9 +48,10 @@ type LookupError struct {
 }
 
 var lookupErrors = []LookupError{
-	{"", "exit status 2"},
-	{"foo", "exit status 2"},
-	{"sysName.0", "exit status 2"},
+	{"", "unknown object"},
+	{"foo", "unknown object"},
+	{"FOO-MIB::sysName.0", "unknown module"},
+	{"sysName.x", "invalid instance"},
 }
 
 func TestLookupErrors(t *testing.T) {
@@ -58,37 +65,183 @@ func TestLookupErrors(t *testing.T) {
 	}
 }
 
-func TestCacheWarm(t *testing.T) {
-	// clear the cache
-	cache.lookup = make(map[string]asn1.ObjectIdentifier)
-
-	// warm it up
-	_, err := Lookup("SNMPv2-MIB::sysName.0")
+const testMIB = `
+-- A module using the built-in modules.
+ACME-MIB DEFINITIONS ::= BEGIN
+
+IMPORTS
+    MODULE-IDENTITY, OBJECT-TYPE, Integer32, enterprises
+        FROM SNMPv2-SMI
+    TEXTUAL-CONVENTION, DisplayString, TruthValue
+        FROM SNMPv2-TC;
+
+acme MODULE-IDENTITY
+    LAST-UPDATED "201601010000Z"
+    ORGANIZATION "Acme" -- inline comment -- CONTACT-INFO "ops@example.com"
+    DESCRIPTION  "Widgets, with ""quotes"" -- and dashes."
+    ::= { enterprises 99999 }
+
+acmeObjects OBJECT IDENTIFIER ::= { acme 1 }
+
+AcmeState ::= TEXTUAL-CONVENTION
+    STATUS       current
+    DESCRIPTION  "State of a widget."
+    SYNTAX       INTEGER { ok(1), degraded(2), failed(3) }
+
+AcmeWidgetEntry ::= SEQUENCE { acmeWidgetState AcmeState }
+
+acmeWidgetTable OBJECT-TYPE
+    SYNTAX      SEQUENCE OF AcmeWidgetEntry
+    MAX-ACCESS  not-accessible
+    STATUS      current
+    DESCRIPTION ""
+    ::= { acmeObjects 1 }
+
+acmeWidgetEntry OBJECT-TYPE
+    SYNTAX      AcmeWidgetEntry
+    MAX-ACCESS  not-accessible
+    STATUS      current
+    DESCRIPTION ""
+    INDEX       { acmeWidgetIndex }
+    ::= { acmeWidgetTable 1 }
+
+acmeWidgetState OBJECT-TYPE
+    SYNTAX      AcmeState
+    MAX-ACCESS  read-only
+    STATUS      current
+    DESCRIPTION ""
+    ::= { acmeWidgetEntry 2 }
+
+acmeWidgetName OBJECT-TYPE
+    SYNTAX      DisplayString (SIZE (0..32))
+    MAX-ACCESS  read-only
+    STATUS      current
+    DESCRIPTION ""
+    ::= { acmeWidgetEntry 3 }
+
+acmeEnabled OBJECT-TYPE
+    SYNTAX      TruthValue
+    MAX-ACCESS  read-only
+    STATUS      current
+    DESCRIPTION ""
+    ::= { acmeObjects 2 }
+
+END
+
+ACME-V1-MIB DEFINITIONS ::= BEGIN
+
+IMPORTS
+    acme FROM ACME-MIB
+    TRAP-TYPE FROM RFC-1215
+    OBJECT-TYPE FROM RFC-1212;
+
+acmeV1 OBJECT IDENTIFIER ::= { acme 2 }
+
+acmeFanSpeed OBJECT-TYPE
+    SYNTAX  INTEGER
+    ACCESS  read-only
+    STATUS  mandatory
+    DESCRIPTION ""
+    ::= { acmeV1 1 }
+
+acmeFanFailed TRAP-TYPE
+    ENTERPRISE  acmeV1
+    VARIABLES   { acmeFanSpeed }
+    DESCRIPTION ""
+    ::= 7
+
+END
+`
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mib")
 	if err != nil {
-		t.Errorf("unexpected error: %v", err)
-		return
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, src := range map[string]string{
+		"ACME-MIB.txt": testMIB,
+		"README":       "not a MIB",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func(dirs []string) {
+		reg.Lock()
+		reg.dirs = dirs
+		reg.indexed = false
+		reg.Unlock()
+	}(reg.dirs)
+	Load(dir)
+
+	for _, test := range []LookupTest{
+		{"ACME-MIB::acmeWidgetState.4", asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 1, 1, 1, 2, 4}},
+		{"acmeEnabled.0", asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 1, 2, 0}},
+		{"ACME-V1-MIB::acmeFanFailed", asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 2, 0, 7}},
+	} {
+		result, err := Lookup(test.prefix)
+		if err != nil {
+			t.Errorf("Lookup(%q) error: %v", test.prefix, err)
+		} else if !result.Equal(test.result) {
+			t.Errorf("Lookup(%q): want=%v have=%v", test.prefix, test.result, result)
+		}
 	}
 
-	// break snmptranslate
-	defer os.Setenv("PATH", os.Getenv("PATH"))
-	os.Setenv("PATH", "")
+	obj, err := Describe("1.3.6.1.4.1.99999.1.1.1.2.4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.Module != "ACME-MIB" || obj.Name != "acmeWidgetState" || obj.Kind != "OBJECT-TYPE" {
+		t.Errorf("unexpected object %+v", obj)
+	}
+	want := map[int]string{1: "ok", 2: "degraded", 3: "failed"}
+	if obj.Syntax == nil || obj.Syntax.Name != "AcmeState" || obj.Syntax.Base != "INTEGER" || !reflect.DeepEqual(obj.Syntax.Enums, want) {
+		t.Errorf("unexpected syntax %+v", obj.Syntax)
+	}
+	for name, want := range map[string]Type{
+		"acmeWidgetName": {Name: "DisplayString", Base: "OCTET STRING", DisplayHint: "255a"},
+		"acmeEnabled":    {Name: "TruthValue", Base: "INTEGER", Enums: map[int]string{1: "true", 2: "false"}},
+		"acmeFanSpeed":   {Name: "INTEGER", Base: "INTEGER"},
+		"ifHCInOctets":   {Name: "Counter64", Base: "Counter64"},
+		"ifType":         {Name: "IANAifType"},
+	} {
+		obj, err := Describe(name)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		s := obj.Syntax
+		if s == nil || s.Name != want.Name || s.Base != want.Base || s.DisplayHint != want.DisplayHint || !reflect.DeepEqual(s.Enums, want.Enums) {
+			t.Errorf("%s: want syntax %+v, have %+v", name, want, s)
+		}
+	}
 
-	// simulate query
-	if _, err := Lookup("SNMPv2-MIB::sysName.0"); err != nil {
-		t.Errorf("unexpected error: %v", err)
+	for oid, want := range map[string]string{
+		"1.3.6.1.4.1.99999.1.1.1.3.12": "ACME-MIB::acmeWidgetName.12",
+		"1.3.6.1.2.1.2.2.1.2.1":        "IF-MIB::ifDescr.1",
+		"1.3.6.1.2.1.1.3":              "SNMPv2-MIB::sysUpTime",
+	} {
+		o, _ := parseOID(oid)
+		if name, ok := Translate(o); !ok || name != want {
+			t.Errorf("Translate(%s): want %s, have %s", oid, want, name)
+		}
+	}
+	if name, ok := Translate(asn1.ObjectIdentifier{2, 999}); ok {
+		t.Errorf("Translate(2.999): unexpected %s", name)
 	}
 }
 
-func TestCacheCold(t *testing.T) {
-	// clear the cache
-	cache.lookup = make(map[string]asn1.ObjectIdentifier)
-
-	// break snmptranslate
-	defer os.Setenv("PATH", os.Getenv("PATH"))
-	os.Setenv("PATH", "")
-
-	// simulate query
-	if _, err := Lookup("SNMPv2-MIB::sysName.0"); err == nil {
-		t.Errorf("unexpected success")
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		"",
+		"not a MIB",
+		"A-MIB DEFINITIONS ::= BEGIN",
+		"A-MIB DEFINITIONS ::= BEGIN a OBJECT IDENTIFIER ::= { b 1 END",
+		`A-MIB DEFINITIONS ::= BEGIN a OBJECT-TYPE DESCRIPTION "x END`,
+	} {
+		if _, err := parseModules(src); err == nil {
+			t.Errorf("expected error for %q", src)
+		}
 	}
 }
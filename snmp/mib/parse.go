@@ -0,0 +1,526 @@
+package mib
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// module is a parsed SMIv1 or SMIv2 MIB module.
+type module struct {
+	name string
+	// imports maps imported symbols to their module.
+	imports map[string]string
+	nodes   map[string]*node
+	// order holds the names of nodes in definition order.
+	order []string
+	types map[string]*Type
+}
+
+// node is an OID value assignment of a module.
+type node struct {
+	// kind is the macro defining the node, for example OBJECT-TYPE, or
+	// OBJECT IDENTIFIER for plain value assignments.
+	kind string
+	// parent is the symbol of the first component of the OID value, or
+	// empty if it is numeric.
+	parent string
+	ids    []int
+	syntax *Type
+
+	oid       []int
+	resolving bool
+}
+
+type token struct {
+	s    string
+	line int
+	// quoted is true for strings, which are never keywords.
+	quoted bool
+}
+
+// lex splits src into tokens, removing comments.
+func lex(src string) ([]token, error) {
+	var toks []token
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f':
+			i++
+		case strings.HasPrefix(src[i:], "--"):
+			// Comments end at the end of the line or at the next "--".
+			i += 2
+			for i < len(src) && src[i] != '\n' {
+				if strings.HasPrefix(src[i:], "--") {
+					i += 2
+					break
+				}
+				i++
+			}
+		case c == '"':
+			// "" is an escaped quote.
+			j := i + 1
+			for {
+				k := strings.IndexByte(src[j:], '"')
+				if k < 0 {
+					return nil, fmt.Errorf("line %d: unterminated string", line)
+				}
+				j += k + 1
+				if j >= len(src) || src[j] != '"' {
+					break
+				}
+				j++
+			}
+			s := src[i+1 : j-1]
+			toks = append(toks, token{s: strings.Replace(s, `""`, `"`, -1), line: line, quoted: true})
+			line += strings.Count(s, "\n")
+			i = j
+		case c == '\'':
+			// binary or hexadecimal string, for example '0F'H
+			j := strings.IndexByte(src[i+1:], '\'')
+			if j < 0 {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			end := i + j + 2
+			if end < len(src) && isIdent(src[end]) {
+				end++
+			}
+			toks = append(toks, token{s: src[i:end], line: line, quoted: true})
+			i = end
+		case strings.HasPrefix(src[i:], "::="):
+			toks = append(toks, token{s: "::=", line: line})
+			i += 3
+		case strings.HasPrefix(src[i:], ".."):
+			toks = append(toks, token{s: "..", line: line})
+			i += 2
+		case strings.IndexByte("{}(),;|[].<>", c) >= 0:
+			toks = append(toks, token{s: string(c), line: line})
+			i++
+		case isIdent(c):
+			j := i + 1
+			for j < len(src) && isIdent(src[j]) && !strings.HasPrefix(src[j:], "--") {
+				j++
+			}
+			toks = append(toks, token{s: src[i:j], line: line})
+			i = j
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+	return toks, nil
+}
+
+func isIdent(c byte) bool {
+	return c == '-' || c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) peek() string {
+	if p.pos >= len(p.toks) {
+		return ""
+	}
+	return p.toks[p.pos].s
+}
+
+func (p *parser) next() string {
+	s := p.peek()
+	p.pos++
+	return s
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.toks)
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	line := 0
+	if p.pos < len(p.toks) {
+		line = p.toks[p.pos].line
+	} else if len(p.toks) > 0 {
+		line = p.toks[len(p.toks)-1].line
+	}
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) expect(s string) error {
+	if t := p.next(); t != s {
+		p.pos--
+		return p.errorf("expected %q, got %q", s, t)
+	}
+	return nil
+}
+
+// skipBalanced skips a bracketed group starting at the current token.
+func (p *parser) skipBalanced(open, close string) error {
+	depth := 0
+	for !p.eof() {
+		switch p.next() {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+	return p.errorf("unterminated %q", open)
+}
+
+// parseModules parses the MIB modules of a file.
+func parseModules(src string) ([]*module, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	var mods []*module
+	for !p.eof() {
+		m, err := p.parseModule()
+		if err != nil {
+			if m != nil {
+				return nil, fmt.Errorf("%s: %v", m.name, err)
+			}
+			return nil, err
+		}
+		mods = append(mods, m)
+	}
+	if len(mods) == 0 {
+		return nil, fmt.Errorf("no MIB modules")
+	}
+	return mods, nil
+}
+
+func (p *parser) parseModule() (*module, error) {
+	m := &module{
+		name:    p.next(),
+		imports: make(map[string]string),
+		nodes:   make(map[string]*node),
+		types:   make(map[string]*Type),
+	}
+	if !isUpper(m.name) {
+		p.pos--
+		return nil, p.errorf("expected module name, got %q", m.name)
+	}
+	if p.peek() == "{" {
+		if err := p.skipBalanced("{", "}"); err != nil {
+			return m, err
+		}
+	}
+	if err := p.expect("DEFINITIONS"); err != nil {
+		return m, err
+	}
+	for !p.eof() && p.peek() != "::=" {
+		// tag default, for example IMPLICIT TAGS
+		p.next()
+	}
+	if err := p.expect("::="); err != nil {
+		return m, err
+	}
+	if err := p.expect("BEGIN"); err != nil {
+		return m, err
+	}
+	for {
+		switch p.peek() {
+		case "":
+			return m, p.errorf("missing END")
+		case "END":
+			p.next()
+			return m, nil
+		case "IMPORTS":
+			p.next()
+			if err := p.parseImports(m); err != nil {
+				return m, err
+			}
+		case "EXPORTS":
+			for !p.eof() && p.next() != ";" {
+			}
+		default:
+			if err := p.parseAssignment(m); err != nil {
+				return m, err
+			}
+		}
+	}
+}
+
+func (p *parser) parseImports(m *module) error {
+	var syms []string
+	for {
+		switch t := p.next(); t {
+		case "":
+			return p.errorf("unterminated IMPORTS")
+		case ";":
+			return nil
+		case ",":
+		case "FROM":
+			from := p.next()
+			for _, s := range syms {
+				m.imports[s] = from
+			}
+			syms = syms[:0]
+		default:
+			syms = append(syms, t)
+		}
+	}
+}
+
+func (p *parser) parseAssignment(m *module) error {
+	name := p.next()
+	switch t := p.peek(); {
+	case t == "MACRO":
+		for !p.eof() && p.next() != "END" {
+		}
+		return nil
+	case t == "::=":
+		p.next()
+		typ, err := p.parseTypeAssignment(name)
+		if err != nil {
+			return err
+		}
+		m.types[name] = typ
+		return nil
+	case t == "OBJECT" && p.pos+1 < len(p.toks) && p.toks[p.pos+1].s == "IDENTIFIER":
+		p.pos += 2
+		if err := p.expect("::="); err != nil {
+			return err
+		}
+		return p.parseOIDValue(m, name, &node{kind: "OBJECT IDENTIFIER"})
+	case isUpper(t):
+		return p.parseMacroValue(m, name)
+	default:
+		return p.errorf("unexpected %q after %q", t, name)
+	}
+}
+
+// parseTypeAssignment parses a type or textual convention definition.
+func (p *parser) parseTypeAssignment(name string) (*Type, error) {
+	if p.peek() != "TEXTUAL-CONVENTION" {
+		t, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		t.Name = name
+		return t, nil
+	}
+	p.next()
+	var hint string
+	for !p.eof() {
+		switch c := p.next(); c {
+		case "DISPLAY-HINT":
+			hint = p.next()
+		case "STATUS":
+			p.next()
+		case "DESCRIPTION", "REFERENCE":
+			p.next()
+		case "SYNTAX":
+			t, err := p.parseType()
+			if err != nil {
+				return nil, err
+			}
+			t.Name = name
+			t.DisplayHint = hint
+			return t, nil
+		default:
+			p.pos--
+			return nil, p.errorf("unexpected %q in TEXTUAL-CONVENTION %s", c, name)
+		}
+	}
+	return nil, p.errorf("missing SYNTAX of %s", name)
+}
+
+// parseType parses a type, keeping its name and enumerated values.
+func (p *parser) parseType() (*Type, error) {
+	if p.peek() == "[" {
+		if err := p.skipBalanced("[", "]"); err != nil {
+			return nil, err
+		}
+	}
+	if t := p.peek(); t == "IMPLICIT" || t == "EXPLICIT" {
+		p.next()
+	}
+	t := &Type{}
+	switch s := p.next(); s {
+	case "OCTET", "OBJECT":
+		second := map[string]string{"OCTET": "STRING", "OBJECT": "IDENTIFIER"}[s]
+		if err := p.expect(second); err != nil {
+			return nil, err
+		}
+		t.ref = s + " " + second
+	case "SEQUENCE":
+		if p.peek() == "OF" {
+			p.next()
+			t.ref = "SEQUENCE OF " + p.next()
+			break
+		}
+		t.ref = s
+		if err := p.skipBalanced("{", "}"); err != nil {
+			return nil, err
+		}
+	case "CHOICE":
+		t.ref = s
+		if err := p.skipBalanced("{", "}"); err != nil {
+			return nil, err
+		}
+	case "":
+		return nil, p.errorf("missing type")
+	default:
+		t.ref = s
+	}
+	t.Name = t.ref
+	for {
+		switch p.peek() {
+		case "{":
+			enums, err := p.parseEnums()
+			if err != nil {
+				return nil, err
+			}
+			t.Enums = enums
+		case "(":
+			if err := p.skipBalanced("(", ")"); err != nil {
+				return nil, err
+			}
+		default:
+			return t, nil
+		}
+	}
+}
+
+// parseEnums parses named numbers, for example { up(1), down(2) }.
+func (p *parser) parseEnums() (map[int]string, error) {
+	p.next()
+	enums := make(map[int]string)
+	for {
+		name := p.next()
+		if name == "}" {
+			return enums, nil
+		}
+		if name == "," {
+			continue
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(p.next())
+		if err != nil {
+			p.pos--
+			return nil, p.errorf("invalid value of %q", name)
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		enums[n] = name
+	}
+}
+
+// parseMacroValue parses a value defined with a macro, for example
+// OBJECT-TYPE or TRAP-TYPE.
+func (p *parser) parseMacroValue(m *module, name string) error {
+	n := &node{kind: p.next()}
+	var enterprise string
+	for {
+		if p.eof() {
+			return p.errorf("missing value of %q", name)
+		}
+		switch t := p.next(); t {
+		case "::=":
+			if p.peek() == "{" {
+				return p.parseOIDValue(m, name, n)
+			}
+			v, err := strconv.Atoi(p.next())
+			if err != nil || n.kind != "TRAP-TYPE" || enterprise == "" {
+				// other value assignments, for example INTEGER
+				return nil
+			}
+			// RFC 3584 section 3 maps SMIv1 traps to enterprise.0.specific.
+			n.parent = enterprise
+			n.ids = []int{0, v}
+			m.define(name, n)
+			return nil
+		case "SYNTAX":
+			if n.kind == "OBJECT-TYPE" && n.syntax == nil {
+				typ, err := p.parseType()
+				if err != nil {
+					return err
+				}
+				n.syntax = typ
+			}
+		case "ENTERPRISE":
+			enterprise = p.next()
+		}
+	}
+}
+
+// parseOIDValue parses an OID value such as { iso org(3) dod(6) 1 } as the
+// value of n. Named components define nodes too.
+func (p *parser) parseOIDValue(m *module, name string, n *node) error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	var parent string
+	var ids []int
+	for {
+		t := p.next()
+		switch {
+		case t == "}":
+			n.parent = parent
+			n.ids = ids
+			m.define(name, n)
+			return nil
+		case t == "":
+			return p.errorf("unterminated value of %q", name)
+		case isNumber(t):
+			v, _ := strconv.Atoi(t)
+			ids = append(ids, v)
+		case p.peek() == "(":
+			p.next()
+			v, err := strconv.Atoi(p.next())
+			if err != nil {
+				p.pos--
+				return p.errorf("invalid value of %q", t)
+			}
+			if err := p.expect(")"); err != nil {
+				return err
+			}
+			if _, ok := m.nodes[t]; !ok {
+				m.define(t, &node{kind: "OBJECT IDENTIFIER", parent: parent, ids: append(ids[:len(ids):len(ids)], v)})
+			}
+			parent, ids = t, nil
+		case parent == "" && len(ids) == 0:
+			parent = t
+		default:
+			return p.errorf("unexpected %q in value of %q", t, name)
+		}
+	}
+}
+
+func (m *module) define(name string, n *node) {
+	if _, ok := m.nodes[name]; !ok {
+		m.order = append(m.order, name)
+	}
+	m.nodes[name] = n
+}
+
+func isUpper(s string) bool {
+	return s != "" && unicode.IsUpper(rune(s[0]))
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -129,7 +129,7 @@ func (a binding) less(b binding) bool {
 // request represents an SNMP request to be sent over a Transport.
 type request struct {
 	ID             int32
-	Type           string // "Get", "GetNext", "GetBulk"
+	Type           string // "Get", "GetNext", "GetBulk", "Response", "Inform", "Trap"
 	Bindings       []binding
 	NonRepeaters   int
 	MaxRepetitions int
@@ -143,21 +143,22 @@ type response struct {
 	Bindings    []binding
 }
 
-// SNMP performs SNMPv2 requests as defined by RFC 3416.
+// SNMP performs SNMPv2 requests as defined by RFC 3416. If V3 is set,
+// requests are made with SNMPv3 and the User-based Security Model instead.
 type SNMP struct {
 	// Community is the SNMP community.
 	Community string
 	// Addr is the UDP address of the SNMP host.
 	Addr *net.UDPAddr
+	// V3 holds the SNMPv3 security parameters. If nil, SNMPv2c is used.
+	V3 *V3
+
+	engine engine
 }
 
 // New creates a new SNMP which connects to host with specified community.
 func New(host, community string) (*SNMP, error) {
-	hostport := host
-	if _, _, err := net.SplitHostPort(hostport); err != nil {
-		hostport = host + ":161"
-	}
-	addr, err := net.ResolveUDPAddr("udp", hostport)
+	addr, err := resolve(host)
 	if err != nil {
 		return nil, err
 	}
@@ -167,69 +168,134 @@ func New(host, community string) (*SNMP, error) {
 	}, nil
 }
 
+// resolve returns the address of host, using port 161 if none is specified.
+func resolve(host string) (*net.UDPAddr, error) {
+	hostport := host
+	if _, _, err := net.SplitHostPort(hostport); err != nil {
+		hostport = host + ":161"
+	}
+	return net.ResolveUDPAddr("udp", hostport)
+}
+
 func (s *SNMP) do(req *request) (*response, error) {
 	for i := range req.Bindings {
 		req.Bindings[i].Value = null
 	}
+	if s.V3 != nil {
+		return s.doV3(req)
+	}
+	pdu, err := marshalPDU(req)
+	if err != nil {
+		return nil, err
+	}
+	var p struct {
+		Version   int
+		Community []byte
+		Data      asn1.RawValue
+	}
+	p.Version = 1
+	p.Community = []byte(s.Community)
+	p.Data = asn1.RawValue{FullBytes: pdu}
+	buf, err := asn1.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	buf, err = s.exchange(buf)
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		Version   int
+		Community []byte
+		Data      struct {
+			RequestID   int32
+			ErrorStatus int
+			ErrorIndex  int
+			Bindings    []binding
+		} `asn1:"tag:2"`
+	}
+	if _, err = asn1.Unmarshal(buf, &r); err != nil {
+		return nil, err
+	}
+	resp := &response{r.Data.RequestID, r.Data.ErrorStatus, r.Data.ErrorIndex, r.Data.Bindings}
+	return resp, nil
+}
+
+// pduTags are the context-specific tags of PDU types.
+var pduTags = map[string]byte{
+	"Get":      0,
+	"GetNext":  1,
+	"Response": 2,
+	"GetBulk":  5,
+	"Inform":   6,
+	"Trap":     7,
+}
+
+// marshalPDU returns the encoding of the PDU of req.
+func marshalPDU(req *request) ([]byte, error) {
+	type pdu struct {
+		RequestID   int32
+		ErrorStatus int
+		ErrorIndex  int
+		Bindings    []binding
+	}
 	var buf []byte
 	var err error
 	switch req.Type {
 	case "Get":
 		var p struct {
-			Version   int
-			Community []byte
-			Data      struct {
-				RequestID   int32
-				ErrorStatus int
-				ErrorIndex  int
-				Bindings    []binding
-			} `asn1:"application,tag:0"`
+			Data pdu `asn1:"application,tag:0"`
 		}
-		p.Version = 1
-		p.Community = []byte(s.Community)
 		p.Data.RequestID = req.ID
 		p.Data.Bindings = req.Bindings
 		buf, err = asn1.Marshal(p)
 	case "GetNext":
 		var p struct {
-			Version   int
-			Community []byte
-			Data      struct {
-				RequestID   int32
-				ErrorStatus int
-				ErrorIndex  int
-				Bindings    []binding
-			} `asn1:"application,tag:1"`
+			Data pdu `asn1:"application,tag:1"`
 		}
-		p.Version = 1
-		p.Community = []byte(s.Community)
 		p.Data.RequestID = req.ID
 		p.Data.Bindings = req.Bindings
 		buf, err = asn1.Marshal(p)
 	case "GetBulk":
 		var p struct {
-			Version   int
-			Community []byte
-			Data      struct {
+			Data struct {
 				RequestID      int32
 				NonRepeaters   int
 				MaxRepetitions int
 				Bindings       []binding
 			} `asn1:"application,tag:5"`
 		}
-		p.Version = 1
-		p.Community = []byte(s.Community)
 		p.Data.RequestID = req.ID
 		p.Data.NonRepeaters = 0
 		p.Data.MaxRepetitions = req.MaxRepetitions
 		p.Data.Bindings = req.Bindings
 		buf, err = asn1.Marshal(p)
+	case "Response", "Inform", "Trap":
+		// The PDU is the sequence with its tag replaced by the
+		// context-specific tag of the type.
+		buf, err = asn1.Marshal(pdu{RequestID: req.ID, Bindings: req.Bindings})
+		if err == nil {
+			buf[0] = 0xa0 | pduTags[req.Type]
+		}
+		return buf, err
 	default:
 		panic("unsupported type " + req.Type)
 	}
 	if err != nil {
 		return nil, err
 	}
+	// buf is a sequence holding only the PDU.
+	var seq struct {
+		Data asn1.RawValue
+	}
+	if _, err := asn1.Unmarshal(buf, &seq); err != nil {
+		return nil, err
+	}
+	return seq.Data.FullBytes, nil
+}
+
+// exchange sends buf to the host and returns its reply.
+func (s *SNMP) exchange(buf []byte) ([]byte, error) {
 	conn, err := net.DialUDP("udp", nil, s.Addr)
 	if err != nil {
 		return nil, err
@@ -249,21 +315,7 @@ func (s *SNMP) do(req *request) (*response, error) {
 	if n == len(buf) {
 		return nil, fmt.Errorf("response too big")
 	}
-	var p struct {
-		Version   int
-		Community []byte
-		Data      struct {
-			RequestID   int32
-			ErrorStatus int
-			ErrorIndex  int
-			Bindings    []binding
-		} `asn1:"tag:2"`
-	}
-	if _, err = asn1.Unmarshal(buf[:n], &p); err != nil {
-		return nil, err
-	}
-	resp := &response{p.Data.RequestID, p.Data.ErrorStatus, p.Data.ErrorIndex, p.Data.Bindings}
-	return resp, nil
+	return buf[:n], nil
 }
 
 // check checks the response PDU for basic correctness.
@@ -0,0 +1,180 @@
+package snmp
+
+import (
+	"fmt"
+	"net"
+
+	"bosun.org/snmp/asn1"
+)
+
+// Trap is a notification sent by an agent: an SNMPv1 trap, or an SNMPv2c
+// trap or inform request.
+type Trap struct {
+	// Version is 0 for SNMPv1 and 1 for SNMPv2c.
+	Version   int
+	Community string
+	// OID identifies the trap. SNMPv1 traps are converted to SNMPv2 trap OIDs
+	// as described in RFC 3584 section 3.1.
+	OID asn1.ObjectIdentifier
+	// Agent is the agent address of SNMPv1 traps.
+	Agent net.IP
+	// Uptime is the sysUpTime of the agent in hundredths of a second.
+	Uptime int64
+	// Variables are the variable bindings of the trap, without sysUpTime.0
+	// and snmpTrapOID.0.
+	Variables []Variable
+	// Inform is true for inform requests, which must be acknowledged with
+	// Response.
+	Inform bool
+
+	requestID int32
+	bindings  []binding
+}
+
+// Variable is a variable binding of a trap.
+type Variable struct {
+	OID asn1.ObjectIdentifier
+	// Value is an int64, *big.Int, []byte, asn1.ObjectIdentifier, or nil for
+	// NULL and exceptions. IpAddress values are a net.IP.
+	Value interface{}
+}
+
+var (
+	sysUpTime0   = asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 3, 0}
+	snmpTrapOID0 = asn1.ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 4, 1, 0}
+	snmpTraps    = asn1.ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 5}
+)
+
+// ParseTrap parses an SNMPv1 or SNMPv2c trap or inform request.
+func ParseTrap(buf []byte) (*Trap, error) {
+	var msg struct {
+		Version   int
+		Community []byte
+		Data      asn1.RawValue
+	}
+	if _, err := asn1.Unmarshal(buf, &msg); err != nil {
+		return nil, err
+	}
+	t := &Trap{
+		Version:   msg.Version,
+		Community: string(msg.Community),
+	}
+	switch {
+	case msg.Version == 0 && msg.Data.Tag == 4:
+		if err := t.parseV1(msg.Data.FullBytes); err != nil {
+			return nil, err
+		}
+	case msg.Version == 1 && (msg.Data.Tag == 7 || msg.Data.Tag == 6):
+		if err := t.parseV2(msg.Data); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("snmp: not a trap: version %d, pdu %d", msg.Version, msg.Data.Tag)
+	}
+	return t, nil
+}
+
+func (t *Trap) parseV1(pdu []byte) error {
+	var p struct {
+		Enterprise   asn1.ObjectIdentifier
+		AgentAddr    asn1.RawValue
+		GenericTrap  int
+		SpecificTrap int
+		TimeStamp    asn1.RawValue
+		Bindings     []binding
+	}
+	if _, err := asn1.UnmarshalWithParams(pdu, &p, "tag:4"); err != nil {
+		return err
+	}
+	if len(p.AgentAddr.Bytes) == 4 {
+		t.Agent = net.IP(p.AgentAddr.Bytes)
+	}
+	if err := (binding{Value: p.TimeStamp}).value(&t.Uptime); err != nil {
+		return err
+	}
+	// RFC 3584 section 3.1
+	if p.GenericTrap == 6 {
+		t.OID = append(append(asn1.ObjectIdentifier{}, p.Enterprise...), 0, p.SpecificTrap)
+	} else {
+		t.OID = append(append(asn1.ObjectIdentifier{}, snmpTraps...), p.GenericTrap+1)
+	}
+	return t.setVariables(p.Bindings)
+}
+
+func (t *Trap) parseV2(data asn1.RawValue) error {
+	var p struct {
+		RequestID   int32
+		ErrorStatus int
+		ErrorIndex  int
+		Bindings    []binding
+	}
+	if _, err := asn1.UnmarshalWithParams(data.FullBytes, &p, fmt.Sprintf("tag:%d", data.Tag)); err != nil {
+		return err
+	}
+	t.Inform = data.Tag == 6
+	t.requestID = p.RequestID
+	t.bindings = p.Bindings
+	var vars []binding
+	for _, b := range p.Bindings {
+		var err error
+		switch {
+		case b.Name.Equal(sysUpTime0):
+			err = b.value(&t.Uptime)
+		case b.Name.Equal(snmpTrapOID0):
+			err = b.value(&t.OID)
+		default:
+			vars = append(vars, b)
+		}
+		if err != nil {
+			return fmt.Errorf("snmp: trap: %v: %v", b.Name, err)
+		}
+	}
+	if t.OID == nil {
+		return fmt.Errorf("snmp: trap: missing snmpTrapOID.0")
+	}
+	return t.setVariables(vars)
+}
+
+func (t *Trap) setVariables(bindings []binding) error {
+	for _, b := range bindings {
+		v := Variable{OID: b.Name}
+		switch {
+		case b.Value.Class == 0 && b.Value.Tag == null.Tag, b.Value.Class == 2:
+			// NULL, noSuchObject, noSuchInstance or endOfMibView
+		case b.Value.Class == 1 && b.Value.Tag == 0:
+			v.Value = net.IP(b.Value.Bytes)
+		default:
+			if err := b.value(&v.Value); err != nil {
+				return fmt.Errorf("snmp: trap: %v: %v", b.Name, err)
+			}
+		}
+		t.Variables = append(t.Variables, v)
+	}
+	return nil
+}
+
+// value is like unmarshal, but leaves the encoding of b unchanged.
+func (b binding) value(v interface{}) error {
+	b.Value.FullBytes = append([]byte{}, b.Value.FullBytes...)
+	return b.unmarshal(v)
+}
+
+// Response returns the message acknowledging an inform request.
+func (t *Trap) Response() ([]byte, error) {
+	if !t.Inform {
+		return nil, fmt.Errorf("snmp: not an inform request")
+	}
+	pdu, err := marshalPDU(&request{Type: "Response", ID: t.requestID, Bindings: t.bindings})
+	if err != nil {
+		return nil, err
+	}
+	var p struct {
+		Version   int
+		Community []byte
+		Data      asn1.RawValue
+	}
+	p.Version = t.Version
+	p.Community = []byte(t.Community)
+	p.Data = asn1.RawValue{FullBytes: pdu}
+	return asn1.Marshal(p)
+}
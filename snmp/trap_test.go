@@ -0,0 +1,158 @@
+package snmp
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"bosun.org/snmp/asn1"
+)
+
+func rawValue(t *testing.T, v interface{}) asn1.RawValue {
+	if r, ok := v.(asn1.RawValue); ok {
+		return r
+	}
+	b, err := asn1.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r asn1.RawValue
+	if _, err := asn1.Unmarshal(b, &r); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func marshalMessage(t *testing.T, version int, community string, pdu []byte) []byte {
+	var p struct {
+		Version   int
+		Community []byte
+		Data      asn1.RawValue
+	}
+	p.Version = version
+	p.Community = []byte(community)
+	p.Data = asn1.RawValue{FullBytes: pdu}
+	b, err := asn1.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+var linkDown = asn1.ObjectIdentifier{1, 3, 6, 1, 6, 3, 1, 1, 5, 3}
+
+func TestParseTrapV2(t *testing.T) {
+	ifIndex := asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 1, 3}
+	ifDescr := asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 2, 3}
+	ifInOctets := asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 10, 3}
+	addr := asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 1}
+	for _, inform := range []bool{false, true} {
+		typ := "Trap"
+		if inform {
+			typ = "Inform"
+		}
+		bindings := []binding{
+			{sysUpTime0, asn1.RawValue{Class: 1, Tag: 3, Bytes: []byte{0x01, 0x00}}},
+			{snmpTrapOID0, rawValue(t, linkDown)},
+			{ifIndex, rawValue(t, 3)},
+			{ifDescr, rawValue(t, []byte("eth2"))},
+			{ifInOctets, asn1.RawValue{Class: 1, Tag: 1, Bytes: []byte{0x7f}}},
+			{addr, asn1.RawValue{Class: 1, Tag: 0, Bytes: []byte{10, 0, 0, 1}}},
+		}
+		pdu, err := marshalPDU(&request{Type: typ, ID: 42, Bindings: bindings})
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := marshalMessage(t, 1, "public", pdu)
+		trap, err := ParseTrap(msg)
+		if err != nil {
+			t.Fatalf("%s: %v", typ, err)
+		}
+		if trap.Version != 1 || trap.Community != "public" || !trap.OID.Equal(linkDown) || trap.Uptime != 256 || trap.Inform != inform {
+			t.Errorf("%s: unexpected trap %+v", typ, trap)
+		}
+		expected := []Variable{
+			{ifIndex, int64(3)},
+			{ifDescr, []byte("eth2")},
+			{ifInOctets, int64(127)},
+			{addr, net.IP{10, 0, 0, 1}},
+		}
+		if !reflect.DeepEqual(trap.Variables, expected) {
+			t.Errorf("%s: expected variables %v, got %v", typ, expected, trap.Variables)
+		}
+		resp, err := trap.Response()
+		if !inform {
+			if err == nil {
+				t.Errorf("expected error for trap response")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The response echoes the request with a Response PDU.
+		pdu[0] = 0xa2
+		if expected := marshalMessage(t, 1, "public", pdu); !reflect.DeepEqual(resp, expected) {
+			t.Errorf("expected response %x, got %x", expected, resp)
+		}
+	}
+}
+
+func TestParseTrapV1(t *testing.T) {
+	enterprise := asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999}
+	for _, test := range []struct {
+		generic, specific int
+		oid               asn1.ObjectIdentifier
+	}{
+		{2, 0, linkDown},
+		{6, 17, asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 0, 17}},
+	} {
+		var p struct {
+			Enterprise   asn1.ObjectIdentifier
+			AgentAddr    asn1.RawValue
+			GenericTrap  int
+			SpecificTrap int
+			TimeStamp    asn1.RawValue
+			Bindings     []binding
+		}
+		p.Enterprise = enterprise
+		p.AgentAddr = asn1.RawValue{Class: 1, Tag: 0, Bytes: []byte{192, 0, 2, 1}}
+		p.GenericTrap = test.generic
+		p.SpecificTrap = test.specific
+		p.TimeStamp = asn1.RawValue{Class: 1, Tag: 3, Bytes: []byte{0x10}}
+		p.Bindings = []binding{{asn1.ObjectIdentifier{1, 3, 6, 1, 2, 1, 2, 2, 1, 1, 1}, rawValue(t, 1)}}
+		pdu, err := asn1.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pdu[0] = 0xa4
+		trap, err := ParseTrap(marshalMessage(t, 0, "private", pdu))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if trap.Version != 0 || trap.Community != "private" || !trap.OID.Equal(test.oid) ||
+			!trap.Agent.Equal(net.IP{192, 0, 2, 1}) || trap.Uptime != 16 || len(trap.Variables) != 1 {
+			t.Errorf("unexpected trap %+v", trap)
+		}
+	}
+}
+
+func TestParseTrapErrors(t *testing.T) {
+	pdu, err := marshalPDU(&request{Type: "Get", ID: 1, Bindings: []binding{{sysUpTime0, null}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseTrap(marshalMessage(t, 1, "public", pdu)); err == nil {
+		t.Errorf("expected error for get request")
+	}
+	pdu, err = marshalPDU(&request{Type: "Trap", ID: 1, Bindings: []binding{{sysUpTime0, rawValue(t, 0)}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseTrap(marshalMessage(t, 1, "public", pdu)); err == nil {
+		t.Errorf("expected error for trap without snmpTrapOID.0")
+	}
+	if _, err := ParseTrap([]byte("garbage")); err == nil {
+		t.Errorf("expected error for garbage")
+	}
+}
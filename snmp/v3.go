@@ -0,0 +1,540 @@
+package snmp
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"strings"
+	"sync"
+	"time"
+
+	"bosun.org/snmp/asn1"
+)
+
+// V3 holds the parameters of an SNMPv3 user of the User-based Security Model
+// defined by RFC 3414. The security level is noAuthNoPriv if AuthProtocol is
+// empty, authNoPriv if only AuthProtocol is set and authPriv if both
+// AuthProtocol and PrivProtocol are set.
+type V3 struct {
+	User string
+	// AuthProtocol is one of MD5, SHA, SHA224, SHA256, SHA384 and SHA512.
+	AuthProtocol   string
+	AuthPassphrase string
+	// PrivProtocol is one of DES and AES (AES-128).
+	PrivProtocol   string
+	PrivPassphrase string
+	// Context is the context name of requests, usually empty.
+	Context string
+}
+
+// NewV3 creates a new SNMP which connects to host with the SNMPv3 user v3.
+func NewV3(host string, v3 V3) (*SNMP, error) {
+	if err := v3.validate(); err != nil {
+		return nil, err
+	}
+	addr, err := resolve(host)
+	if err != nil {
+		return nil, err
+	}
+	s := &SNMP{
+		Addr: addr,
+		V3:   &v3,
+	}
+	// Start the privacy salt at a random value as recommended by RFC 3826.
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	s.engine.salt = binary.BigEndian.Uint64(b)
+	return s, nil
+}
+
+type authProtocol struct {
+	hash func() hash.Hash
+	// macLen is the length of the truncated HMAC.
+	macLen int
+}
+
+// authProtocols are the authentication protocols of RFC 3414 and RFC 7860.
+var authProtocols = map[string]authProtocol{
+	"MD5":    {md5.New, 12},
+	"SHA":    {sha1.New, 12},
+	"SHA224": {sha256.New224, 16},
+	"SHA256": {sha256.New, 24},
+	"SHA384": {sha512.New384, 32},
+	"SHA512": {sha512.New, 48},
+}
+
+func (v *V3) validate() error {
+	if v.User == "" {
+		return fmt.Errorf("snmp: v3: empty user")
+	}
+	v.AuthProtocol = strings.ToUpper(v.AuthProtocol)
+	v.PrivProtocol = strings.ToUpper(v.PrivProtocol)
+	if v.AuthProtocol != "" {
+		if _, ok := authProtocols[v.AuthProtocol]; !ok {
+			return fmt.Errorf("snmp: v3: unknown authentication protocol %q", v.AuthProtocol)
+		}
+		if len(v.AuthPassphrase) < 8 {
+			return fmt.Errorf("snmp: v3: authentication passphrase must be at least 8 characters")
+		}
+	}
+	switch v.PrivProtocol {
+	case "":
+		return nil
+	case "DES", "AES":
+	default:
+		return fmt.Errorf("snmp: v3: unknown privacy protocol %q", v.PrivProtocol)
+	}
+	if v.AuthProtocol == "" {
+		return fmt.Errorf("snmp: v3: privacy requires authentication")
+	}
+	if len(v.PrivPassphrase) < 8 {
+		return fmt.Errorf("snmp: v3: privacy passphrase must be at least 8 characters")
+	}
+	return nil
+}
+
+// flags returns the msgFlags for the security level of v.
+func (v *V3) flags() byte {
+	f := flagReportable
+	if v.AuthProtocol != "" {
+		f |= flagAuth
+	}
+	if v.PrivProtocol != "" {
+		f |= flagPriv
+	}
+	return f
+}
+
+const (
+	flagAuth       byte = 0x01
+	flagPriv       byte = 0x02
+	flagReportable byte = 0x04
+
+	securityModelUSM = 3
+	maxMessageSize   = 65507
+)
+
+// engine is the state of the authoritative SNMP engine of a host, discovered
+// with the first SNMPv3 request.
+type engine struct {
+	sync.Mutex
+	id    []byte
+	boots int
+	time  int
+	// synced is when time was received.
+	synced  time.Time
+	authKey []byte
+	privKey []byte
+	salt    uint64
+}
+
+// v3Message is an SNMPv3 message as defined by RFC 3412.
+type v3Message struct {
+	Version int
+	Header  struct {
+		ID            int32
+		MaxSize       int
+		Flags         []byte
+		SecurityModel int
+	}
+	SecurityParameters []byte
+	// Data is a scopedPDU, or an octet string holding an encrypted
+	// scopedPDU.
+	Data asn1.RawValue
+}
+
+type usmParameters struct {
+	EngineID       []byte
+	EngineBoots    int
+	EngineTime     int
+	User           []byte
+	AuthParameters []byte
+	PrivParameters []byte
+}
+
+type scopedPDU struct {
+	ContextEngineID []byte
+	ContextName     []byte
+	Data            asn1.RawValue
+}
+
+// usmStats maps the counters sent in report PDUs to errors.
+var usmStats = map[string]string{
+	"1.3.6.1.6.3.15.1.1.1.0": "unsupported security level",
+	"1.3.6.1.6.3.15.1.1.2.0": "not in time window",
+	"1.3.6.1.6.3.15.1.1.3.0": "unknown user name",
+	"1.3.6.1.6.3.15.1.1.4.0": "unknown engine ID",
+	"1.3.6.1.6.3.15.1.1.5.0": "wrong digest",
+	"1.3.6.1.6.3.15.1.1.6.0": "decryption error",
+}
+
+const (
+	notInTimeWindow = "1.3.6.1.6.3.15.1.1.2.0"
+	unknownEngineID = "1.3.6.1.6.3.15.1.1.4.0"
+)
+
+// reportError is returned for a report PDU received instead of a response.
+type reportError struct {
+	oid string
+}
+
+func (e *reportError) Error() string {
+	s := usmStats[e.oid]
+	if s == "" {
+		s = e.oid
+	}
+	return "snmp: v3: report: " + s
+}
+
+func (s *SNMP) doV3(req *request) (*response, error) {
+	pdu, err := marshalPDU(req)
+	if err != nil {
+		return nil, err
+	}
+	for retry := true; ; retry = false {
+		s.engine.Lock()
+		discovered := s.engine.id != nil
+		s.engine.Unlock()
+		if !discovered {
+			if err := s.discover(); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := s.sendV3(pdu, s.V3.flags())
+		if re, ok := err.(*reportError); ok && retry {
+			switch re.oid {
+			case notInTimeWindow:
+				// sendV3 updated the engine time from the report.
+				continue
+			case unknownEngineID:
+				s.engine.Lock()
+				s.engine.id = nil
+				s.engine.Unlock()
+				continue
+			}
+		}
+		return resp, err
+	}
+}
+
+// discover learns the engine ID, boots and time of the host and localizes the
+// keys of the user to the engine.
+func (s *SNMP) discover() error {
+	pdu, err := marshalPDU(&request{Type: "Get", ID: <-nextID})
+	if err != nil {
+		return err
+	}
+	_, err = s.sendV3(pdu, flagReportable)
+	if re, ok := err.(*reportError); ok && re.oid == unknownEngineID {
+		err = nil
+	}
+	if err != nil {
+		return fmt.Errorf("snmp: v3: engine discovery: %v", err)
+	}
+	s.engine.Lock()
+	defer s.engine.Unlock()
+	if len(s.engine.id) == 0 {
+		return fmt.Errorf("snmp: v3: engine discovery: no engine ID")
+	}
+	if s.V3.AuthProtocol != "" {
+		h := authProtocols[s.V3.AuthProtocol].hash
+		s.engine.authKey = localizeKey(h, s.V3.AuthPassphrase, s.engine.id)
+		if s.V3.PrivProtocol != "" {
+			s.engine.privKey = localizeKey(h, s.V3.PrivPassphrase, s.engine.id)
+		}
+	}
+	return nil
+}
+
+// sendV3 sends pdu with the given msgFlags and returns the response. Report
+// PDUs are returned as a *reportError.
+func (s *SNMP) sendV3(pdu []byte, flags byte) (*response, error) {
+	msgID := <-nextID
+	buf, err := s.marshalV3(msgID, flags, pdu)
+	if err != nil {
+		return nil, err
+	}
+	buf, err = s.exchange(buf)
+	if err != nil {
+		return nil, err
+	}
+	var msg v3Message
+	if _, err := asn1.Unmarshal(buf, &msg); err != nil {
+		return nil, err
+	}
+	if msg.Version != 3 || msg.Header.SecurityModel != securityModelUSM || len(msg.Header.Flags) != 1 {
+		return nil, fmt.Errorf("snmp: v3: invalid response header")
+	}
+	if msg.Header.ID != msgID {
+		return nil, fmt.Errorf("snmp: v3: message id mismatch")
+	}
+	var usm usmParameters
+	if _, err := asn1.Unmarshal(msg.SecurityParameters, &usm); err != nil {
+		return nil, err
+	}
+	s.engine.Lock()
+	defer s.engine.Unlock()
+	rflags := msg.Header.Flags[0]
+	if rflags&flagAuth != 0 {
+		if s.engine.authKey == nil || !s.V3.verify(s.engine.authKey, buf, msg.SecurityParameters, usm.AuthParameters) {
+			return nil, fmt.Errorf("snmp: v3: response authentication failed")
+		}
+	}
+	// Only unauthenticated discovery responses and authentic messages may
+	// set the engine state.
+	if s.engine.id == nil || rflags&flagAuth != 0 {
+		if s.engine.id == nil {
+			s.engine.id = usm.EngineID
+		}
+		s.engine.boots = usm.EngineBoots
+		s.engine.time = usm.EngineTime
+		s.engine.synced = time.Now()
+	}
+	data := msg.Data.FullBytes
+	if rflags&flagPriv != 0 {
+		if msg.Data.Tag != tagOctetString || s.engine.privKey == nil {
+			return nil, fmt.Errorf("snmp: v3: unexpected encrypted response")
+		}
+		data, err = decrypt(s.V3.PrivProtocol, s.engine.privKey, usm.EngineBoots, usm.EngineTime, usm.PrivParameters, msg.Data.Bytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var scoped scopedPDU
+	if _, err := asn1.Unmarshal(data, &scoped); err != nil {
+		return nil, err
+	}
+	var p struct {
+		RequestID   int32
+		ErrorStatus int
+		ErrorIndex  int
+		Bindings    []binding
+	}
+	if _, err := asn1.UnmarshalWithParams(scoped.Data.FullBytes, &p, fmt.Sprintf("tag:%d", scoped.Data.Tag)); err != nil {
+		return nil, err
+	}
+	if flags&flagAuth != 0 && rflags&flagAuth == 0 && scoped.Data.Tag != tagReport {
+		return nil, fmt.Errorf("snmp: v3: unauthenticated response")
+	}
+	if scoped.Data.Tag == tagReport {
+		oid := ""
+		if len(p.Bindings) > 0 {
+			oid = p.Bindings[0].Name.String()
+		}
+		return nil, &reportError{oid}
+	}
+	return &response{p.RequestID, p.ErrorStatus, p.ErrorIndex, p.Bindings}, nil
+}
+
+const (
+	tagOctetString = 4
+	tagReport      = 8
+)
+
+// marshalV3 returns the encoded message holding pdu. s.engine must not be
+// locked.
+func (s *SNMP) marshalV3(msgID int32, flags byte, pdu []byte) ([]byte, error) {
+	s.engine.Lock()
+	defer s.engine.Unlock()
+	e := &s.engine
+	usm := usmParameters{
+		EngineID: e.id,
+	}
+	// Discovery requests have no engine ID or user.
+	if e.id != nil {
+		usm.User = []byte(s.V3.User)
+		usm.EngineBoots = e.boots
+		usm.EngineTime = e.time + int(time.Since(e.synced)/time.Second)
+	}
+	scoped, err := asn1.Marshal(scopedPDU{
+		ContextEngineID: e.id,
+		ContextName:     []byte(s.V3.Context),
+		Data:            asn1.RawValue{FullBytes: pdu},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var msg v3Message
+	msg.Version = 3
+	msg.Header.ID = msgID
+	msg.Header.MaxSize = maxMessageSize
+	msg.Header.Flags = []byte{flags}
+	msg.Header.SecurityModel = securityModelUSM
+	msg.Data = asn1.RawValue{FullBytes: scoped}
+	if flags&flagPriv != 0 {
+		e.salt++
+		var enc []byte
+		enc, usm.PrivParameters, err = encrypt(s.V3.PrivProtocol, e.privKey, usm.EngineBoots, usm.EngineTime, e.salt, scoped)
+		if err != nil {
+			return nil, err
+		}
+		if msg.Data.FullBytes, err = asn1.Marshal(enc); err != nil {
+			return nil, err
+		}
+	}
+	if flags&flagAuth != 0 {
+		usm.AuthParameters = make([]byte, authProtocols[s.V3.AuthProtocol].macLen)
+	}
+	if msg.SecurityParameters, err = asn1.Marshal(usm); err != nil {
+		return nil, err
+	}
+	buf, err := asn1.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if flags&flagAuth != 0 {
+		s.V3.sign(e.authKey, buf, msg.SecurityParameters)
+	}
+	return buf, nil
+}
+
+// authOffset returns the offset in msg of the authentication parameters mac
+// of the encoded usmParameters sp.
+func authOffset(msg, sp, mac []byte) int {
+	i := bytes.Index(msg, sp)
+	// The privacy parameters that follow the authentication parameters in sp
+	// are shorter than mac, so the last match is the authentication
+	// parameters.
+	j := bytes.LastIndex(sp, mac)
+	if i < 0 || j < 0 {
+		return -1
+	}
+	return i + j
+}
+
+// sign sets the authentication parameters of msg, which hold zeros.
+func (v *V3) sign(key, msg, sp []byte) {
+	p := authProtocols[v.AuthProtocol]
+	i := authOffset(msg, sp, make([]byte, p.macLen))
+	m := hmac.New(p.hash, key)
+	m.Write(msg)
+	copy(msg[i:], m.Sum(nil)[:p.macLen])
+}
+
+// verify checks the authentication parameters mac of msg.
+func (v *V3) verify(key, msg, sp, mac []byte) bool {
+	p := authProtocols[v.AuthProtocol]
+	if len(mac) != p.macLen {
+		return false
+	}
+	i := authOffset(msg, sp, mac)
+	if i < 0 {
+		return false
+	}
+	b := make([]byte, len(msg))
+	copy(b, msg)
+	copy(b[i:], make([]byte, p.macLen))
+	m := hmac.New(p.hash, key)
+	m.Write(b)
+	return hmac.Equal(m.Sum(nil)[:p.macLen], mac)
+}
+
+// localizeKey returns the key derived from password and localized to
+// engineID as described in RFC 3414 section A.2.
+func localizeKey(newHash func() hash.Hash, password string, engineID []byte) []byte {
+	h := newHash()
+	pw := []byte(password)
+	buf := make([]byte, 64)
+	for n, i := 0, 0; n < 1048576; n += len(buf) {
+		for j := range buf {
+			buf[j] = pw[i%len(pw)]
+			i++
+		}
+		h.Write(buf)
+	}
+	ku := h.Sum(nil)
+	h.Reset()
+	h.Write(ku)
+	h.Write(engineID)
+	h.Write(ku)
+	return h.Sum(nil)
+}
+
+// encrypt encrypts the scoped PDU b with the privacy protocol priv, returning
+// the encrypted data and the privacy parameters.
+func encrypt(priv string, key []byte, boots, engineTime int, salt uint64, b []byte) (enc, params []byte, err error) {
+	params = make([]byte, 8)
+	switch priv {
+	case "DES":
+		// RFC 3414 section 8.1.1.1
+		binary.BigEndian.PutUint32(params, uint32(boots))
+		binary.BigEndian.PutUint32(params[4:], uint32(salt))
+		block, err := des.NewCipher(key[:8])
+		if err != nil {
+			return nil, nil, err
+		}
+		iv := make([]byte, 8)
+		for i := range iv {
+			iv[i] = key[8+i] ^ params[i]
+		}
+		if r := len(b) % 8; r != 0 {
+			b = append(b[:len(b):len(b)], make([]byte, 8-r)...)
+		}
+		enc = make([]byte, len(b))
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(enc, b)
+	case "AES":
+		// RFC 3826 section 3.1.3
+		binary.BigEndian.PutUint64(params, salt)
+		block, err := aes.NewCipher(key[:16])
+		if err != nil {
+			return nil, nil, err
+		}
+		enc = make([]byte, len(b))
+		cipher.NewCFBEncrypter(block, aesIV(boots, engineTime, params)).XORKeyStream(enc, b)
+	default:
+		return nil, nil, fmt.Errorf("snmp: v3: unknown privacy protocol %q", priv)
+	}
+	return enc, params, nil
+}
+
+// decrypt reverses encrypt.
+func decrypt(priv string, key []byte, boots, engineTime int, params, enc []byte) ([]byte, error) {
+	if len(params) != 8 {
+		return nil, fmt.Errorf("snmp: v3: invalid privacy parameters")
+	}
+	b := make([]byte, len(enc))
+	switch priv {
+	case "DES":
+		if len(enc)%8 != 0 {
+			return nil, fmt.Errorf("snmp: v3: invalid encrypted data length")
+		}
+		block, err := des.NewCipher(key[:8])
+		if err != nil {
+			return nil, err
+		}
+		iv := make([]byte, 8)
+		for i := range iv {
+			iv[i] = key[8+i] ^ params[i]
+		}
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(b, enc)
+	case "AES":
+		block, err := aes.NewCipher(key[:16])
+		if err != nil {
+			return nil, err
+		}
+		cipher.NewCFBDecrypter(block, aesIV(boots, engineTime, params)).XORKeyStream(b, enc)
+	default:
+		return nil, fmt.Errorf("snmp: v3: unknown privacy protocol %q", priv)
+	}
+	return b, nil
+}
+
+func aesIV(boots, engineTime int, salt []byte) []byte {
+	iv := make([]byte, 16)
+	binary.BigEndian.PutUint32(iv, uint32(boots))
+	binary.BigEndian.PutUint32(iv[4:], uint32(engineTime))
+	copy(iv[8:], salt)
+	return iv
+}
@@ -0,0 +1,398 @@
+package snmp
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/snmp/asn1"
+)
+
+func TestLocalizeKey(t *testing.T) {
+	// RFC 3414 sections A.3.1 and A.3.2
+	engineID, _ := hex.DecodeString("000000000000000000000002")
+	for _, test := range []struct {
+		auth, key string
+	}{
+		{"MD5", "526f5eed9fcce26f8964c2930787d82b"},
+		{"SHA", "6695febc9288e36282235fc7151f128497b38f3f"},
+	} {
+		key := hex.EncodeToString(localizeKey(authProtocols[test.auth].hash, "maplesyrup", engineID))
+		if key != test.key {
+			t.Errorf("%s: expected key %s, got %s", test.auth, test.key, key)
+		}
+	}
+}
+
+func TestV3(t *testing.T) {
+	users := []V3{
+		{User: "noauth"},
+		{User: "md5", AuthProtocol: "MD5", AuthPassphrase: "md5password"},
+		{User: "sha", AuthProtocol: "SHA", AuthPassphrase: "shapassword"},
+		{User: "sha256", AuthProtocol: "SHA256", AuthPassphrase: "sha256password"},
+		{User: "sha512des", AuthProtocol: "SHA512", AuthPassphrase: "sha512password", PrivProtocol: "DES", PrivPassphrase: "despassword"},
+		{User: "md5des", AuthProtocol: "MD5", AuthPassphrase: "md5password", PrivProtocol: "DES", PrivPassphrase: "despassword"},
+		{User: "shaaes", AuthProtocol: "SHA", AuthPassphrase: "shapassword", PrivProtocol: "AES", PrivPassphrase: "aespassword"},
+		{User: "sha224aes", AuthProtocol: "sha224", AuthPassphrase: "sha224password", PrivProtocol: "aes", PrivPassphrase: "aespassword"},
+	}
+	for _, omitTime := range []bool{false, true} {
+		agent := startTestAgent(t, users, omitTime)
+		for _, u := range users {
+			name := fmt.Sprintf("%s omitTime=%v", u.User, omitTime)
+			s, err := NewV3(agent.addr(), u)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			var descr []byte
+			if err := s.Get("1.3.6.1.2.1.1.1.0", &descr); err != nil {
+				t.Errorf("%s: get: %v", name, err)
+				continue
+			}
+			if string(descr) != "test agent" {
+				t.Errorf("%s: expected sysDescr %q, got %q", name, "test agent", descr)
+			}
+			rows, err := s.Walk("1.3.6.1.2.1.2.2.1.2", "1.3.6.1.2.1.2.2.1.4")
+			if err != nil {
+				t.Fatalf("%s: walk: %v", name, err)
+			}
+			var got []string
+			for rows.Next() {
+				var d []byte
+				var mtu int64
+				id, err := rows.Scan(&d, &mtu)
+				if err != nil {
+					t.Fatalf("%s: scan: %v", name, err)
+				}
+				got = append(got, fmt.Sprintf("%v:%s:%d", id, d, mtu))
+			}
+			if err := rows.Err(); err != nil {
+				t.Errorf("%s: walk: %v", name, err)
+			}
+			if expected := "1:eth0:1500 2:eth1:9000"; strings.Join(got, " ") != expected {
+				t.Errorf("%s: expected rows %s, got %v", name, expected, got)
+			}
+		}
+		agent.conn.Close()
+	}
+}
+
+func TestV3Errors(t *testing.T) {
+	agent := startTestAgent(t, []V3{
+		{User: "sha", AuthProtocol: "SHA", AuthPassphrase: "shapassword", PrivProtocol: "AES", PrivPassphrase: "aespassword"},
+	}, false)
+	defer agent.conn.Close()
+	for _, test := range []struct {
+		v3  V3
+		err string
+	}{
+		{V3{User: "sha", AuthProtocol: "SHA", AuthPassphrase: "wrongpassword", PrivProtocol: "AES", PrivPassphrase: "aespassword"}, "wrong digest"},
+		{V3{User: "unknown", AuthProtocol: "SHA", AuthPassphrase: "shapassword"}, "unknown user name"},
+		{V3{User: "sha", AuthProtocol: "SHA", AuthPassphrase: "shapassword", PrivProtocol: "AES", PrivPassphrase: "wrongpassword"}, "decryption error"},
+	} {
+		s, err := NewV3(agent.addr(), test.v3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var descr []byte
+		err = s.Get("1.3.6.1.2.1.1.1.0", &descr)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%+v: expected %q error, got %v", test.v3, test.err, err)
+		}
+	}
+	for _, v3 := range []V3{
+		{},
+		{User: "u", AuthProtocol: "SHA1", AuthPassphrase: "password"},
+		{User: "u", AuthProtocol: "SHA", AuthPassphrase: "short"},
+		{User: "u", PrivProtocol: "AES", PrivPassphrase: "password"},
+		{User: "u", AuthProtocol: "SHA", AuthPassphrase: "password", PrivProtocol: "3DES", PrivPassphrase: "password"},
+	} {
+		if _, err := NewV3("localhost", v3); err == nil {
+			t.Errorf("%+v: expected error", v3)
+		}
+	}
+}
+
+// testAgent is an SNMPv3 agent stand-in serving a fixed MIB.
+type testAgent struct {
+	t        *testing.T
+	conn     *net.UDPConn
+	engineID []byte
+	boots    int
+	start    time.Time
+	users    map[string]V3
+	mib      []binding
+	// omitTime causes discovery reports to not include the engine boots
+	// and time, so that they are learned from a notInTimeWindow report.
+	omitTime bool
+}
+
+// startTestAgent starts an agent for users. The agent is configured before
+// it starts serving, as its fields are not safe to change afterwards.
+func startTestAgent(t *testing.T, users []V3, omitTime bool) *testAgent {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &testAgent{
+		t:        t,
+		conn:     conn,
+		engineID: []byte("\x80\x00\x1f\x88\x80test-agent"),
+		boots:    3,
+		start:    time.Now().Add(-time.Hour),
+		users:    make(map[string]V3),
+		omitTime: omitTime,
+	}
+	for _, u := range users {
+		if err := u.validate(); err != nil {
+			t.Fatal(err)
+		}
+		a.users[u.User] = u
+	}
+	for oid, v := range map[string]interface{}{
+		"1.3.6.1.2.1.1.1.0":        []byte("test agent"),
+		"1.3.6.1.2.1.2.2.1.2.1":    []byte("eth0"),
+		"1.3.6.1.2.1.2.2.1.2.2":    []byte("eth1"),
+		"1.3.6.1.2.1.2.2.1.4.1":    1500,
+		"1.3.6.1.2.1.2.2.1.4.2":    9000,
+		"1.3.6.1.2.1.31.1.1.1.1.1": []byte("ifname"),
+	} {
+		name, _ := parseTestOID(oid)
+		b, err := asn1.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var raw asn1.RawValue
+		asn1.Unmarshal(b, &raw)
+		a.mib = append(a.mib, binding{Name: name, Value: raw})
+	}
+	sort.Slice(a.mib, func(i, j int) bool { return a.mib[i].less(a.mib[j]) })
+	go a.serve()
+	return a
+}
+
+func parseTestOID(s string) (asn1.ObjectIdentifier, error) {
+	var oid asn1.ObjectIdentifier
+	for _, e := range strings.Split(s, ".") {
+		var n int
+		if _, err := fmt.Sscan(e, &n); err != nil {
+			return nil, err
+		}
+		oid = append(oid, n)
+	}
+	return oid, nil
+}
+
+func (a *testAgent) addr() string {
+	return a.conn.LocalAddr().String()
+}
+
+func (a *testAgent) serve() {
+	buf := make([]byte, 65536)
+	for {
+		n, from, err := a.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		resp, err := a.handle(buf[:n])
+		if err != nil {
+			a.t.Errorf("agent: %v", err)
+			continue
+		}
+		a.conn.WriteToUDP(resp, from)
+	}
+}
+
+func (a *testAgent) time() int {
+	return int(time.Since(a.start) / time.Second)
+}
+
+func (a *testAgent) handle(buf []byte) ([]byte, error) {
+	var msg v3Message
+	if _, err := asn1.Unmarshal(buf, &msg); err != nil {
+		return nil, err
+	}
+	var usm usmParameters
+	if _, err := asn1.Unmarshal(msg.SecurityParameters, &usm); err != nil {
+		return nil, err
+	}
+	flags := msg.Header.Flags[0]
+	report := func(oid string, flags byte, u V3, authKey []byte) ([]byte, error) {
+		name, _ := parseTestOID(oid)
+		b, _ := asn1.Marshal(1)
+		var raw asn1.RawValue
+		asn1.Unmarshal(b, &raw)
+		return a.reply(msg.Header.ID, flags, u, authKey, nil, tagReport, 0, []binding{{Name: name, Value: raw}})
+	}
+	if len(usm.EngineID) == 0 {
+		return report(unknownEngineID, 0, V3{}, nil)
+	}
+	u, ok := a.users[string(usm.User)]
+	if !ok {
+		return report("1.3.6.1.6.3.15.1.1.3.0", 0, V3{}, nil)
+	}
+	var authKey, privKey []byte
+	if u.AuthProtocol != "" {
+		h := authProtocols[u.AuthProtocol].hash
+		authKey = localizeKey(h, u.AuthPassphrase, a.engineID)
+		if u.PrivProtocol != "" {
+			privKey = localizeKey(h, u.PrivPassphrase, a.engineID)
+		}
+	}
+	if flags&flagAuth != 0 {
+		if !u.verify(authKey, buf, msg.SecurityParameters, usm.AuthParameters) {
+			return report("1.3.6.1.6.3.15.1.1.5.0", 0, u, nil)
+		}
+		if d := usm.EngineTime - a.time(); usm.EngineBoots != a.boots || d > 150 || d < -150 {
+			return report(notInTimeWindow, flagAuth, u, authKey)
+		}
+	}
+	data := msg.Data.FullBytes
+	if flags&flagPriv != 0 {
+		var err error
+		data, err = decrypt(u.PrivProtocol, privKey, usm.EngineBoots, usm.EngineTime, usm.PrivParameters, msg.Data.Bytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var scoped scopedPDU
+	if _, err := asn1.Unmarshal(data, &scoped); err != nil {
+		return report("1.3.6.1.6.3.15.1.1.6.0", flags&flagAuth, u, authKey)
+	}
+	var p struct {
+		RequestID      int32
+		NonRepeaters   int
+		MaxRepetitions int
+		Bindings       []binding
+	}
+	if _, err := asn1.UnmarshalWithParams(scoped.Data.FullBytes, &p, fmt.Sprintf("tag:%d", scoped.Data.Tag)); err != nil {
+		return nil, err
+	}
+	var bindings []binding
+	switch scoped.Data.Tag {
+	case 0:
+		for _, b := range p.Bindings {
+			b.Value = noSuchObject
+			for _, m := range a.mib {
+				if m.Name.Equal(b.Name) {
+					b.Value = m.Value
+				}
+			}
+			bindings = append(bindings, b)
+		}
+	case 1, 5:
+		reps := 1
+		if scoped.Data.Tag == 5 {
+			reps = p.MaxRepetitions
+		}
+		last := p.Bindings
+		for i := 0; i < reps; i++ {
+			next := make([]binding, len(last))
+			for j, b := range last {
+				next[j] = binding{Name: b.Name, Value: endOfMibView}
+				for _, m := range a.mib {
+					if b.less(m) {
+						next[j] = m
+						break
+					}
+				}
+			}
+			end := false
+			for _, b := range next {
+				end = end || b.Value.Class == endOfMibView.Class && b.Value.Tag == endOfMibView.Tag
+			}
+			// Agents may return fewer repetitions than requested.
+			if end && i > 0 {
+				break
+			}
+			bindings = append(bindings, next...)
+			last = next
+		}
+	default:
+		return nil, fmt.Errorf("unexpected pdu %d", scoped.Data.Tag)
+	}
+	return a.reply(msg.Header.ID, flags&^flagReportable, u, authKey, privKey, 2, p.RequestID, bindings)
+}
+
+// reply returns a message holding a PDU with the given tag.
+func (a *testAgent) reply(msgID int32, flags byte, u V3, authKey, privKey []byte, tag int, requestID int32, bindings []binding) ([]byte, error) {
+	type pdu struct {
+		RequestID   int32
+		ErrorStatus int
+		ErrorIndex  int
+		Bindings    []binding
+	}
+	var b []byte
+	var err error
+	if tag == tagReport {
+		var p struct {
+			Data pdu `asn1:"application,tag:8"`
+		}
+		p.Data = pdu{RequestID: requestID, Bindings: bindings}
+		b, err = asn1.Marshal(p)
+	} else {
+		var p struct {
+			Data pdu `asn1:"application,tag:2"`
+		}
+		p.Data = pdu{RequestID: requestID, Bindings: bindings}
+		b, err = asn1.Marshal(p)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var seq struct {
+		Data asn1.RawValue
+	}
+	if _, err := asn1.Unmarshal(b, &seq); err != nil {
+		return nil, err
+	}
+	scoped, err := asn1.Marshal(scopedPDU{
+		ContextEngineID: a.engineID,
+		Data:            asn1.RawValue{FullBytes: seq.Data.FullBytes},
+	})
+	if err != nil {
+		return nil, err
+	}
+	usm := usmParameters{
+		EngineID: a.engineID,
+		User:     []byte(u.User),
+	}
+	if flags&flagAuth != 0 || !a.omitTime {
+		usm.EngineBoots = a.boots
+		usm.EngineTime = a.time()
+	}
+	var msg v3Message
+	msg.Version = 3
+	msg.Header.ID = msgID
+	msg.Header.MaxSize = maxMessageSize
+	msg.Header.Flags = []byte{flags}
+	msg.Header.SecurityModel = securityModelUSM
+	msg.Data = asn1.RawValue{FullBytes: scoped}
+	if flags&flagPriv != 0 {
+		var enc []byte
+		enc, usm.PrivParameters, err = encrypt(u.PrivProtocol, privKey, usm.EngineBoots, usm.EngineTime, uint64(time.Now().UnixNano()), scoped)
+		if err != nil {
+			return nil, err
+		}
+		if msg.Data.FullBytes, err = asn1.Marshal(enc); err != nil {
+			return nil, err
+		}
+	}
+	if flags&flagAuth != 0 {
+		usm.AuthParameters = make([]byte, authProtocols[u.AuthProtocol].macLen)
+	}
+	if msg.SecurityParameters, err = asn1.Marshal(usm); err != nil {
+		return nil, err
+	}
+	buf, err := asn1.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if flags&flagAuth != 0 {
+		u.sign(authKey, buf, msg.SecurityParameters)
+	}
+	return buf, nil
+}
@@ -48,11 +48,15 @@ type row struct {
 // Walk executes a query against host authenticated by the community string,
 // retrieving the MIB sub-tree defined by the the given root oids.
 func (s *SNMP) Walk(oids ...string) (*Rows, error) {
+	head, err := lookup(oids...)
+	if err != nil {
+		return nil, err
+	}
 	rows := &Rows{
 		avail:    nil,
 		walkFn:   walkN,
 		headText: oids,
-		head:     lookup(oids...),
+		head:     head,
 		request:  s.do,
 	}
 	for _, oid := range rows.head {
@@ -233,14 +237,14 @@ func walkN(have []binding, rf requestFunc) ([]row, error) {
 }
 
 // lookup maps oids in their symbolic format into numeric format.
-func lookup(oids ...string) []asn1.ObjectIdentifier {
+func lookup(oids ...string) ([]asn1.ObjectIdentifier, error) {
 	list := make([]asn1.ObjectIdentifier, 0, len(oids))
 	for _, o := range oids {
 		oid, err := mib.Lookup(o)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
 		list = append(list, oid)
 	}
-	return list
+	return list, nil
 }